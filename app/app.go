@@ -101,6 +101,7 @@ var (
 		distribution.ModuleName:          nil,
 		distribution.RewardsBankPoolName: nil,
 		orders.ModuleName:                {supply.Burner},
+		orders.FeeCollectorName:          nil,
 		gov.ModuleName:                   {supply.Burner},
 	}
 )
@@ -413,6 +414,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		keys[oracle.StoreKey],
 		app.paramsKeeper.Subspace(oracle.DefaultParamspace),
 		app.vmKeeper,
+		orders.RequestOraclePerms(),
 		appModulePerms(oracle.AvailablePermissions),
 	)
 
@@ -422,6 +424,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		keys[markets.StoreKey],
 		app.ccsKeeper,
 		orders.RequestMarketsPerms(),
+		orderbook.RequestMarketsPerms(),
 		appModulePerms(markets.AvailablePermissions),
 	)
 
@@ -432,6 +435,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		app.bankKeeper,
 		app.supplyKeeper,
 		app.marketKeeper,
+		app.oracleKeeper,
 		orderbook.RequestOrdersPerms(),
 		appModulePerms(orders.AvailablePermissions),
 	)
@@ -441,6 +445,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		cdc,
 		keys[orderbook.StoreKey],
 		app.orderKeeper,
+		app.marketKeeper,
 		appModulePerms(orderbook.AvailablePermissions),
 	)
 
@@ -489,7 +494,7 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		poa.NewAppMsModule(app.poaKeeper),
 		multisig.NewAppModule(app.msKeeper, app.poaKeeper),
 		oracle.NewAppModule(app.oracleKeeper),
-		markets.NewAppModule(app.marketKeeper),
+		markets.NewAppMsModule(app.marketKeeper),
 		orders.NewAppModule(app.orderKeeper),
 		orderbook.NewAppModule(app.orderBookKeeper),
 		crisis.NewAppModule(&app.crisisKeeper),
@@ -503,11 +508,13 @@ func NewDnServiceApp(logger log.Logger, db dbm.DB, config *config.VMConfig, invC
 		distribution.ModuleName,
 		slashing.ModuleName,
 		vm.ModuleName,
+		orders.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisis.ModuleName,
 		gov.ModuleName,
 		staking.ModuleName,
+		poa.ModuleName,
 		multisig.ModuleName,
 		oracle.ModuleName,
 		orders.ModuleName,
@@ -667,14 +667,16 @@ func TestPOA_CLI(t *testing.T) {
 	senderAddr := ct.Accounts["validator1"].Address
 	newValidatorAccName := "plain"
 	newValidatorAcc := ct.Accounts[newValidatorAccName]
+	newValidatorSdkAddr, err := sdk.AccAddressFromBech32(newValidatorAcc.Address)
+	require.NoError(t, err)
 	nonExistingAddress := secp256k1.GenPrivKey().PubKey().Address()
 
 	// check add validator Tx
 	{
-		require.LessOrEqual(t, len(curValidators), len(cliTester.EthAddresses), "not enough predefined ethAddresses")
-		newEthAddress, issueID := cliTester.EthAddresses[len(curValidators)], "newValidator"
+		newEthAddress, newEthSignature := NewEthAddressOwnership(t, ct.IDs.ChainID, newValidatorSdkAddr)
+		issueID := "newValidator"
 
-		ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, newEthAddress, issueID).CheckSucceeded()
+		ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, newEthAddress, newEthSignature, issueID).CheckSucceeded()
 		ct.ConfirmCall(issueID)
 
 		// update account
@@ -696,25 +698,31 @@ func TestPOA_CLI(t *testing.T) {
 		{
 			// wrong number of args
 			{
-				tx := ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, newEthAddress, issueID)
+				tx := ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, newEthAddress, newEthSignature, issueID)
 				tx.RemoveCmdArg(issueID)
 				tx.CheckFailedWithErrorSubstring("arg(s)")
 			}
 			// non-existing fromAddress
 			{
-				tx := ct.TxPoaAddValidator(nonExistingAddress.String(), newValidatorAcc.Address, newEthAddress, issueID)
+				tx := ct.TxPoaAddValidator(nonExistingAddress.String(), newValidatorAcc.Address, newEthAddress, newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring(NotFoundErrSubString)
 			}
 			// invalid validator address
 			{
-				tx := ct.TxPoaAddValidator(senderAddr, "invalid_address", newEthAddress, issueID)
+				tx := ct.TxPoaAddValidator(senderAddr, "invalid_address", newEthAddress, newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring("address")
 			}
 			// MsgAddValidator ValidateBasic
 			{
-				tx := ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, "invalid_eth_address", issueID)
+				tx := ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, "invalid_eth_address", newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring("invalid_eth_address")
 			}
+			// MsgAddValidator ValidateBasic: ethSignature by other key
+			{
+				_, otherEthSignature := NewEthAddressOwnership(t, ct.IDs.ChainID, newValidatorSdkAddr)
+				tx := ct.TxPoaAddValidator(senderAddr, newValidatorAcc.Address, newEthAddress, otherEthSignature, issueID)
+				tx.CheckFailedWithErrorSubstring("ethSignature")
+			}
 		}
 	}
 
@@ -759,14 +767,13 @@ func TestPOA_CLI(t *testing.T) {
 
 	// check replace validator Tx
 	{
-		require.LessOrEqual(t, len(curValidators), len(cliTester.EthAddresses), "not enough predefined ethAddresses")
-		newEthAddress := cliTester.EthAddresses[len(curValidators)]
+		newEthAddress, newEthSignature := NewEthAddressOwnership(t, ct.IDs.ChainID, newValidatorSdkAddr)
 
 		targetValidatorName := "validator2"
 		targetValidatorAcc := ct.Accounts[targetValidatorName]
 		issueID := "ReplaceValidator"
 
-		tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, newValidatorAcc.Address, newEthAddress, issueID)
+		tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, newValidatorAcc.Address, newEthAddress, newEthSignature, issueID)
 		tx.CheckSucceeded()
 		ct.ConfirmCall(issueID)
 
@@ -791,28 +798,28 @@ func TestPOA_CLI(t *testing.T) {
 		{
 			// wrong number of args
 			{
-				tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, newValidatorAcc.Address, newEthAddress, issueID)
+				tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, newValidatorAcc.Address, newEthAddress, newEthSignature, issueID)
 				tx.RemoveCmdArg(issueID)
 				tx.CheckFailedWithErrorSubstring("arg(s)")
 			}
 			// non-existing fromAddress
 			{
-				tx := ct.TxPoaReplaceValidator(nonExistingAddress.String(), targetValidatorAcc.Address, newValidatorAcc.Address, newEthAddress, issueID)
+				tx := ct.TxPoaReplaceValidator(nonExistingAddress.String(), targetValidatorAcc.Address, newValidatorAcc.Address, newEthAddress, newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring(NotFoundErrSubString)
 			}
 			// invalid old validator address
 			{
-				tx := ct.TxPoaReplaceValidator(senderAddr, "invalid_address", newValidatorAcc.Address, newEthAddress, issueID)
+				tx := ct.TxPoaReplaceValidator(senderAddr, "invalid_address", newValidatorAcc.Address, newEthAddress, newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring("oldValidator")
 			}
 			// invalid new validator address
 			{
-				tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, "invalid_address", newEthAddress, issueID)
+				tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, "invalid_address", newEthAddress, newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring("newValidator")
 			}
 			// invalid ethAddress
 			{
-				tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, newValidatorAcc.Address, "invalid_eth_address", issueID)
+				tx := ct.TxPoaReplaceValidator(senderAddr, targetValidatorAcc.Address, newValidatorAcc.Address, "invalid_eth_address", newEthSignature, issueID)
 				tx.CheckFailedWithErrorSubstring("invalid_eth_address")
 			}
 		}
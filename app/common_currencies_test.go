@@ -1,6 +1,7 @@
 package app
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authExported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -237,12 +239,39 @@ func CreateCurrency(t *testing.T, app *DnServiceApp, ccDenom string, ccDecimals
 	app.Commit()
 }
 
+// SetWithdrawLimits sets currency withdraw limits via the gov proposal handler.
+func SetWithdrawLimits(t *testing.T, app *DnServiceApp, limits currencies.WithdrawLimits) {
+	proposal := currencies.NewSetWithdrawLimitsProposal(limits.Denom, limits.MinAmount, limits.MaxAmount, limits.AccountDailyCap, limits.BlockCap)
+	ExecCurrenciesProposal(t, app, proposal)
+}
+
+// EnableWithdraws enables currency withdraws setting no withdraw limits via the gov proposal handler.
+func EnableWithdraws(t *testing.T, app *DnServiceApp, denom string) {
+	SetWithdrawLimits(t, app, currencies.NewEmptyWithdrawLimits(denom))
+}
+
+// ExecCurrenciesProposal validates and executes currencies gov proposal via the gov proposal handler.
+func ExecCurrenciesProposal(t *testing.T, app *DnServiceApp, proposal gov.Content) {
+	require.NoError(t, proposal.ValidateBasic(), "validating %q proposal", proposal.ProposalType())
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}})
+	err := currencies.NewGovHandler(app.ccKeeper)(GetContext(app, false), proposal)
+	require.NoError(t, err, "executing %q proposal", proposal.ProposalType())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
+// NewIssueDeposit creates a source chain deposit info unique for the issueID.
+func NewIssueDeposit(issueID string) currencies.IssueDeposit {
+	return currencies.NewIssueDeposit("ethereum", "0x"+hex.EncodeToString([]byte(issueID)), 0, "0xsender")
+}
+
 // IssueCurrency creates currency issue multisig message and confirms it.
 func IssueCurrency(t *testing.T, app *DnServiceApp,
 	coin sdk.Coin, msgID, issueID string,
 	recipientAccIdx uint, accs []*auth.BaseAccount, privKeys []crypto.PrivKey, doCheck bool) (*sdk.Result, error) {
 
-	issueMsg := currencies.NewMsgIssueCurrency(issueID, coin, accs[recipientAccIdx].Address)
+	issueMsg := currencies.NewMsgIssueCurrency(issueID, coin, accs[recipientAccIdx].Address, NewIssueDeposit(issueID))
 	return MSMsgSubmitAndVote(t, app, msgID, issueMsg, recipientAccIdx, accs, privKeys, doCheck)
 }
 
@@ -263,6 +292,24 @@ func WithdrawCurrency(t *testing.T, app *DnServiceApp,
 	return res, err
 }
 
+// ConfirmWithdraw creates confirm withdraw multisig message and confirms it.
+func ConfirmWithdraw(t *testing.T, app *DnServiceApp,
+	id uint64, pzTxHash, msgID string,
+	accs []*auth.BaseAccount, privKeys []crypto.PrivKey, doCheck bool) (*sdk.Result, error) {
+
+	confirmMsg := currencies.NewMsgConfirmWithdraw(dnTypes.NewIDFromUint64(id), pzTxHash)
+	return MSMsgSubmitAndVote(t, app, msgID, confirmMsg, 0, accs, privKeys, doCheck)
+}
+
+// RefundWithdraw creates refund withdraw multisig message and confirms it.
+func RefundWithdraw(t *testing.T, app *DnServiceApp,
+	id uint64, msgID string,
+	accs []*auth.BaseAccount, privKeys []crypto.PrivKey, doCheck bool) (*sdk.Result, error) {
+
+	refundMsg := currencies.NewMsgRefundWithdraw(dnTypes.NewIDFromUint64(id))
+	return MSMsgSubmitAndVote(t, app, msgID, refundMsg, 0, accs, privKeys, doCheck)
+}
+
 // CheckCurrencyExists checks currency exists.
 func CheckCurrencyExists(t *testing.T, app *DnServiceApp, denom string, supply sdk.Int, decimals uint8) {
 	currencyObj := ccstorage.Currency{}
@@ -296,6 +343,25 @@ func CheckWithdrawExists(t *testing.T, app *DnServiceApp, id uint64, coin sdk.Co
 	require.Equal(t, chainID, withdraw.PegZoneChainID)
 }
 
+// CheckWithdrawState checks withdraw lifecycle state.
+func CheckWithdrawState(t *testing.T, app *DnServiceApp, id uint64, state currencies.WithdrawState, pzTxHash string) {
+	withdraw := currencies.Withdraw{}
+	CheckRunQuery(t, app, currencies.WithdrawReq{ID: dnTypes.NewIDFromUint64(id)}, queryCurrencyWithdrawPath, &withdraw)
+
+	require.Equal(t, state, withdraw.State)
+	require.Equal(t, pzTxHash, withdraw.PegZoneTxHash)
+}
+
+// CheckWithdrawQuota checks account remaining withdraw quota.
+func CheckWithdrawQuota(t *testing.T, app *DnServiceApp, denom string, addr sdk.AccAddress, accountRemaining, blockRemaining sdk.Int) {
+	quota := currencies.WithdrawQuota{}
+	CheckRunQuery(t, app, currencies.WithdrawQuotaReq{Denom: denom, Address: addr}, queryCurrencyWithdrawQuotaPath, &quota)
+
+	require.Equal(t, addr, quota.Address)
+	require.Equal(t, accountRemaining.String(), quota.AccountDailyRemaining.String(), "account daily remaining")
+	require.Equal(t, blockRemaining.String(), quota.BlockRemaining.String(), "block remaining")
+}
+
 // CheckRecipientCoins checks account balance.
 func CheckRecipientCoins(t *testing.T, app *DnServiceApp, recipientAddr sdk.AccAddress, denom string, amount sdk.Int) {
 	checkBalance := amount
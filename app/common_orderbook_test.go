@@ -176,12 +176,12 @@ func (tester *OrderBookTester) AddClient(addr sdk.AccAddress, baseCoinsAmount, q
 		for _, market := range tester.Markets {
 			baseIssueID := fmt.Sprintf("%s_%s_base_%s", addr.String(), market.ID.String(), baseCoinsAmount.String())
 			baseCoin := sdk.NewCoin(market.BaseAssetDenom, baseCoinsAmount)
-			baseErr := tester.app.ccKeeper.IssueCurrency(ctx, baseIssueID, baseCoin, addr)
+			baseErr := tester.app.ccKeeper.IssueCurrency(ctx, baseIssueID, baseCoin, addr, NewIssueDeposit(baseIssueID))
 			require.NoError(tester.t, baseErr, "issue baseCurrency for client: %d", clientID)
 
 			quoteIssueID := fmt.Sprintf("%s_%s_quote_%s", addr.String(), market.ID.String(), quoteCoinsAmount.String())
 			quoteCoin := sdk.NewCoin(market.QuoteAssetDenom, quoteCoinsAmount)
-			quoteErr := tester.app.ccKeeper.IssueCurrency(ctx, quoteIssueID, quoteCoin, addr)
+			quoteErr := tester.app.ccKeeper.IssueCurrency(ctx, quoteIssueID, quoteCoin, addr, NewIssueDeposit(quoteIssueID))
 			require.NoError(tester.t, quoteErr, "issue quoteCurrency for client: %d", clientID)
 		}
 	}
@@ -427,6 +427,7 @@ func (tester *OrderBookTester) addOrder(owner sdk.AccAddress, dir orders.Directi
 			owner,
 			m.GetAssetCode(),
 			dir,
+			orders.LimitOrderType,
 			p,
 			q,
 			ttlInSec,
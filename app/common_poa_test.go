@@ -10,13 +10,26 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/rand"
 
+	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/poa"
 )
 
+// NewEthAddressOwnership generates a new Ethereum key and signs the validator ownership message for the chain ID with it.
+func NewEthAddressOwnership(t *testing.T, chainID string, address sdk.AccAddress) (ethAddress, ethSignature string) {
+	ethPrivKey, err := helpers.NewEthereumPrivateKey()
+	require.NoError(t, err)
+
+	ethSignature, ethAddress, err = helpers.SignEthereumMessage(ethPrivKey, poa.ValidatorEthSignatureMsg(chainID, address))
+	require.NoError(t, err)
+
+	return
+}
+
 // AddValidators creates poa add validator multisig message and confirms it.
 func AddValidators(t *testing.T, app *DnServiceApp, genAccs []*auth.BaseAccount, newValidators []*auth.BaseAccount, privKeys []crypto.PrivKey, doChecks bool) (*sdk.Result, error) {
 	for _, v := range newValidators {
-		addMsg := poa.NewMsgAddValidator(v.Address, ethAddresses[0], genAccs[0].Address)
+		ethAddress, ethSignature := NewEthAddressOwnership(t, chainID, v.Address)
+		addMsg := poa.NewMsgAddValidator(v.Address, ethAddress, ethSignature, genAccs[0].Address)
 		msgID := fmt.Sprintf("addValidator:%s:%d", v.Address, rand.Uint16())
 
 		res, err := MSMsgSubmitAndVote(t, app, msgID, addMsg, 0, genAccs, privKeys, doChecks)
@@ -32,7 +45,8 @@ func AddValidators(t *testing.T, app *DnServiceApp, genAccs []*auth.BaseAccount,
 
 // ReplaceValidator creates poa replace validator multisig message and confirms it.
 func ReplaceValidator(t *testing.T, app *DnServiceApp, genAccs []*auth.BaseAccount, oldValidatorAddr, newValidatorAddr sdk.AccAddress, oldPrivKeys []crypto.PrivKey, doChecks bool) (*sdk.Result, error) {
-	replaceMsg := poa.NewMsgReplaceValidator(oldValidatorAddr, newValidatorAddr, ethAddresses[0], genAccs[0].GetAddress())
+	ethAddress, ethSignature := NewEthAddressOwnership(t, chainID, newValidatorAddr)
+	replaceMsg := poa.NewMsgReplaceValidator(oldValidatorAddr, newValidatorAddr, ethAddress, ethSignature, genAccs[0].GetAddress())
 	msgID := fmt.Sprintf("ReplaceValidator:%s", newValidatorAddr)
 
 	return MSMsgSubmitAndVote(t, app, msgID, replaceMsg, 0, genAccs, oldPrivKeys, doChecks)
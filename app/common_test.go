@@ -36,6 +36,7 @@ import (
 	vmConfig "github.com/dfinance/dnode/cmd/config"
 	"github.com/dfinance/dnode/cmd/config/genesis/defaults"
 	"github.com/dfinance/dnode/cmd/config/restrictions"
+	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/helpers/tests"
 	"github.com/dfinance/dnode/x/currencies"
 	"github.com/dfinance/dnode/x/genaccounts"
@@ -62,6 +63,10 @@ const (
 	queryCurrencyWithdrawsPath = "/custom/" + currencies.ModuleName + "/" + currencies.QueryWithdraws
 	queryCurrencyWithdrawPath  = "/custom/" + currencies.ModuleName + "/" + currencies.QueryWithdraw
 	//
+	queryCurrencyWithdrawLimitsPath = "/custom/" + currencies.ModuleName + "/" + currencies.QueryWithdrawLimits
+	queryCurrencyWithdrawQuotaPath  = "/custom/" + currencies.ModuleName + "/" + currencies.QueryWithdrawQuota
+	queryCurrencyIssuesByTxHashPath = "/custom/" + currencies.ModuleName + "/" + currencies.QueryIssuesByTxHash
+	//
 	queryMsGetCallPath   = "/custom/" + multisig.ModuleName + "/" + multisig.QueryCall
 	queryMsGetCallsPath  = "/custom/" + multisig.ModuleName + "/" + multisig.QueryCalls
 	queryMsGetCallLastId = "/custom/" + multisig.ModuleName + "/" + multisig.QueryLastId
@@ -70,6 +75,7 @@ const (
 	queryPoaGetValidatorsPath   = "/custom/" + poa.ModuleName + "/" + poa.QueryValidators
 	queryPoaGetValidatorPath    = "/custom/" + poa.ModuleName + "/" + poa.QueryValidator
 	queryPoaGetMinMaxParamsPath = "/custom/" + poa.ModuleName + "/" + poa.QueryMinMax
+	queryPoaGetInactivePath     = "/custom/" + poa.ModuleName + "/" + poa.QueryInactive
 	//
 	queryOracleGetCurrentPricePathFmt = "/custom/oracle/price/%s"
 	queryOracleGetRawPricesPathFmt    = "/custom/oracle/rawprices/%s/%d"
@@ -90,17 +96,6 @@ var (
 	coin1          = sdk.NewCoin(currency1Denom, amount)
 	coin2          = sdk.NewCoin(currency2Denom, amount)
 	coin3          = sdk.NewCoin(currency3Denom, amount)
-	ethAddresses   = []string{
-		"0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1",
-		"0x7d577a597B2742b498Cb5Cf0C26cDCD726d39E6e",
-		"0xDCEceAF3fc5C0a63d195d69b1A90011B7B19650D",
-		"0x598443F1880Ef585B21f1d7585Bd0577402861E5",
-		"0x13cBB8D99C6C4e0f2728C7d72606e78A29C4E224",
-		"0x77dB2BEBBA79Db42a978F896968f4afCE746ea1F",
-		"0x24143873e0E0815fdCBcfFDbe09C979CbF9Ad013",
-		"0x10A1c1CB95c92EC31D3f22C66Eef1d9f3F258c6B",
-		"0xe0FC04FA2d34a66B779fd5CEe748268032a146c0",
-	}
 	bufferSize = 1024 * 1024
 	//
 	vmMockAddress  *string
@@ -219,7 +214,6 @@ func NewTestDnAppMockVM(logOpts ...log.Option) (*DnServiceApp, func()) {
 
 	// use invariants check period 1 for high pressure tests
 	customRestrictions := restrictions.GetAppRestrictions()
-	delete(customRestrictions.MsgDeniedList, currencies.ModuleName)
 	app := NewDnServiceApp(logger, dbm.NewMemDB(), config, 1, customRestrictions)
 
 	stopFunc := func() {
@@ -256,7 +250,6 @@ func NewTestDnAppDVM(t *testing.T, logOpts ...log.Option) (*DnServiceApp, string
 
 	// use invariants check period 1 for high pressure tests
 	customRestrictions := restrictions.GetAppRestrictions()
-	delete(customRestrictions.MsgDeniedList, currencies.ModuleName)
 	app := NewDnServiceApp(logger, dbm.NewMemDB(), config, 1, customRestrictions)
 
 	// start DS server
@@ -358,7 +351,11 @@ func GetGenesis(app *DnServiceApp, chainID, monikerID string, nodeAccPrivKey sec
 
 		validators := make(poa.Validators, len(accs))
 		for idx, acc := range accs {
-			validators[idx] = poa.Validator{Address: acc.Address, EthAddress: "0x17f7D1087971dF1a0E6b8Dae7428E97484E32615"}
+			ethPrivKey, err := helpers.NewEthereumPrivateKey()
+			if err != nil {
+				return nil, fmt.Errorf("ethereum privKey for %q: %w", acc.Address.String(), err)
+			}
+			validators[idx] = poa.Validator{Address: acc.Address, EthAddress: helpers.EthereumPubKeyToAddress(&ethPrivKey.PublicKey)}
 		}
 		genesisState[poa.ModuleName] = codec.MustMarshalJSONIndent(app.cdc, poa.GenesisState{
 			Parameters: poa.DefaultParams(),
@@ -22,7 +22,8 @@ import (
 )
 
 // Exports genesis and validators.
-func (app *DnServiceApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []string,
+// Finished multisig calls are moved to the archive on zero-height squash if {archiveMsCalls} is set.
+func (app *DnServiceApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []string, archiveMsCalls bool,
 ) (appState json.RawMessage, validators []tmTypes.GenesisValidator, retErr error) {
 
 	var err error
@@ -32,7 +33,7 @@ func (app *DnServiceApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhi
 
 	// zero-height squash
 	if forZeroHeight {
-		if err := app.prepareGenesisForZeroHeight(ctx, jailWhiteList); err != nil {
+		if err := app.prepareGenesisForZeroHeight(ctx, jailWhiteList, archiveMsCalls); err != nil {
 			retErr = fmt.Errorf("preparing genesis for zero-height: %w", err)
 			return
 		}
@@ -64,14 +65,14 @@ func (app *DnServiceApp) checkInvariants(ctx sdk.Context) error {
 
 // prepareGenesisForZeroHeight updates current context to fit zero-height genesis.
 // Basically it "squashes" all height-dependent storage objects.
-func (app *DnServiceApp) prepareGenesisForZeroHeight(ctx sdk.Context, jailWhiteList []string) error {
+func (app *DnServiceApp) prepareGenesisForZeroHeight(ctx sdk.Context, jailWhiteList []string, archiveMsCalls bool) error {
 	// Check invariants before
 	if err := app.checkInvariants(ctx); err != nil {
 		return fmt.Errorf("pre invariants check failed: %w", err)
 	}
 
 	// Prepare PrepareForZeroHeight module functions options
-	optsMap, err := prepareDefaultZeroHeightOptions(jailWhiteList)
+	optsMap, err := prepareDefaultZeroHeightOptions(jailWhiteList, archiveMsCalls)
 	if err != nil {
 		return fmt.Errorf("prepareDefaultZeroHeightOptions: %w", err)
 	}
@@ -150,7 +151,8 @@ func (app *DnServiceApp) prepareGenesisForZeroHeight(ctx sdk.Context, jailWhiteL
 	// MultiSig
 	{
 		moduleName := multisig.ModuleName
-		if err := app.msKeeper.PrepareForZeroHeight(ctx); err != nil {
+		opts := optsMap[moduleName].(multisig.SquashOptions)
+		if err := app.msKeeper.PrepareForZeroHeight(ctx, opts); err != nil {
 			return fmt.Errorf("module %s: %w", moduleName, err)
 		}
 	}
@@ -176,7 +178,7 @@ func (app *DnServiceApp) prepareGenesisForZeroHeight(ctx sdk.Context, jailWhiteL
 }
 
 // prepareDefaultZeroHeightOptions returns base (default) options map per module for PrepareForZeroHeight functions.
-func prepareDefaultZeroHeightOptions(jailWhiteList []string) (map[string]interface{}, error) {
+func prepareDefaultZeroHeightOptions(jailWhiteList []string, archiveMsCalls bool) (map[string]interface{}, error) {
 	optsMap := make(map[string]interface{})
 
 	// CCStorage
@@ -224,6 +226,15 @@ func prepareDefaultZeroHeightOptions(jailWhiteList []string) (map[string]interfa
 		opts := gov.NewEmptySquashOptions()
 		optsMap[moduleName] = opts
 	}
+	// MultiSig
+	{
+		moduleName := multisig.ModuleName
+		opts := multisig.NewEmptySquashOptions()
+		if err := opts.SetArchiveOperation(archiveMsCalls); err != nil {
+			return nil, fmt.Errorf("module %s: %w", moduleName, err)
+		}
+		optsMap[moduleName] = opts
+	}
 
 	return optsMap, nil
 }
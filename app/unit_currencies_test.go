@@ -27,7 +27,7 @@ func TestCurrenciesApp_MultisigHandler(t *testing.T) {
 
 	{
 		senderAcc, senderPrivKey := GetAccountCheckTx(app, genValidators[0].Address), genPrivKeys[0]
-		issueMsg := currencies.NewMsgIssueCurrency(issue1ID, coin1, senderAcc.GetAddress())
+		issueMsg := currencies.NewMsgIssueCurrency(issue1ID, coin1, senderAcc.GetAddress(), NewIssueDeposit(issue1ID))
 		tx := GenTx([]sdk.Msg{issueMsg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
 		CheckDeliverSpecificErrorTx(t, app, tx, sdkErrors.ErrUnauthorized)
 	}
@@ -57,6 +57,7 @@ func TestCurrenciesApp_Queries(t *testing.T) {
 	CreateCurrency(t, app, currency1Denom, 0)
 	CreateCurrency(t, app, currency2Denom, 0)
 	CreateCurrency(t, app, currency3Denom, 0)
+	EnableWithdraws(t, app, currency3Denom)
 	IssueCurrency(t, app, coin1, "msg1", issue1ID, recipientIdx, genAccs, genPrivKeys, true)
 	IssueCurrency(t, app, coin2, "msg2", issue2ID, recipientIdx, genAccs, genPrivKeys, true)
 	IssueCurrency(t, app, coin3, "msg3", issue3ID, recipientIdx, genAccs, genPrivKeys, true)
@@ -170,6 +171,25 @@ func TestCurrenciesApp_Issue(t *testing.T) {
 		res, err := IssueCurrency(t, app, coin, msgId, issueId, recipientIdx, genAccs, genPrivKeys, false)
 		CheckResultError(t, multisig.ErrVoteAlreadyApproved, res, err)
 	}
+
+	// fail: currency issue with already issued source chain deposit
+	{
+		msgId, issueId := "3", "issue3"
+		issueMsg := currencies.NewMsgIssueCurrency(issueId, sdk.NewCoin(denom, amount), recipientAddr, NewIssueDeposit("issue1"))
+
+		res, err := MSMsgSubmitAndVote(t, app, msgId, issueMsg, recipientIdx, genAccs, genPrivKeys, false)
+		CheckResultError(t, currencies.ErrWrongIssueDeposit, res, err)
+	}
+
+	// check issues by deposit tx hash query
+	{
+		issues := make([]currencies.GenesisIssue, 0)
+		deposit := NewIssueDeposit("issue2")
+		CheckRunQuery(t, app, currencies.IssuesByTxHashReq{TxHash: deposit.TxHash}, queryCurrencyIssuesByTxHashPath, &issues)
+		require.Len(t, issues, 1)
+		require.Equal(t, "issue2", issues[0].ID)
+		require.Equal(t, deposit, issues[0].Deposit)
+	}
 }
 
 // Test maximum bank supply level (DVM has u128 limit).
@@ -232,6 +252,7 @@ func TestCurrenciesApp_Decimals(t *testing.T) {
 	curAmount, curDecimals, denom := sdk.OneInt(), uint8(1), currency1Denom
 
 	CreateCurrency(t, app, denom, curDecimals)
+	EnableWithdraws(t, app, denom)
 
 	// issue currency amount with decimals
 	{
@@ -294,6 +315,15 @@ func TestCurrenciesApp_Withdraw(t *testing.T) {
 		CheckRecipientCoins(t, app, recipientAddr, denom, curSupply)
 	}
 
+	// fail: withdraws are disabled (no withdraw limits set)
+	{
+		coin := sdk.NewCoin(denom, amount)
+		res, err := WithdrawCurrency(t, app, chainID, coin, recipientAddr, recipientPrivKey, false)
+		CheckResultError(t, currencies.ErrWithdrawDisabled, res, err)
+	}
+
+	EnableWithdraws(t, app, denom)
+
 	// ok: withdraw currency
 	{
 		coin := sdk.NewCoin(denom, amount)
@@ -333,6 +363,185 @@ func TestCurrenciesApp_Withdraw(t *testing.T) {
 	}
 }
 
+// Test withdraw peg zone confirmation and refund via multisig.
+func TestCurrenciesApp_WithdrawConfirmRefund(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genAccs, _, _, genPrivKeys := CreateGenAccounts(10, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genAccs)
+
+	recipientIdx, recipientAddr, recipientPrivKey := uint(0), genAccs[0].Address, genPrivKeys[0]
+	curSupply, denom := amount.Mul(sdk.NewInt(2)), currency1Denom
+
+	CreateCurrency(t, app, denom, 0)
+	EnableWithdraws(t, app, denom)
+
+	// issue and withdraw currency twice
+	{
+		IssueCurrency(t, app, sdk.NewCoin(denom, curSupply), "1", issue1ID, recipientIdx, genAccs, genPrivKeys, true)
+
+		coin := sdk.NewCoin(denom, amount)
+		WithdrawCurrency(t, app, chainID, coin, recipientAddr, recipientPrivKey, true)
+		WithdrawCurrency(t, app, chainID, coin, recipientAddr, recipientPrivKey, true)
+		curSupply = curSupply.Sub(amount.MulRaw(2))
+
+		CheckWithdrawState(t, app, 0, currencies.WithdrawStatePending, "")
+		CheckWithdrawState(t, app, 1, currencies.WithdrawStatePending, "")
+		CheckCurrencyExists(t, app, denom, curSupply, 0)
+	}
+
+	// ok: confirm
+	{
+		ConfirmWithdraw(t, app, 0, "pzTxHash", "confirm0", genAccs, genPrivKeys, true)
+		CheckWithdrawState(t, app, 0, currencies.WithdrawStateConfirmed, "pzTxHash")
+		CheckCurrencyExists(t, app, denom, curSupply, 0)
+		CheckRecipientCoins(t, app, recipientAddr, denom, curSupply)
+	}
+
+	// ok: refund
+	{
+		RefundWithdraw(t, app, 1, "refund1", genAccs, genPrivKeys, true)
+		curSupply = curSupply.Add(amount)
+
+		CheckWithdrawState(t, app, 1, currencies.WithdrawStateRefunded, "")
+		CheckCurrencyExists(t, app, denom, curSupply, 0)
+		CheckRecipientCoins(t, app, recipientAddr, denom, curSupply)
+	}
+
+	// fail: refund confirmed withdraw
+	{
+		res, err := RefundWithdraw(t, app, 0, "refund0", genAccs, genPrivKeys, false)
+		CheckResultError(t, currencies.ErrWrongWithdrawState, res, err)
+	}
+
+	// check state filter query
+	{
+		withdraws := currencies.Withdraws{}
+		reqParams := currencies.WithdrawsReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), State: currencies.WithdrawStateRefunded}
+		CheckRunQuery(t, app, reqParams, queryCurrencyWithdrawsPath, &withdraws)
+		require.Len(t, withdraws, 1)
+		require.Equal(t, uint64(1), withdraws[0].ID.UInt64())
+	}
+}
+
+// Test withdraw limits set via gov proposal.
+func TestCurrenciesApp_WithdrawLimits(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genAccs, _, _, genPrivKeys := CreateGenAccounts(10, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genAccs)
+
+	recipientIdx, recipientAddr, recipientPrivKey := uint(0), genAccs[0].Address, genPrivKeys[0]
+	denom := currency1Denom
+
+	CreateCurrency(t, app, denom, 0)
+	IssueCurrency(t, app, sdk.NewCoin(denom, amount.MulRaw(10)), "1", issue1ID, recipientIdx, genAccs, genPrivKeys, true)
+
+	limits := currencies.NewWithdrawLimits(denom, sdk.OneInt(), amount, amount.MulRaw(2), sdk.ZeroInt())
+	SetWithdrawLimits(t, app, limits)
+
+	// check limits query
+	{
+		getLimits := currencies.WithdrawLimits{}
+		CheckRunQuery(t, app, currencies.WithdrawLimitsReq{Denom: denom}, queryCurrencyWithdrawLimitsPath, &getLimits)
+		require.Equal(t, limits.String(), getLimits.String())
+	}
+
+	// fail: max amount
+	{
+		res, err := WithdrawCurrency(t, app, chainID, sdk.NewCoin(denom, amount.AddRaw(1)), recipientAddr, recipientPrivKey, false)
+		CheckResultError(t, currencies.ErrWithdrawLimit, res, err)
+		CheckWithdrawQuota(t, app, denom, recipientAddr, limits.AccountDailyCap, sdk.ZeroInt())
+	}
+
+	// ok: withdraw within account daily cap
+	{
+		WithdrawCurrency(t, app, chainID, sdk.NewCoin(denom, amount), recipientAddr, recipientPrivKey, true)
+		WithdrawCurrency(t, app, chainID, sdk.NewCoin(denom, amount), recipientAddr, recipientPrivKey, true)
+		CheckWithdrawQuota(t, app, denom, recipientAddr, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+
+	// fail: account daily cap
+	{
+		res, err := WithdrawCurrency(t, app, chainID, sdk.NewCoin(denom, sdk.OneInt()), recipientAddr, recipientPrivKey, false)
+		CheckResultError(t, currencies.ErrWithdrawLimit, res, err)
+	}
+}
+
+// Test currency pause / unpause gov proposals.
+func TestCurrenciesApp_Pause(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genAccs, _, _, genPrivKeys := CreateGenAccounts(10, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genAccs)
+
+	recipientIdx, recipientAddr, recipientPrivKey := uint(0), genAccs[0].Address, genPrivKeys[0]
+	denom := currency1Denom
+
+	CreateCurrency(t, app, denom, 0)
+	EnableWithdraws(t, app, denom)
+	IssueCurrency(t, app, sdk.NewCoin(denom, amount), "1", issue1ID, recipientIdx, genAccs, genPrivKeys, true)
+
+	checkCurrency := func(paused bool) {
+		currency := ccstorage.Currency{}
+		CheckRunQuery(t, app, currencies.CurrencyReq{Denom: denom}, queryCurrencyCurrencyPath, &currency)
+		require.Equal(t, paused, currency.Paused)
+
+		curStatus, err := app.ccsKeeper.GetResStdCurrencyStatus(GetContext(app, true), denom)
+		require.NoError(t, err)
+		require.Equal(t, paused, curStatus.Paused)
+	}
+
+	// set metadata
+	{
+		ExecCurrenciesProposal(t, app, currencies.NewSetCurrencyMetadataProposal(denom, "Test", "Test currency", "https://dfinance.co/test.png"))
+
+		currency := ccstorage.Currency{}
+		CheckRunQuery(t, app, currencies.CurrencyReq{Denom: denom}, queryCurrencyCurrencyPath, &currency)
+		require.Equal(t, "Test", currency.Name)
+		require.Equal(t, "Test currency", currency.Description)
+		require.Equal(t, "https://dfinance.co/test.png", currency.LogoURI)
+
+		curStatus, err := app.ccsKeeper.GetResStdCurrencyStatus(GetContext(app, true), denom)
+		require.NoError(t, err)
+		require.Equal(t, ccstorage.NewResCurrencyStatus(currency), curStatus)
+	}
+
+	// pause
+	ExecCurrenciesProposal(t, app, currencies.NewPauseCurrencyProposal(denom))
+	checkCurrency(true)
+
+	// fail: issue
+	{
+		res, err := IssueCurrency(t, app, sdk.NewCoin(denom, amount), "2", issue2ID, recipientIdx, genAccs, genPrivKeys, false)
+		CheckResultError(t, ccstorage.ErrPaused, res, err)
+	}
+
+	// fail: withdraw
+	{
+		res, err := WithdrawCurrency(t, app, chainID, sdk.NewCoin(denom, amount), recipientAddr, recipientPrivKey, false)
+		CheckResultError(t, ccstorage.ErrPaused, res, err)
+	}
+
+	// unpause
+	ExecCurrenciesProposal(t, app, currencies.NewUnpauseCurrencyProposal(denom))
+	checkCurrency(false)
+
+	// ok: withdraw
+	{
+		WithdrawCurrency(t, app, chainID, sdk.NewCoin(denom, amount), recipientAddr, recipientPrivKey, true)
+	}
+}
+
 // Test issues and destroys currency and verifies that supply (via supply module) stays up-to-date.
 func TestCurrenciesApp_Supply(t *testing.T) {
 	t.Parallel()
@@ -378,6 +587,8 @@ func TestCurrenciesApp_Supply(t *testing.T) {
 
 	// withdraw 2.5 btc from account2
 	{
+		EnableWithdraws(t, app, "btc")
+
 		recipientAddr, recipientPrivKey := genAccs[1].Address, genPrivKeys[1]
 		amount, _ := sdk.NewIntFromString("250000000")
 		coin := sdk.NewCoin("btc", amount)
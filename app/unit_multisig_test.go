@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/core/msmodule"
 	"github.com/dfinance/dnode/x/currencies"
 	"github.com/dfinance/dnode/x/multisig"
 	msClient "github.com/dfinance/dnode/x/multisig/client"
@@ -60,7 +61,8 @@ func TestMSApp_Voting(t *testing.T) {
 	{
 		// create call
 		senderAcc, senderPrivKey := GetAccountCheckTx(app, nonExistingValidator.Address), nonExistingValidatorPrivKey
-		addMsg := poa.NewMsgAddValidator(targetValidator.Address, ethAddresses[0], senderAcc.GetAddress())
+		ethAddress, ethSignature := NewEthAddressOwnership(t, chainID, targetValidator.Address)
+		addMsg := poa.NewMsgAddValidator(targetValidator.Address, ethAddress, ethSignature, senderAcc.GetAddress())
 		msgID := fmt.Sprintf("addValidator:%s", targetValidator.Address)
 		submitMsg := msClient.NewMsgSubmitCall(addMsg, msgID, senderAcc.GetAddress())
 		tx := GenTx([]sdk.Msg{submitMsg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
@@ -71,7 +73,8 @@ func TestMSApp_Voting(t *testing.T) {
 	{
 		// create call
 		senderAcc, senderPrivKey := GetAccountCheckTx(app, genValidators[0].Address), genPrivKeys[0]
-		addMsg := poa.NewMsgAddValidator(targetValidator.Address, ethAddresses[0], senderAcc.GetAddress())
+		ethAddress, ethSignature := NewEthAddressOwnership(t, chainID, targetValidator.Address)
+		addMsg := poa.NewMsgAddValidator(targetValidator.Address, ethAddress, ethSignature, senderAcc.GetAddress())
 		callUniqueId = fmt.Sprintf("addValidator:%s", targetValidator.Address)
 		submitMsg := msClient.NewMsgSubmitCall(addMsg, callUniqueId, senderAcc.GetAddress())
 		tx := GenTx([]sdk.Msg{submitMsg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
@@ -206,7 +209,7 @@ func TestMSApp_BlockHeight(t *testing.T) {
 		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}})
 		// generate submit message
 		issueId, msgId := fmt.Sprintf("issue%d", curIssueIdx), strconv.Itoa(curIssueIdx)
-		issueMsg := currencies.NewMsgIssueCurrency(issueId, coin1, senderAddr)
+		issueMsg := currencies.NewMsgIssueCurrency(issueId, coin1, senderAddr, NewIssueDeposit(issueId))
 		submitMsg := msClient.NewMsgSubmitCall(issueMsg, msgId, senderAddr)
 		// emit transaction
 		senderAcc := GetAccount(app, senderAddr)
@@ -290,3 +293,78 @@ func TestMSApp_BlockHeight(t *testing.T) {
 		CheckDeliverSpecificErrorTx(t, app, tx, multisig.ErrVoteAlreadyApproved)
 	}
 }
+
+// Check batch call messages are executed atomically.
+func TestMSApp_BatchCall(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genAccs, genAddrs, _, genPrivKeys := CreateGenAccounts(7, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genAccs)
+
+	CreateCurrency(t, app, currency1Denom, 0)
+
+	confirmCnt := int(app.poaKeeper.GetEnoughConfirmations(GetContext(app, true)))
+	submitCall := func(uniqueID string, msg msmodule.MsMsg, batchMsgs ...msmodule.MsMsg) dnTypes.ID {
+		senderAcc, senderPrivKey := GetAccountCheckTx(app, genAddrs[0]), genPrivKeys[0]
+		submitMsg := msClient.NewMsgSubmitCall(msg, uniqueID, senderAcc.GetAddress(), batchMsgs...)
+		tx := GenTx([]sdk.Msg{submitMsg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
+		CheckDeliverTx(t, app, tx)
+
+		response := multisig.CallResp{}
+		CheckRunQuery(t, app, multisig.CallByUniqueIdReq{UniqueID: uniqueID}, queryMsGetUniqueCall, &response)
+
+		return response.Call.ID
+	}
+	confirmCall := func(callID dnTypes.ID) multisig.Call {
+		for i := 1; i < confirmCnt; i++ {
+			senderAcc, senderPrivKey := GetAccountCheckTx(app, genAddrs[i]), genPrivKeys[i]
+			confirmMsg := msClient.NewMsgConfirmCall(callID, senderAcc.GetAddress())
+			tx := GenTx([]sdk.Msg{confirmMsg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
+			CheckDeliverTx(t, app, tx)
+		}
+
+		response := multisig.CallResp{}
+		CheckRunQuery(t, app, multisig.CallReq{CallID: callID}, queryMsGetCallPath, &response)
+
+		return response.Call
+	}
+
+	// ok: all batch messages executed
+	{
+		issueMsg1 := currencies.NewMsgIssueCurrency("issue1", coin1, genAddrs[0], NewIssueDeposit("issue1"))
+		issueMsg2 := currencies.NewMsgIssueCurrency("issue2", coin1, genAddrs[1], NewIssueDeposit("issue2"))
+
+		call := confirmCall(submitCall("batch1", issueMsg1, issueMsg2))
+		require.True(t, call.Executed)
+		require.Len(t, call.Results, 2)
+		require.True(t, call.Results[0].Succeeded)
+		require.True(t, call.Results[1].Succeeded)
+
+		CheckIssueExists(t, app, "issue1", coin1, genAddrs[0])
+		CheckIssueExists(t, app, "issue2", coin1, genAddrs[1])
+	}
+
+	// fail: batch message fails, previous messages are reverted
+	{
+		issueMsg3 := currencies.NewMsgIssueCurrency("issue3", coin1, genAddrs[0], NewIssueDeposit("issue3"))
+		issueMsg4 := currencies.NewMsgIssueCurrency("issue4", coin1, genAddrs[1], NewIssueDeposit("issue4"))
+
+		batchCallID := submitCall("batch2", issueMsg3, issueMsg4)
+
+		// issue4 is executed before the batch call
+		call := confirmCall(submitCall("single", issueMsg4))
+		require.True(t, call.Executed)
+
+		call = confirmCall(batchCallID)
+		require.True(t, call.Failed)
+		require.NotEmpty(t, call.Error)
+		require.Len(t, call.Results, 2)
+		require.True(t, call.Results[0].Succeeded)
+		require.False(t, call.Results[1].Succeeded)
+
+		CheckRunQuerySpecificError(t, app, currencies.IssueReq{ID: "issue3"}, queryCurrencyIssuePath, currencies.ErrWrongIssueID)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
@@ -714,3 +716,42 @@ func TestOracle_PostPrices(t *testing.T) {
 		}
 	}
 }
+
+// Check EndBlocker doesn't halt the chain if price history / liveness params are not set (chain upgraded without new params).
+func TestOracle_EndBlockerParamsNotSet(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genAccs, _, _, _ := CreateGenAccounts(1, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genAccs)
+
+	// remove params
+	{
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}})
+
+		ctx := GetContext(app, false)
+		paramsStore := prefix.NewStore(ctx.KVStore(app.keys[params.StoreKey]), []byte(oracle.DefaultParamspace+"/"))
+		paramsStore.Delete(oracle.KeyPriceHistory)
+		paramsStore.Delete(oracle.KeyLiveness)
+
+		require.NotPanics(t, func() {
+			app.EndBlock(abci.RequestEndBlock{})
+		})
+		app.Commit()
+	}
+
+	// next blocks
+	for i := 0; i < 3; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{ChainID: chainID, Height: app.LastBlockHeight() + 1}})
+		require.NotPanics(t, func() {
+			app.EndBlock(abci.RequestEndBlock{})
+		})
+		app.Commit()
+	}
+
+	ctx := GetContext(app, true)
+	require.Equal(t, oracle.PriceHistoryParams{}, app.oracleKeeper.GetPriceHistoryParams(ctx))
+	require.Equal(t, oracle.LivenessParams{}, app.oracleKeeper.GetLivenessParams(ctx))
+}
@@ -28,12 +28,39 @@ func TestPOAApp_HandlerIsMultisigOnly(t *testing.T) {
 	// check module supports only multisig calls (using MSRouter)
 	{
 		senderAcc, senderPrivKey := GetAccountCheckTx(app, genValidators[0].Address), genPrivKeys[0]
-		addMsg := poa.NewMsgAddValidator(newValidators[0].Address, ethAddresses[0], genValidators[0].Address)
+		ethAddress, ethSignature := NewEthAddressOwnership(t, chainID, newValidators[0].Address)
+		addMsg := poa.NewMsgAddValidator(newValidators[0].Address, ethAddress, ethSignature, genValidators[0].Address)
 		tx := GenTx([]sdk.Msg{addMsg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
 		CheckDeliverSpecificErrorTx(t, app, tx, core.ErrOnlyMultisigMsgs)
 	}
 }
 
+// Checks validator heartbeat Tx and inactive validators query.
+func TestPOAApp_Heartbeat(t *testing.T) {
+	t.Parallel()
+
+	app, appStop := NewTestDnAppMockVM()
+	defer appStop()
+
+	genValidators, _, _, genPrivKeys := CreateGenAccounts(7, GenDefCoins(t))
+	CheckSetGenesisMockVM(t, app, genValidators)
+
+	// ok: heartbeat from validator
+	{
+		senderAcc, senderPrivKey := GetAccountCheckTx(app, genValidators[0].Address), genPrivKeys[0]
+		msg := poa.NewMsgHeartbeat(genValidators[0].Address)
+		tx := GenTx([]sdk.Msg{msg}, []uint64{senderAcc.GetAccountNumber()}, []uint64{senderAcc.GetSequence()}, senderPrivKey)
+		CheckDeliverTx(t, app, tx)
+	}
+
+	// check inactive query (auto removal is disabled by default)
+	{
+		response := poa.ValidatorLivenesses{}
+		CheckRunQuery(t, app, nil, queryPoaGetInactivePath, &response)
+		require.Empty(t, response)
+	}
+}
+
 // Test poa module queries.
 func TestPOAApp_Queries(t *testing.T) {
 	t.Parallel()
@@ -85,6 +112,14 @@ func TestPOAApp_ValidatorsAdd(t *testing.T) {
 	genValidators, genPrivKeys, newValidators := accs[:7], privKeys[:7], accs[7:]
 	CheckSetGenesisMockVM(t, app, genValidators)
 
+	// fail: ethSignature for other chain ID
+	{
+		ethAddress, ethSignature := NewEthAddressOwnership(t, "other-chain", newValidators[0].Address)
+		addMsg := poa.NewMsgAddValidator(newValidators[0].Address, ethAddress, ethSignature, genValidators[0].Address)
+		res, err := MSMsgSubmitAndVote(t, app, "addValidator:otherChain", addMsg, 0, genValidators, genPrivKeys, false)
+		CheckResultError(t, poa.ErrWrongEthereumSignature, res, err)
+	}
+
 	// add new validators
 	curConfirmCnt := app.poaKeeper.GetEnoughConfirmations(GetContext(app, true))
 	{
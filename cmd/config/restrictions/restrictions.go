@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/dfinance/dnode/cmd/config/genesis/defaults"
-	"github.com/dfinance/dnode/x/currencies"
 )
 
 // Custom restriction params for application
@@ -37,11 +36,7 @@ func GetAppRestrictions() AppRestrictions {
 	return AppRestrictions{
 		DisabledTxCmd: []string{},
 		DisabledQueryCmd: []string{},
-		MsgDeniedList: map[string][]string{
-			currencies.ModuleName: {
-				currencies.MsgWithdrawCurrency{}.Type(),
-			},
-		},
+		MsgDeniedList: map[string][]string{},
 		ParamsProposal: params.RestrictedParams{
 			params.RestrictedParam{Subspace: distribution.ModuleName, Key: string(distribution.ParamKeyValidatorsPoolTax)},
 			params.RestrictedParam{Subspace: distribution.ModuleName, Key: string(distribution.ParamKeyLiquidityProvidersPoolTax)},
@@ -35,6 +35,10 @@ import (
 	vmCli "github.com/dfinance/dnode/x/vm/client/cli"
 )
 
+const (
+	flagArchiveMsCalls = "archive-multisig-calls"
+)
+
 // @title Dfinance dnode REST API
 // @version 1.0
 
@@ -80,6 +84,11 @@ func main() {
 	)
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			cmd.Flags().Bool(flagArchiveMsCalls, false, "Move finished multisig calls to the archive on zero-height export (requires --for-zero-height)")
+		}
+	}
 
 	// configure crash logging
 	if err := logger.SetupSentry(version.ServerName, version.Version, version.Commit); err != nil {
@@ -122,11 +131,11 @@ func exportAppStateAndTMValidators(
 		if err != nil {
 			return nil, nil, err
 		}
-		return dnApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+		return dnApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList, viper.GetBool(flagArchiveMsCalls))
 	}
 
 	dnApp := app.NewDnServiceApp(logger, db, config, dnConfig.DefInvCheckPeriod, restrictions.GetAppRestrictions())
-	return dnApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	return dnApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList, viper.GetBool(flagArchiveMsCalls))
 }
 
 // Init cmd together with VM configruation.
@@ -19,6 +19,7 @@ require (
 	github.com/99designs/keyring v1.1.6
 	github.com/OneOfOne/xxhash v1.2.7
 	github.com/atlassian/go-sentry-api v0.0.0-20200117001222-a9ccec16c98b
+	github.com/btcsuite/btcd v0.20.1-beta
 	github.com/cosmos/cosmos-sdk v0.0.1
 	github.com/dfinance/dvm-proto/go v0.0.0-20201007122036-27be7297df4e
 	github.com/dfinance/glav v0.0.0-20200814081332-c4701f6c12a6
@@ -45,6 +46,7 @@ require (
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.33.7
 	github.com/tendermint/tm-db v0.5.1
+	golang.org/x/crypto v0.0.0-20200709230013-948cd5f35899
 	google.golang.org/grpc v1.30.0
 	google.golang.org/protobuf v1.24.0 // indirect
 	k8s.io/apimachinery v0.18.6 // indirect
@@ -1,11 +1,18 @@
 package helpers
 
 import (
+	"crypto/ecdsa"
 	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"golang.org/x/crypto/sha3"
 )
 
 const (
-	EthAddressLength = 20
+	EthAddressLength   = 20
+	EthSignatureLength = 65
 )
 
 // Check it's hex
@@ -23,3 +30,118 @@ func IsEthereumAddress(address string) bool {
 	s := address[2:]
 	return len(s) == 2*EthAddressLength && isHex(s)
 }
+
+// IsEthereumAddressChecksumValid checks EIP-55 mixed-case checksum of the ethereum address.
+// All lower / upper case addresses have no checksum and are considered valid.
+// Contract: address passed IsEthereumAddress check.
+func IsEthereumAddressChecksumValid(address string) bool {
+	s := address[2:]
+	if s == strings.ToLower(s) || s == strings.ToUpper(s) {
+		return true
+	}
+
+	return address == ToEthereumChecksumAddress(address)
+}
+
+// ToEthereumChecksumAddress converts ethereum address to the EIP-55 mixed-case checksum form.
+// Contract: address passed IsEthereumAddress check.
+func ToEthereumChecksumAddress(address string) string {
+	s := strings.ToLower(address[2:])
+	hash := keccak256([]byte(s))
+
+	result := []byte(s)
+	for i := range result {
+		hashNibble := hash[i/2]
+		if i%2 == 0 {
+			hashNibble >>= 4
+		}
+		if result[i] >= 'a' && hashNibble&0xF >= 8 {
+			result[i] -= 'a' - 'A'
+		}
+	}
+
+	return "0x" + string(result)
+}
+
+// EthereumPersonalMessageHash returns EIP-191 "personal_sign" hash for the message.
+func EthereumPersonalMessageHash(msg []byte) []byte {
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(msg))
+
+	return keccak256([]byte(prefix), msg)
+}
+
+// RecoverEthereumAddress recovers ethereum address (checksum form) from the "personal_sign" message signature.
+// Signature is a hex string of [R || S || V] bytes, V is 0 / 1 or 27 / 28.
+func RecoverEthereumAddress(msg []byte, signature string) (string, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("signature: hex decode: %w", err)
+	}
+	if len(sig) != EthSignatureLength {
+		return "", fmt.Errorf("signature: invalid length %d (%d expected)", len(sig), EthSignatureLength)
+	}
+
+	v := sig[EthSignatureLength-1]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return "", fmt.Errorf("signature: invalid recovery ID %d", sig[EthSignatureLength-1])
+	}
+
+	// btcec compact signature format: [27 + V || R || S]
+	btcSig := make([]byte, EthSignatureLength)
+	btcSig[0] = 27 + v
+	copy(btcSig[1:], sig[:EthSignatureLength-1])
+
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), btcSig, EthereumPersonalMessageHash(msg))
+	if err != nil {
+		return "", fmt.Errorf("signature: recover: %w", err)
+	}
+
+	return EthereumPubKeyToAddress(pubKey.ToECDSA()), nil
+}
+
+// SignEthereumMessage creates the "personal_sign" message signature (hex string of [R || S || V] bytes, V is 27 / 28).
+// Returns signature and signer ethereum address (checksum form).
+func SignEthereumMessage(privKey *ecdsa.PrivateKey, msg []byte) (signature, address string, err error) {
+	btcSig, err := btcec.SignCompact(btcec.S256(), (*btcec.PrivateKey)(privKey), EthereumPersonalMessageHash(msg), false)
+	if err != nil {
+		return "", "", fmt.Errorf("signing: %w", err)
+	}
+
+	// [27 + V || R || S] => [R || S || V]
+	sig := make([]byte, EthSignatureLength)
+	copy(sig, btcSig[1:])
+	sig[EthSignatureLength-1] = btcSig[0]
+
+	return "0x" + hex.EncodeToString(sig), EthereumPubKeyToAddress(&privKey.PublicKey), nil
+}
+
+// NewEthereumPrivateKey generates a new secp256k1 ethereum private key.
+func NewEthereumPrivateKey() (*ecdsa.PrivateKey, error) {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return privKey.ToECDSA(), nil
+}
+
+// EthereumPubKeyToAddress converts secp256k1 public key to ethereum address (checksum form).
+func EthereumPubKeyToAddress(pubKey *ecdsa.PublicKey) string {
+	pubKeyBz := (*btcec.PublicKey)(pubKey).SerializeUncompressed()
+	hash := keccak256(pubKeyBz[1:])
+
+	return ToEthereumChecksumAddress("0x" + hex.EncodeToString(hash[len(hash)-EthAddressLength:]))
+}
+
+// keccak256 returns Keccak-256 (legacy, pre-SHA3 padding) hash of data.
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+
+	return h.Sum(nil)
+}
@@ -333,6 +333,7 @@ func (ct *CLITester) RestQueryCurrenciesIssueStdTx(creatorAccName, payeeAccName,
 		ID:      issueID,
 		Coin:    coin,
 		Payee:   payeeAccInfo.Address,
+		Deposit: NewCurrenciesIssueDeposit(issueID),
 	}
 
 	reqSubPath := fmt.Sprintf("%s/%s", currencies.ModuleName, "issue")
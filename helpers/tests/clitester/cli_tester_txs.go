@@ -1,6 +1,7 @@
 package clitester
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/currencies"
 	"github.com/dfinance/dnode/x/orders"
 )
 
@@ -40,7 +42,14 @@ func (ct *CLITester) TxStake(fromAddr, validator string, amount int64, denom str
 	return r
 }
 
+// NewCurrenciesIssueDeposit creates a source chain deposit info unique for the issueID.
+func NewCurrenciesIssueDeposit(issueID string) currencies.IssueDeposit {
+	return currencies.NewIssueDeposit("ethereum", "0x"+hex.EncodeToString([]byte(issueID)), 0, "0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1")
+}
+
 func (ct *CLITester) TxCurrenciesIssue(payeeAddr, fromAddr, issueID, denom string, amount sdk.Int) *TxRequest {
+	deposit := NewCurrenciesIssueDeposit(issueID)
+
 	r := ct.newTxRequest()
 	r.SetCmd(
 		"currencies",
@@ -48,7 +57,11 @@ func (ct *CLITester) TxCurrenciesIssue(payeeAddr, fromAddr, issueID, denom strin
 		"ms-issue",
 		issueID,
 		sdk.NewCoin(denom, amount).String(),
-		payeeAddr)
+		payeeAddr,
+		deposit.ChainID,
+		deposit.TxHash,
+		strconv.FormatUint(deposit.LogIndex, 10),
+		deposit.Sender)
 
 	return r
 }
@@ -66,7 +79,7 @@ func (ct *CLITester) TxCurrenciesWithdraw(recipientAddr, fromAddr, denom string,
 	return r
 }
 
-func (ct *CLITester) TxPoaAddValidator(fromAddr, address, ethAddress, issueID string) *TxRequest {
+func (ct *CLITester) TxPoaAddValidator(fromAddr, address, ethAddress, ethSignature, issueID string) *TxRequest {
 	r := ct.newTxRequest()
 	r.SetCmd(
 		"poa",
@@ -75,6 +88,7 @@ func (ct *CLITester) TxPoaAddValidator(fromAddr, address, ethAddress, issueID st
 		issueID,
 		address,
 		ethAddress,
+		ethSignature,
 	)
 
 	return r
@@ -93,7 +107,7 @@ func (ct *CLITester) TxPoaRemoveValidator(fromAddr, address, issueID string) *Tx
 	return r
 }
 
-func (ct *CLITester) TxPoaReplaceValidator(fromAddr, targetAddress, address, ethAddress, issueID string) *TxRequest {
+func (ct *CLITester) TxPoaReplaceValidator(fromAddr, targetAddress, address, ethAddress, ethSignature, issueID string) *TxRequest {
 	r := ct.newTxRequest()
 	r.SetCmd(
 		"poa",
@@ -103,6 +117,7 @@ func (ct *CLITester) TxPoaReplaceValidator(fromAddr, targetAddress, address, eth
 		targetAddress,
 		address,
 		ethAddress,
+		ethSignature,
 	)
 
 	return r
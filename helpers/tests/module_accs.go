@@ -11,6 +11,7 @@ var (
 	MAccPerms map[string][]string = map[string][]string{
 		auth.FeeCollectorName:     nil,
 		"orders":                  {supply.Burner},
+		"orders_fees":             nil,
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		staking.LiquidityPoolName: {supply.Staking},
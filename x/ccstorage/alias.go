@@ -6,15 +6,16 @@ import (
 )
 
 type (
-	Keeper          = keeper.Keeper
-	GenesisState    = types.GenesisState
-	Currency        = types.Currency
-	Currencies      = types.Currencies
-	CurrencyParams  = types.CurrencyParams
-	ResCurrencyInfo = types.ResCurrencyInfo
-	ResBalance      = types.ResBalance
-	Balance         = types.Balance
-	Balances        = types.Balances
+	Keeper            = keeper.Keeper
+	GenesisState      = types.GenesisState
+	Currency          = types.Currency
+	Currencies        = types.Currencies
+	CurrencyParams    = types.CurrencyParams
+	ResCurrencyInfo   = types.ResCurrencyInfo
+	ResCurrencyStatus = types.ResCurrencyStatus
+	ResBalance        = types.ResBalance
+	Balance           = types.Balance
+	Balances          = types.Balances
 	//
 	SquashOptions = keeper.SquashOptions
 )
@@ -23,11 +24,18 @@ const (
 	ModuleName = types.ModuleName
 	StoreKey   = types.StoreKey
 	// Event types, attribute types and values
-	EventTypesCreate = types.EventTypesCreate
+	EventTypesCreate  = types.EventTypesCreate
+	EventTypesUpdate  = types.EventTypesUpdate
+	EventTypesPause   = types.EventTypesPause
+	EventTypesUnpause = types.EventTypesUnpause
 	//
-	AttributeDenom    = types.AttributeDenom
-	AttributeDecimals = types.AttributeDecimals
-	AttributeInfoPath = types.AttributeInfoPath
+	AttributeDenom           = types.AttributeDenom
+	AttributeDecimals        = types.AttributeDecimals
+	AttributeInfoPath        = types.AttributeInfoPath
+	AttributeContractAddress = types.AttributeContractAddress
+	AttributeName            = types.AttributeName
+	AttributeDescription     = types.AttributeDescription
+	AttributeLogoURI         = types.AttributeLogoURI
 )
 
 var (
@@ -38,11 +46,14 @@ var (
 	NewKeeper           = keeper.NewKeeper
 	DefaultGenesisState = types.DefaultGenesisState
 	//
-	NewEmptySquashOptions = keeper.NewEmptySquashOptions
+	NewEmptySquashOptions    = keeper.NewEmptySquashOptions
+	ValidateCurrencyMetadata = types.ValidateCurrencyMetadata
+	NewResCurrencyStatus     = types.NewResCurrencyStatus
 	// perms requests
 	RequestVMStoragePerms = types.RequestVMStoragePerms
 	// errors
 	ErrInternal    = types.ErrInternal
 	ErrWrongDenom  = types.ErrWrongDenom
 	ErrWrongParams = types.ErrWrongParams
+	ErrPaused      = types.ErrPaused
 )
@@ -27,6 +27,7 @@ func (k Keeper) CreateCurrency(ctx sdk.Context, params types.CurrencyParams) err
 	// store currency objects
 	k.storeCurrency(ctx, currency)
 	k.storeResStdCurrencyInfo(ctx, currency)
+	k.storeResStdCurrencyStatus(ctx, currency)
 
 	ctx.EventManager().EmitEvent(types.NewCCCreatedEvent(currency))
 
@@ -104,6 +105,82 @@ func (k Keeper) DecreaseCurrencySupply(ctx sdk.Context, coin sdk.Coin) error {
 	return nil
 }
 
+// UpdateCurrencyContract updates currency ERC20 contract address.
+func (k Keeper) UpdateCurrencyContract(ctx sdk.Context, denom, contractAddress string) error {
+	k.modulePerms.AutoCheck(types.PermUpdate)
+
+	currency, err := k.GetCurrency(ctx, denom)
+	if err != nil {
+		return err
+	}
+	currency.ContractAddress = contractAddress
+
+	k.storeCurrency(ctx, currency)
+	k.storeResStdCurrencyStatus(ctx, currency)
+
+	ctx.EventManager().EmitEvent(types.NewCCUpdatedEvent(currency))
+
+	return nil
+}
+
+// SetCurrencyMetadata updates currency display metadata.
+func (k Keeper) SetCurrencyMetadata(ctx sdk.Context, denom, name, description, logoURI string) error {
+	k.modulePerms.AutoCheck(types.PermUpdate)
+
+	if err := types.ValidateCurrencyMetadata(name, description, logoURI); err != nil {
+		return sdkErrors.Wrapf(types.ErrWrongParams, "currency %q: %v", denom, err)
+	}
+
+	currency, err := k.GetCurrency(ctx, denom)
+	if err != nil {
+		return err
+	}
+	currency.Name, currency.Description, currency.LogoURI = name, description, logoURI
+
+	k.storeCurrency(ctx, currency)
+	k.storeResStdCurrencyStatus(ctx, currency)
+
+	ctx.EventManager().EmitEvent(types.NewCCUpdatedEvent(currency))
+
+	return nil
+}
+
+// SetCurrencyPaused pauses / unpauses currency.
+func (k Keeper) SetCurrencyPaused(ctx sdk.Context, denom string, paused bool) error {
+	k.modulePerms.AutoCheck(types.PermUpdate)
+
+	currency, err := k.GetCurrency(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if currency.Paused == paused {
+		return sdkErrors.Wrapf(types.ErrWrongParams, "currency %q: paused state is already %t", denom, paused)
+	}
+	currency.Paused = paused
+
+	k.storeCurrency(ctx, currency)
+	k.storeResStdCurrencyStatus(ctx, currency)
+
+	ctx.EventManager().EmitEvent(types.NewCCPausedEvent(currency))
+
+	return nil
+}
+
+// CheckCurrencyActive returns error if currency doesn't exist or is paused.
+func (k Keeper) CheckCurrencyActive(ctx sdk.Context, denom string) error {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	currency, err := k.GetCurrency(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if currency.Paused {
+		return sdkErrors.Wrapf(types.ErrPaused, "currency %q", denom)
+	}
+
+	return nil
+}
+
 // getCurrency returns currency from the storage
 func (k Keeper) getCurrency(ctx sdk.Context, denom string) types.Currency {
 	store := ctx.KVStore(k.storeKey)
@@ -48,6 +48,12 @@ func TestCCSKeeper_CreateCurrency(t *testing.T) {
 		require.EqualValues(t, 0, curInfo.TotalSupply.Uint64())
 		require.False(t, curInfo.IsToken)
 
+		// check currencyStatus
+		curStatus, err := keeper.GetResStdCurrencyStatus(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, types.NewResCurrencyStatus(currency), curStatus)
+		require.False(t, curStatus.Paused)
+
 		// check VM paths
 		curBalancePath := glav.BalanceVector(denom)
 		curInfoPath := glav.CurrencyInfoVector(denom)
@@ -163,6 +169,145 @@ func TestCCSKeeper_Supply(t *testing.T) {
 	}
 }
 
+// Test keeper UpdateCurrencyContract / SetCurrencyMetadata methods.
+func TestCCSKeeper_UpdateCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	// create currency
+	params := types.CurrencyParams{
+		Denom:    "test",
+		Decimals: uint8(8),
+	}
+	denom := params.Denom
+
+	err := keeper.CreateCurrency(ctx, params)
+	require.NoError(t, err)
+
+	initCurInfo, err := keeper.GetResStdCurrencyInfo(ctx, denom)
+	require.NoError(t, err)
+
+	// ok: contract address
+	{
+		contractAddress := "0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1"
+		require.NoError(t, keeper.UpdateCurrencyContract(ctx, denom, contractAddress))
+
+		currency, err := keeper.GetCurrency(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, contractAddress, currency.ContractAddress)
+
+		curInfo, err := keeper.GetResStdCurrencyInfo(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, initCurInfo, curInfo)
+
+		curStatus, err := keeper.GetResStdCurrencyStatus(ctx, denom)
+		require.NoError(t, err)
+		require.EqualValues(t, contractAddress, curStatus.ContractAddress)
+	}
+
+	// ok: metadata
+	{
+		require.NoError(t, keeper.SetCurrencyMetadata(ctx, denom, "Test", "Test currency", "https://dfinance.co/test.png"))
+
+		currency, err := keeper.GetCurrency(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, "Test", currency.Name)
+		require.Equal(t, "Test currency", currency.Description)
+		require.Equal(t, "https://dfinance.co/test.png", currency.LogoURI)
+
+		curInfo, err := keeper.GetResStdCurrencyInfo(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, initCurInfo, curInfo)
+
+		curStatus, err := keeper.GetResStdCurrencyStatus(ctx, denom)
+		require.NoError(t, err)
+		require.EqualValues(t, currency.Name, curStatus.Name)
+		require.EqualValues(t, currency.Description, curStatus.Description)
+		require.EqualValues(t, currency.LogoURI, curStatus.LogoURI)
+		require.EqualValues(t, currency.ContractAddress, curStatus.ContractAddress)
+	}
+
+	// fail: invalid metadata
+	{
+		err := keeper.SetCurrencyMetadata(ctx, denom, "Test", "", "test.png")
+		require.True(t, types.ErrWrongParams.Is(err))
+	}
+
+	// fail: non-existing currency
+	{
+		require.True(t, types.ErrWrongDenom.Is(keeper.UpdateCurrencyContract(ctx, "invalid", "")))
+		require.True(t, types.ErrWrongDenom.Is(keeper.SetCurrencyMetadata(ctx, "invalid", "", "", "")))
+	}
+}
+
+// Test keeper SetCurrencyPaused / CheckCurrencyActive methods.
+func TestCCSKeeper_PauseCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	// create currency
+	params := types.CurrencyParams{
+		Denom:    "test",
+		Decimals: uint8(8),
+	}
+	denom := params.Denom
+
+	err := keeper.CreateCurrency(ctx, params)
+	require.NoError(t, err)
+	require.NoError(t, keeper.CheckCurrencyActive(ctx, denom))
+
+	initCurInfo, err := keeper.GetResStdCurrencyInfo(ctx, denom)
+	require.NoError(t, err)
+
+	// fail: already unpaused
+	{
+		require.True(t, types.ErrWrongParams.Is(keeper.SetCurrencyPaused(ctx, denom, false)))
+	}
+
+	// ok: pause
+	{
+		require.NoError(t, keeper.SetCurrencyPaused(ctx, denom, true))
+		require.True(t, types.ErrPaused.Is(keeper.CheckCurrencyActive(ctx, denom)))
+
+		curInfo, err := keeper.GetResStdCurrencyInfo(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, initCurInfo, curInfo)
+
+		curStatus, err := keeper.GetResStdCurrencyStatus(ctx, denom)
+		require.NoError(t, err)
+		require.True(t, curStatus.Paused)
+	}
+
+	// fail: already paused
+	{
+		require.True(t, types.ErrWrongParams.Is(keeper.SetCurrencyPaused(ctx, denom, true)))
+	}
+
+	// ok: unpause
+	{
+		require.NoError(t, keeper.SetCurrencyPaused(ctx, denom, false))
+		require.NoError(t, keeper.CheckCurrencyActive(ctx, denom))
+
+		curInfo, err := keeper.GetResStdCurrencyInfo(ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, initCurInfo, curInfo)
+
+		curStatus, err := keeper.GetResStdCurrencyStatus(ctx, denom)
+		require.NoError(t, err)
+		require.False(t, curStatus.Paused)
+	}
+
+	// fail: non-existing currency
+	{
+		require.True(t, types.ErrWrongDenom.Is(keeper.SetCurrencyPaused(ctx, "invalid", true)))
+		require.True(t, types.ErrWrongDenom.Is(keeper.CheckCurrencyActive(ctx, "invalid")))
+	}
+}
+
 func TestCCSKeeper_GetCurrencies(t *testing.T) {
 	t.Parallel()
 
@@ -32,8 +32,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 	for _, currency := range k.GetCurrencies(ctx) {
 		state.CurrenciesParams = append(state.CurrenciesParams, types.CurrencyParams{
-			Denom:    currency.Denom,
-			Decimals: currency.Decimals,
+			Denom:           currency.Denom,
+			Decimals:        currency.Decimals,
+			ContractAddress: currency.ContractAddress,
+			Name:            currency.Name,
+			Description:     currency.Description,
+			LogoURI:         currency.LogoURI,
+			Paused:          currency.Paused,
 		})
 	}
 
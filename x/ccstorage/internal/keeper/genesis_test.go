@@ -44,6 +44,9 @@ func TestCCSKeeper_ExportGenesis(t *testing.T) {
 	input := NewTestInput(t)
 	ctx, keeper := input.ctx, input.keeper
 
+	require.NoError(t, keeper.SetCurrencyMetadata(ctx, "btc", "Bitcoin", "", ""))
+	require.NoError(t, keeper.SetCurrencyPaused(ctx, "btc", true))
+
 	state := types.GenesisState{}
 	bz := keeper.ExportGenesis(ctx)
 	input.cdc.MustUnmarshalJSON(bz, &state)
@@ -52,7 +55,7 @@ func TestCCSKeeper_ExportGenesis(t *testing.T) {
 		foundCnt, foundIdx := 0, 0
 		for i, expParams := range state.CurrenciesParams {
 			if curParams.Denom == expParams.Denom {
-				require.Equal(t, expParams.Decimals, curParams.Decimals)
+				require.Equal(t, expParams, curParams)
 
 				foundCnt++
 				foundIdx = i
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/lcs"
+
+	"github.com/dfinance/dnode/x/ccstorage/internal/types"
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// GetResStdCurrencyStatus returns VM currencyStatus for stdlib currencies (non-token).
+func (k Keeper) GetResStdCurrencyStatus(ctx sdk.Context, denom string) (types.ResCurrencyStatus, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	accessPath := &vm_grpc.VMAccessPath{
+		Address: common_vm.StdLibAddress,
+		Path:    types.CurrencyStatusVector(denom),
+	}
+
+	if !k.vmKeeper.HasValue(ctx, accessPath) {
+		return types.ResCurrencyStatus{}, sdkErrors.Wrapf(types.ErrInternal, "currencyStatus for %q: nof found in VM storage", denom)
+	}
+
+	currencyStatus := types.ResCurrencyStatus{}
+	bz := k.vmKeeper.GetValue(ctx, accessPath)
+	if err := lcs.Unmarshal(bz, &currencyStatus); err != nil {
+		return types.ResCurrencyStatus{}, sdkErrors.Wrapf(types.ErrInternal, "currencyStatus for %q: lcs unmarshal: %v", denom, err)
+	}
+
+	return currencyStatus, nil
+}
+
+// storeResStdCurrencyStatus sets currencyStatus to the VM storage.
+func (k Keeper) storeResStdCurrencyStatus(ctx sdk.Context, currency types.Currency) {
+	bz, err := lcs.Marshal(types.NewResCurrencyStatus(currency))
+	if err != nil {
+		panic(fmt.Errorf("currency %q: lcs marshal: %v", currency.Denom, err))
+	}
+
+	accessPath := &vm_grpc.VMAccessPath{
+		Address: common_vm.StdLibAddress,
+		Path:    currency.StatusPath(),
+	}
+
+	k.vmKeeper.SetValue(ctx, accessPath, bz)
+}
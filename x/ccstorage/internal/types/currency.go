@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"net/url"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dfinance/glav"
@@ -20,6 +21,14 @@ type Currency struct {
 	Supply sdk.Int `json:"supply" yaml:"supply" swaggertype:"string" example:"100"`
 	// ERC20 contract address
 	ContractAddress string `json:"contract_address" yaml:"contract_address"`
+	// Display name
+	Name string `json:"name" yaml:"name" example:"Dfinance XFI"`
+	// Description
+	Description string `json:"description" yaml:"description"`
+	// Logo URI
+	LogoURI string `json:"logo_uri" yaml:"logo_uri" example:"https://dfinance.co/xfi.png"`
+	// Paused currency can't be issued, withdrawn and traded
+	Paused bool `json:"paused" yaml:"paused"`
 }
 
 // Valid checks that Currency is valid.
@@ -27,6 +36,9 @@ func (c Currency) Valid() error {
 	if err := dnTypes.DenomFilter(c.Denom); err != nil {
 		return fmt.Errorf("denom is invalid: %v", err)
 	}
+	if err := ValidateCurrencyMetadata(c.Name, c.Description, c.LogoURI); err != nil {
+		return fmt.Errorf("metadata is invalid: %v", err)
+	}
 
 	return nil
 }
@@ -56,6 +68,16 @@ func (c Currency) InfoPathHex() string {
 	return hex.EncodeToString(c.InfoPath())
 }
 
+// StatusPath return []byte representation for StatusPath.
+func (c Currency) StatusPath() []byte {
+	return CurrencyStatusVector(c.Denom)
+}
+
+// StatusPathHex return string representation for StatusPath.
+func (c Currency) StatusPathHex() string {
+	return hex.EncodeToString(c.StatusPath())
+}
+
 // UintToDec converts sdk.Uint to sdk.Dec using currency decimals.
 func (c Currency) UintToDec(quantity sdk.Uint) sdk.Dec {
 	return sdk.NewDecFromIntWithPrec(sdk.Int(quantity), int64(c.Decimals))
@@ -78,14 +100,46 @@ func (c Currency) String() string {
 		"  Denom:    %s\n"+
 		"  Decimals: %d\n"+
 		"  Contract Address: %s\n"+
-		"  Supply:   %s",
+		"  Supply:   %s\n"+
+		"  Name:     %s\n"+
+		"  Description: %s\n"+
+		"  Logo URI: %s\n"+
+		"  Paused:   %t",
 		c.Denom,
 		c.Decimals,
 		c.ContractAddress,
 		c.Supply.String(),
+		c.Name,
+		c.Description,
+		c.LogoURI,
+		c.Paused,
 	)
 }
 
+// ValidateCurrencyMetadata checks currency display metadata (empty values are allowed).
+func ValidateCurrencyMetadata(name, description, logoURI string) error {
+	if len(name) > CurrencyNameMaxLength {
+		return fmt.Errorf("name: length GT %d", CurrencyNameMaxLength)
+	}
+	if len(description) > CurrencyDescriptionMaxLength {
+		return fmt.Errorf("description: length GT %d", CurrencyDescriptionMaxLength)
+	}
+	if len(logoURI) > CurrencyLogoURIMaxLength {
+		return fmt.Errorf("logo_uri: length GT %d", CurrencyLogoURIMaxLength)
+	}
+	if logoURI != "" {
+		uri, err := url.ParseRequestURI(logoURI)
+		if err != nil {
+			return fmt.Errorf("logo_uri: %w", err)
+		}
+		if uri.Scheme == "" || uri.Host == "" {
+			return fmt.Errorf("logo_uri: absolute URI expected")
+		}
+	}
+
+	return nil
+}
+
 // Currencies is a slice of Currency objects.
 type Currencies []Currency
 
@@ -97,6 +151,10 @@ func (list Currencies) ToParams() CurrenciesParams {
 			Denom:           currency.Denom,
 			Decimals:        currency.Decimals,
 			ContractAddress: currency.ContractAddress,
+			Name:            currency.Name,
+			Description:     currency.Description,
+			LogoURI:         currency.LogoURI,
+			Paused:          currency.Paused,
 		})
 	}
 
@@ -110,5 +168,9 @@ func NewCurrency(params CurrencyParams, supply sdk.Int) Currency {
 		Decimals:        params.Decimals,
 		Supply:          supply,
 		ContractAddress: params.ContractAddress,
+		Name:            params.Name,
+		Description:     params.Description,
+		LogoURI:         params.LogoURI,
+		Paused:          params.Paused,
 	}
 }
@@ -3,6 +3,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,3 +49,22 @@ func TestCCS_Currency_Valid(t *testing.T) {
 		require.Contains(t, err.Error(), "invalid")
 	}
 }
+
+func TestCCS_ValidateCurrencyMetadata(t *testing.T) {
+	t.Parallel()
+
+	// ok: empty
+	require.NoError(t, ValidateCurrencyMetadata("", "", ""))
+	// ok
+	require.NoError(t, ValidateCurrencyMetadata("Bitcoin", "Wrapped BTC", "https://dfinance.co/btc.png"))
+	require.NoError(t, ValidateCurrencyMetadata("Bitcoin", "", "ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco"))
+
+	// fail: too long
+	require.Error(t, ValidateCurrencyMetadata(strings.Repeat("a", CurrencyNameMaxLength+1), "", ""))
+	require.Error(t, ValidateCurrencyMetadata("", strings.Repeat("a", CurrencyDescriptionMaxLength+1), ""))
+	require.Error(t, ValidateCurrencyMetadata("", "", "https://dfinance.co/"+strings.Repeat("a", CurrencyLogoURIMaxLength)))
+
+	// fail: invalid URI
+	require.Error(t, ValidateCurrencyMetadata("", "", "btc.png"))
+	require.Error(t, ValidateCurrencyMetadata("", "", "/btc.png"))
+}
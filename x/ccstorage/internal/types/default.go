@@ -4,3 +4,10 @@ const (
 	ModuleName = "ccstorage"
 	StoreKey   = ModuleName
 )
+
+const (
+	// Currency metadata max lengths
+	CurrencyNameMaxLength        = 64
+	CurrencyDescriptionMaxLength = 256
+	CurrencyLogoURIMaxLength     = 256
+)
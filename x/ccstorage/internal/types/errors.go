@@ -8,4 +8,5 @@ var (
 	ErrInternal    = sdkErrors.Register(ModuleName, 100, "internal")
 	ErrWrongDenom  = sdkErrors.Register(ModuleName, 101, "wrong denom")
 	ErrWrongParams = sdkErrors.Register(ModuleName, 102, "invalid currency params")
+	ErrPaused      = sdkErrors.Register(ModuleName, 103, "currency is paused")
 )
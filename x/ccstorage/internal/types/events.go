@@ -7,12 +7,18 @@ import (
 )
 
 const (
-	EventTypesCreate = ModuleName + ".create"
+	EventTypesCreate  = ModuleName + ".create"
+	EventTypesUpdate  = ModuleName + ".update"
+	EventTypesPause   = ModuleName + ".pause"
+	EventTypesUnpause = ModuleName + ".unpause"
 	//
 	AttributeDenom           = "denom"
 	AttributeDecimals        = "decimals"
 	AttributeInfoPath        = "info_path"
 	AttributeContractAddress = "contract_address"
+	AttributeName            = "name"
+	AttributeDescription     = "description"
+	AttributeLogoURI         = "logo_uri"
 )
 
 // NewCCCreatedEvent creates an Event on currency creation.
@@ -25,3 +31,28 @@ func NewCCCreatedEvent(currency Currency) sdk.Event {
 		sdk.NewAttribute(AttributeContractAddress, currency.ContractAddress),
 	)
 }
+
+// NewCCUpdatedEvent creates an Event on currency contract address / metadata update.
+func NewCCUpdatedEvent(currency Currency) sdk.Event {
+	return sdk.NewEvent(
+		EventTypesUpdate,
+		sdk.NewAttribute(AttributeDenom, currency.Denom),
+		sdk.NewAttribute(AttributeContractAddress, currency.ContractAddress),
+		sdk.NewAttribute(AttributeName, currency.Name),
+		sdk.NewAttribute(AttributeDescription, currency.Description),
+		sdk.NewAttribute(AttributeLogoURI, currency.LogoURI),
+	)
+}
+
+// NewCCPausedEvent creates an Event on currency pause / unpause.
+func NewCCPausedEvent(currency Currency) sdk.Event {
+	eventType := EventTypesUnpause
+	if currency.Paused {
+		eventType = EventTypesPause
+	}
+
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeDenom, currency.Denom),
+	)
+}
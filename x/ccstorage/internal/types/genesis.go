@@ -37,6 +37,14 @@ type CurrencyParams struct {
 	Decimals uint8 `json:"decimals" yaml:"decimals"`
 	// ERC20 contract address
 	ContractAddress string `json:"contract_address" yaml:"contract_address"`
+	// Display name
+	Name string `json:"name" yaml:"name"`
+	// Description
+	Description string `json:"description" yaml:"description"`
+	// Logo URI
+	LogoURI string `json:"logo_uri" yaml:"logo_uri"`
+	// Paused currency can't be issued, withdrawn and traded
+	Paused bool `json:"paused" yaml:"paused"`
 }
 
 // Validate check that params are valid.
@@ -44,6 +52,9 @@ func (c CurrencyParams) Validate() error {
 	if err := dnTypes.DenomFilter(c.Denom); err != nil {
 		return fmt.Errorf("denom: %w", err)
 	}
+	if err := ValidateCurrencyMetadata(c.Name, c.Description, c.LogoURI); err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
 	return nil
 }
 
@@ -14,13 +14,19 @@ func TestCCS_GenesisParams_Validate(t *testing.T) {
 
 	// ok
 	{
-		param := CurrencyParams{"xfi", 0, ""}
+		param := CurrencyParams{Denom: "xfi", Decimals: 0}
 		require.NoError(t, param.Validate())
 	}
 
 	// fail: invalid denom
 	{
-		param1 := CurrencyParams{"xfi1", 0, ""}
+		param1 := CurrencyParams{Denom: "xfi1", Decimals: 0}
+		require.Error(t, param1.Validate())
+	}
+
+	// fail: invalid metadata
+	{
+		param1 := CurrencyParams{Denom: "xfi", Decimals: 0, LogoURI: "xfi.png"}
 		require.Error(t, param1.Validate())
 	}
 }
@@ -12,6 +12,7 @@ import (
 // ResCurrencyInfo is a DVM resource, containing Currency meta-data.
 // For standard currencies, CurrencyInfo is converted from Currency.
 // For token currencies, CurrencyInfo is created by VM.
+// Layout must match the stdlib Dfinance::Info resource (LCS serialization), currency metadata is stored in Currency.
 type ResCurrencyInfo struct {
 	// Currency denom ([]byte is used for VM)
 	Denom []byte `json:"denom" yaml:"denom" swaggertype:"string" example:"xfi"`
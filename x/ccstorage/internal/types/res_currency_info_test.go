@@ -3,9 +3,11 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/lcs"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/common_vm"
@@ -17,8 +19,13 @@ func TestCCS_NewCurrencyInfo(t *testing.T) {
 
 	currency := NewCurrency(
 		CurrencyParams{
-			Denom:    "test",
-			Decimals: 4,
+			Denom:           "test",
+			Decimals:        4,
+			ContractAddress: "0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1",
+			Name:            "Test",
+			Description:     "Test currency",
+			LogoURI:         "https://dfinance.co/test.png",
+			Paused:          true,
 		},
 		sdk.NewIntFromUint64(100),
 	)
@@ -55,3 +62,44 @@ func TestCCS_NewCurrencyInfo(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+// Test ResCurrencyInfo LCS layout matches the stdlib Dfinance::Info resource.
+func TestCCS_ResCurrencyInfoLayout(t *testing.T) {
+	t.Parallel()
+
+	type stdInfo struct {
+		Denom       []byte
+		Decimals    uint8
+		IsToken     bool
+		Owner       []byte `lcs:"len=20"`
+		TotalSupply *big.Int
+	}
+
+	stdCurInfo := stdInfo{
+		Denom:       []byte("test"),
+		Decimals:    4,
+		IsToken:     false,
+		Owner:       common_vm.StdLibAddress,
+		TotalSupply: big.NewInt(100),
+	}
+	stdBz, err := lcs.Marshal(stdCurInfo)
+	require.NoError(t, err)
+
+	currency := NewCurrency(CurrencyParams{Denom: "test", Decimals: 4, Name: "Test", Paused: true}, sdk.NewIntFromUint64(100))
+	curInfo, err := NewResCurrencyInfo(currency, common_vm.StdLibAddress)
+	require.NoError(t, err)
+
+	// ok: serialization is byte-identical
+	{
+		bz, err := lcs.Marshal(curInfo)
+		require.NoError(t, err)
+		require.Equal(t, stdBz, bz)
+	}
+
+	// ok: stdlib resource deserialization
+	{
+		decodedCurInfo := ResCurrencyInfo{}
+		require.NoError(t, lcs.Unmarshal(stdBz, &decodedCurInfo))
+		require.Equal(t, curInfo, decodedCurInfo)
+	}
+}
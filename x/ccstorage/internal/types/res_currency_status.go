@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	// Stdlib struct name of the currency status resource (0x1::Dfinance::Status<Coin>)
+	StatusStruct = "Status"
+)
+
+// ResCurrencyStatus is a DVM resource, containing Currency state and metadata (stdlib 0x1::Dfinance::Status resource).
+// Resource is kept separately from the ResCurrencyInfo to keep the stdlib Dfinance::Info resource layout.
+// Fields order must match the stdlib struct:
+// resource struct Status<Coin> { paused: bool, contract_address: vector<u8>, name: vector<u8>, description: vector<u8>, logo_uri: vector<u8> }.
+type ResCurrencyStatus struct {
+	// Paused currency can't be issued, withdrawn and traded
+	Paused bool `json:"paused" yaml:"paused"`
+	// ERC20 contract address ([]byte is used for VM)
+	ContractAddress []byte `json:"contract_address" yaml:"contract_address" swaggertype:"string"`
+	// Display name ([]byte is used for VM)
+	Name []byte `json:"name" yaml:"name" swaggertype:"string"`
+	// Description ([]byte is used for VM)
+	Description []byte `json:"description" yaml:"description" swaggertype:"string"`
+	// Logo URI ([]byte is used for VM)
+	LogoURI []byte `json:"logo_uri" yaml:"logo_uri" swaggertype:"string"`
+}
+
+func (s ResCurrencyStatus) String() string {
+	return fmt.Sprintf("ResCurrencyStatus:\n"+
+		"  Paused:           %t\n"+
+		"  Contract address: %s\n"+
+		"  Name:             %s\n"+
+		"  Description:      %s\n"+
+		"  Logo URI:         %s",
+		s.Paused,
+		string(s.ContractAddress),
+		string(s.Name),
+		string(s.Description),
+		string(s.LogoURI),
+	)
+}
+
+// NewResCurrencyStatus converts Currency to VM's ResCurrencyStatus.
+func NewResCurrencyStatus(currency Currency) ResCurrencyStatus {
+	return ResCurrencyStatus{
+		Paused:          currency.Paused,
+		ContractAddress: []byte(currency.ContractAddress),
+		Name:            []byte(currency.Name),
+		Description:     []byte(currency.Description),
+		LogoURI:         []byte(currency.LogoURI),
+	}
+}
+
+// CurrencyStatusVector returns VM access path for the 0x1::Dfinance::Status<Coin> resource.
+func CurrencyStatusVector(denom string) []byte {
+	tag := glav.NewStructTag(stdLibVMAddress(), glav.DfinanceModule, StatusStruct, []glav.TypeParam{currencyTypeParam(denom)})
+
+	return tag.AccessVector()
+}
+
+// currencyTypeParam returns VM currency type param (0x1::XFI::T or 0x1::Coins::{DENOM}).
+func currencyTypeParam(denom string) glav.TypeParam {
+	if denom = strings.ToUpper(denom); denom == "XFI" {
+		return glav.NewStructTypeParam(glav.NewStructTag(stdLibVMAddress(), glav.XfiModule, glav.XfiStruct, []glav.TypeParam{}))
+	}
+
+	return glav.NewStructTypeParam(glav.NewStructTag(stdLibVMAddress(), glav.CoinsModule, denom, []glav.TypeParam{}))
+}
+
+// stdLibVMAddress returns stdlib address as a fixed size array.
+func stdLibVMAddress() (address [common_vm.VMAddressLength]byte) {
+	copy(address[:], common_vm.StdLibAddress)
+	return
+}
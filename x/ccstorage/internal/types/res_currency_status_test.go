@@ -0,0 +1,78 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+)
+
+// Test NewResCurrencyStatus and ResCurrencyStatus LCS layout.
+func TestCCS_ResCurrencyStatus(t *testing.T) {
+	t.Parallel()
+
+	currency := NewCurrency(
+		CurrencyParams{
+			Denom:           "test",
+			Decimals:        4,
+			ContractAddress: "0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1",
+			Name:            "Test",
+			Description:     "Test currency",
+			LogoURI:         "https://dfinance.co/test.png",
+			Paused:          true,
+		},
+		sdk.NewIntFromUint64(100),
+	)
+
+	curStatus := NewResCurrencyStatus(currency)
+	require.True(t, curStatus.Paused)
+	require.EqualValues(t, currency.ContractAddress, curStatus.ContractAddress)
+	require.EqualValues(t, currency.Name, curStatus.Name)
+	require.EqualValues(t, currency.Description, curStatus.Description)
+	require.EqualValues(t, currency.LogoURI, curStatus.LogoURI)
+
+	// ok: serialization matches the Move struct layout
+	{
+		type stdStatus struct {
+			Paused          bool
+			ContractAddress []byte
+			Name            []byte
+			Description     []byte
+			LogoURI         []byte
+		}
+
+		stdBz, err := lcs.Marshal(stdStatus{
+			Paused:          true,
+			ContractAddress: []byte(currency.ContractAddress),
+			Name:            []byte(currency.Name),
+			Description:     []byte(currency.Description),
+			LogoURI:         []byte(currency.LogoURI),
+		})
+		require.NoError(t, err)
+
+		bz, err := lcs.Marshal(curStatus)
+		require.NoError(t, err)
+		require.Equal(t, stdBz, bz)
+
+		decodedCurStatus := ResCurrencyStatus{}
+		require.NoError(t, lcs.Unmarshal(bz, &decodedCurStatus))
+		require.Equal(t, curStatus, decodedCurStatus)
+	}
+}
+
+// Test CurrencyStatusVector currency type params match the stdlib ones.
+func TestCCS_CurrencyStatusVector(t *testing.T) {
+	t.Parallel()
+
+	for _, denom := range []string{"xfi", "btc", "test"} {
+		infoTag := glav.NewStructTag(stdLibVMAddress(), glav.DfinanceModule, glav.InfoStruct, []glav.TypeParam{currencyTypeParam(denom)})
+		require.Equal(t, glav.CurrencyInfoVector(denom), infoTag.AccessVector(), "denom %q", denom)
+
+		require.NotEqual(t, glav.CurrencyInfoVector(denom), CurrencyStatusVector(denom), "denom %q", denom)
+		require.Equal(t, CurrencyStatusVector(denom), NewCurrency(CurrencyParams{Denom: denom}, sdk.ZeroInt()).StatusPath(), "denom %q", denom)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,3 +70,15 @@ func Test_MustParsePathKey(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetPathAddressPrefixKey(t *testing.T) {
+	address := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}
+	path := []byte{1, 2, 4, 8, 16, 32, 64, 128, 255}
+
+	key := GetPathKey(&vm_grpc.VMAccessPath{Address: address, Path: path})
+	prefix := GetPathAddressPrefixKey(address)
+
+	require.True(t, bytes.HasPrefix(key, prefix))
+	require.True(t, bytes.HasPrefix(prefix, GetPathPrefixKey()))
+	require.Equal(t, key[len(prefix):], path)
+}
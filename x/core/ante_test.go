@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestTypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -186,3 +187,49 @@ func TestAnteHandler_CorrectDenomFees(t *testing.T) {
 	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
 	checkValidTx(t, ah, input.ctx, tx, true)
 }
+
+// nolint:errcheck
+// Test VM multi signer script tx requires signatures from all script signers.
+func TestAnteHandler_VMMultiSignerScript(t *testing.T) {
+	t.Parallel()
+
+	input := setupTestInput()
+
+	priv1, _, addr1 := vestTypes.KeyTestPubAddr()
+	priv2, _, addr2 := vestTypes.KeyTestPubAddr()
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		acc := input.accKeeper.NewAccountWithAddress(input.ctx, addr)
+		acc.SetCoins(DefaultFees)
+		input.accKeeper.SetAccount(input.ctx, acc)
+	}
+
+	msg := vm.NewMsgExecuteScriptMultiSigner([]sdk.AccAddress{addr1, addr2}, make(vm.Contract, 16), nil, nil)
+	fee := auth.StdFee{Gas: 1000000, Amount: DefaultFees}
+	msgs := []sdk.Msg{msg}
+	ah := NewAnteHandler(input.accKeeper, input.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+
+	// fail: second signer signature is missing
+	{
+		privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+		tx := authTypes.NewTestTx(input.ctx, msgs, privs, accNums, seqs, fee)
+		_, err := ah(input.ctx, tx, false)
+		require.Error(t, err)
+		require.True(t, sdkErrors.ErrUnauthorized.Is(err) || sdkErrors.ErrInvalidPubKey.Is(err), err.Error())
+	}
+
+	// fail: signatures order mismatch
+	{
+		privs, accNums, seqs := []crypto.PrivKey{priv2, priv1}, []uint64{1, 0}, []uint64{0, 0}
+		tx := authTypes.NewTestTx(input.ctx, msgs, privs, accNums, seqs, fee)
+		_, err := ah(input.ctx, tx, false)
+		require.Error(t, err)
+		require.True(t, sdkErrors.ErrUnauthorized.Is(err) || sdkErrors.ErrInvalidPubKey.Is(err), err.Error())
+	}
+
+	// ok
+	{
+		privs, accNums, seqs := []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+		tx := authTypes.NewTestTx(input.ctx, msgs, privs, accNums, seqs, fee)
+		checkValidTx(t, ah, input.ctx, tx, false)
+	}
+}
@@ -9,16 +9,34 @@ type (
 	Keeper              = keeper.Keeper
 	GenesisState        = types.GenesisState
 	Issue               = types.Issue
+	IssueDeposit        = types.IssueDeposit
+	GenesisIssue        = types.GenesisIssue
 	Withdraw            = types.Withdraw
 	Withdraws           = types.Withdraws
+	WithdrawState       = types.WithdrawState
 	MsgIssueCurrency    = types.MsgIssueCurrency
 	MsgWithdrawCurrency = types.MsgWithdrawCurrency
 	MsgUnstakeCurrency  = types.MsgUnstakeCurrency
+	MsgConfirmWithdraw  = types.MsgConfirmWithdraw
+	MsgRefundWithdraw   = types.MsgRefundWithdraw
 	AddCurrencyProposal = types.AddCurrencyProposal
 	CurrencyReq         = types.CurrencyReq
 	IssueReq            = types.IssueReq
 	WithdrawsReq        = types.WithdrawsReq
 	WithdrawReq         = types.WithdrawReq
+	//
+	SetWithdrawLimitsProposal = types.SetWithdrawLimitsProposal
+	WithdrawLimits            = types.WithdrawLimits
+	WithdrawLimitsList        = types.WithdrawLimitsList
+	WithdrawQuota             = types.WithdrawQuota
+	WithdrawLimitsReq         = types.WithdrawLimitsReq
+	WithdrawQuotaReq          = types.WithdrawQuotaReq
+	IssuesByTxHashReq         = types.IssuesByTxHashReq
+	//
+	UpdateCurrencyContractProposal = types.UpdateCurrencyContractProposal
+	SetCurrencyMetadataProposal    = types.SetCurrencyMetadataProposal
+	PauseCurrencyProposal          = types.PauseCurrencyProposal
+	UnpauseCurrencyProposal        = types.UnpauseCurrencyProposal
 )
 
 const (
@@ -31,15 +49,34 @@ const (
 	QueryWithdraw  = types.QueryWithdraw
 	QueryIssue     = types.QueryIssue
 	QueryCurrency  = types.QueryCurrency
+	//
+	QueryWithdrawLimits = types.QueryWithdrawLimits
+	QueryWithdrawQuota  = types.QueryWithdrawQuota
+	QueryIssuesByTxHash = types.QueryIssuesByTxHash
+	//
+	WithdrawAccountCapPeriod = types.WithdrawAccountCapPeriod
+	//
+	WithdrawStatePending   = types.WithdrawStatePending
+	WithdrawStateConfirmed = types.WithdrawStateConfirmed
+	WithdrawStateRefunded  = types.WithdrawStateRefunded
 	// Event types, attribute types and values
 	EventTypesIssue    = types.EventTypesIssue
 	EventTypesWithdraw = types.EventTypesWithdraw
 	//
+	EventTypesWithdrawConfirm = types.EventTypesWithdrawConfirm
+	EventTypesWithdrawRefund  = types.EventTypesWithdrawRefund
+	//
 	AttributeDenom      = types.AttributeDenom
 	AttributeAmount     = types.AttributeAmount
 	AttributeIssueId    = types.AttributeIssueId
 	AttributeWithdrawId = types.AttributeWithdrawId
 	AttributeSender     = types.AttributeSender
+	//
+	AttributePegZoneTxHash = types.AttributePegZoneTxHash
+	//
+	AttributeSourceChainID  = types.AttributeSourceChainID
+	AttributeSourceTxHash   = types.AttributeSourceTxHash
+	AttributeSourceLogIndex = types.AttributeSourceLogIndex
 )
 
 var (
@@ -53,8 +90,20 @@ var (
 	DefaultGenesisState    = types.DefaultGenesisState
 	RegisterInvariants     = keeper.RegisterInvariants
 	NewMsgIssueCurrency    = types.NewMsgIssueCurrency
+	NewIssueDeposit        = types.NewIssueDeposit
 	NewMsgWithdrawCurrency = types.NewMsgWithdrawCurrency
+	NewMsgConfirmWithdraw  = types.NewMsgConfirmWithdraw
+	NewMsgRefundWithdraw   = types.NewMsgRefundWithdraw
 	NewAddCurrencyProposal = types.NewAddCurrencyProposal
+	//
+	NewSetWithdrawLimitsProposal = types.NewSetWithdrawLimitsProposal
+	NewWithdrawLimits            = types.NewWithdrawLimits
+	NewEmptyWithdrawLimits       = types.NewEmptyWithdrawLimits
+	//
+	NewUpdateCurrencyContractProposal = types.NewUpdateCurrencyContractProposal
+	NewSetCurrencyMetadataProposal    = types.NewSetCurrencyMetadataProposal
+	NewPauseCurrencyProposal          = types.NewPauseCurrencyProposal
+	NewUnpauseCurrencyProposal        = types.NewUnpauseCurrencyProposal
 	// perms requests
 	RequestCCStoragePerms = types.RequestCCStoragePerms
 	// errors
@@ -64,6 +113,12 @@ var (
 	ErrWrongIssueID        = types.ErrWrongIssueID
 	ErrWrongWithdrawID     = types.ErrWrongWithdrawID
 	ErrWrongPegZoneSpender = types.ErrWrongPegZonePayee
+	ErrWrongWithdrawState  = types.ErrWrongWithdrawState
+	ErrWrongPegZoneTxHash  = types.ErrWrongPegZoneTxHash
+	ErrWithdrawLimit       = types.ErrWithdrawLimit
+	ErrWrongWithdrawLimit  = types.ErrWrongWithdrawLimit
+	ErrWrongIssueDeposit   = types.ErrWrongIssueDeposit
+	ErrWithdrawDisabled    = types.ErrWithdrawDisabled
 	ErrGovInvalidProposal  = types.ErrGovInvalidProposal
 
 	// Mint denom and event type when mint happen.
@@ -57,10 +57,10 @@ func PostMsUnstakeCurrency(cdc *codec.Codec) *cobra.Command {
 // PostMsIssueCurrency returns tx command which post a new multisig issue request.
 func PostMsIssueCurrency(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "ms-issue [issueID] [coin] [payee]",
+		Use:     "ms-issue [issueID] [coin] [payee] [sourceChainID] [sourceTxHash] [sourceLogIndex] [sourceSender]",
 		Short:   "Issue new currency via multi signature, increasing payee coin balance",
-		Example: "ms-issue issue1 100xfi {account} --from {account}",
-		Args:    cobra.ExactArgs(3),
+		Example: "ms-issue issue1 100xfi {account} ethereum {txHash} 0 {ethAddress} --from {account}",
+		Args:    cobra.ExactArgs(7),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
 
@@ -80,8 +80,14 @@ func PostMsIssueCurrency(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			logIndex, err := helpers.ParseUint64Param("sourceLogIndex", args[5], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
 			// prepare and send multisig message
-			msg := types.NewMsgIssueCurrency(args[0], coin, payee)
+			deposit := types.NewIssueDeposit(args[3], args[4], logIndex, args[6])
+			msg := types.NewMsgIssueCurrency(args[0], coin, payee, deposit)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -100,6 +106,101 @@ func PostMsIssueCurrency(cdc *codec.Codec) *cobra.Command {
 		"unique multi signature call ID",
 		"currency denomination symbol and amount in Coin format (1.0 btc with 8 decimals -> 100000000btc)",
 		"payee address (whose balance is increased)",
+		"source chain ID",
+		"source chain deposit tx hash",
+		"source chain deposit event log index within the tx",
+		"source chain deposit sender",
+	})
+
+	return cmd
+}
+
+// PostMsConfirmWithdraw returns tx command which post a new multisig withdraw peg zone transfer confirmation request.
+func PostMsConfirmWithdraw(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ms-confirm-withdraw [uniqueID] [withdrawID] [pegZoneTxHash]",
+		Short:   "Confirm pending withdraw peg zone transfer via multi signature",
+		Example: "ms-confirm-withdraw confirm0 0 0x5f1c3ea7d3a8e7f14d7d6b3c71e3ed9b6bfc9d31fa89b3ae4f6e4b2e8fb2b1ac --from {account}",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			id, err := helpers.ParseDnIDParam("withdrawID", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send multisig message
+			msg := types.NewMsgConfirmWithdraw(id, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			callMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
+			if err := callMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{callMsg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique multi signature call ID",
+		"pending withdraw ID",
+		"second blockchain transfer tx hash",
+	})
+
+	return cmd
+}
+
+// PostMsRefundWithdraw returns tx command which post a new multisig withdraw refund request.
+func PostMsRefundWithdraw(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ms-refund-withdraw [uniqueID] [withdrawID]",
+		Short:   "Refund failed pending withdraw via multi signature, increasing spender coin balance",
+		Example: "ms-refund-withdraw refund0 0 --from {account}",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			id, err := helpers.ParseDnIDParam("withdrawID", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send multisig message
+			msg := types.NewMsgRefundWithdraw(id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			callMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
+			if err := callMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{callMsg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique multi signature call ID",
+		"pending withdraw ID",
 	})
 
 	return cmd
@@ -14,6 +14,10 @@ import (
 	"github.com/dfinance/dnode/x/currencies/internal/types"
 )
 
+const (
+	flagWithdrawsState = "state"
+)
+
 // GetCurrency returns query command that returns currency by denom.
 func GetCurrency(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -113,12 +117,49 @@ func GetIssue(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetIssuesByTxHash returns query command that returns issues by the source chain deposit tx hash.
+func GetIssuesByTxHash(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "issues-by-tx [txHash]",
+		Short:   "Get issues by source chain deposit tx hash",
+		Example: "issues-by-tx 0x5f1c3ea7d3a8e7f14d7d6b3c71e3ed9b6bfc9d31fa89b3ae4f6e4b2e8fb2b1ac",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// prepare request
+			req := types.IssuesByTxHashReq{TxHash: args[0]}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryIssuesByTxHash), bz)
+			if err != nil {
+				return err
+			}
+
+			var out []types.GenesisIssue
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"source chain deposit tx hash",
+	})
+
+	return cmd
+}
+
 // GetWithdraws returns query command that lists all withdraw objects with filters and pagination.
 func GetWithdraws(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "withdraws",
 		Short:   "Get withdraw list by page and limit",
-		Example: "withdraws --page=1 --limit=10",
+		Example: "withdraws --page=1 --limit=10 --state=pending",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -132,6 +173,7 @@ func GetWithdraws(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			req := types.WithdrawsReq{
 				Page:  page,
 				Limit: limit,
+				State: types.WithdrawState(viper.GetString(flagWithdrawsState)),
 			}
 
 			bz, err := cliCtx.Codec.MarshalJSON(req)
@@ -152,6 +194,7 @@ func GetWithdraws(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagWithdrawsState, "", "(optional) filter by withdraw state (pending / confirmed / refunded)")
 
 	return cmd
 }
@@ -200,3 +243,87 @@ func GetWithdraw(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetWithdrawLimits returns query command that returns currency withdraw limits.
+func GetWithdrawLimits(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-limits [denom]",
+		Short:   "Get currency withdraw limits",
+		Example: "withdraw-limits xfi",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// prepare request
+			req := types.WithdrawLimitsReq{Denom: args[0]}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWithdrawLimits), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.WithdrawLimits
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+	})
+
+	return cmd
+}
+
+// GetWithdrawQuota returns query command that returns account remaining withdraw quota for the currency.
+func GetWithdrawQuota(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-quota [denom] [address]",
+		Short:   "Get account remaining withdraw quota for the currency",
+		Example: "withdraw-quota xfi {account}",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			address, err := helpers.ParseSdkAddressParam("address", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.WithdrawQuotaReq{
+				Denom:   args[0],
+				Address: address,
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWithdrawQuota), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.WithdrawQuota
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+		"account address",
+	})
+
+	return cmd
+}
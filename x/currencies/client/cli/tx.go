@@ -132,3 +132,254 @@ func AddCurrencyProposal(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// Send governance set withdraw limits proposal.
+func SetWithdrawLimitsProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-withdraw-limits-proposal [denom] [minAmount] [maxAmount] [accountDailyCap] [blockCap]",
+		Args:    cobra.ExactArgs(5),
+		Short:   "Submit currency withdraw limits proposal, zero value disables the limit",
+		Example: "set-withdraw-limits-proposal xfi 100 1000 5000 0 --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			if err := helpers.ValidateDenomParam("denom", denom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+
+			minAmount, err := helpers.ParseSdkIntParam("minAmount", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			maxAmount, err := helpers.ParseSdkIntParam("maxAmount", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			accountDailyCap, err := helpers.ParseSdkIntParam("accountDailyCap", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			blockCap, err := helpers.ParseSdkIntParam("blockCap", args[4], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewSetWithdrawLimitsProposal(denom, minAmount, maxAmount, accountDailyCap, blockCap)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+		"minimum single withdraw amount (0 - no limit)",
+		"maximum single withdraw amount (0 - no limit)",
+		"rolling 24h withdraw amount cap per account (0 - no limit)",
+		"withdraw amount cap per block (0 - no limit)",
+	})
+
+	return cmd
+}
+
+// Send governance update currency contract address proposal.
+func UpdateCurrencyContractProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-currency-contract-proposal [denom] [ERC20ContractAddress]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit currency ERC20 contract address update proposal",
+		Example: "update-currency-contract-proposal eth 0x29D7d1dd5B6f9C864d9db560D72a247c178aE86B --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			if err := helpers.ValidateDenomParam("denom", denom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+
+			contractAddress, err := helpers.ParseEthereumAddressParam("ERC20ContractAddress", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewUpdateCurrencyContractProposal(denom, contractAddress)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+		"ERC20 contract address",
+	})
+
+	return cmd
+}
+
+// Send governance set currency metadata proposal.
+func SetCurrencyMetadataProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-currency-metadata-proposal [denom] [name] [description] [logoURI]",
+		Args:    cobra.ExactArgs(4),
+		Short:   "Submit currency display metadata proposal, empty value clears the field",
+		Example: "set-currency-metadata-proposal eth Ethereum \"Wrapped Ether\" https://dfinance.co/eth.png --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			if err := helpers.ValidateDenomParam("denom", denom, helpers.ParamTypeCliArg); err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewSetCurrencyMetadataProposal(denom, args[1], args[2], args[3])
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+		"display name",
+		"description",
+		"logo URI (absolute)",
+	})
+
+	return cmd
+}
+
+// Send governance pause currency proposal.
+func PauseCurrencyProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-currency-proposal [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit currency pause proposal (blocks issue, withdraw and order posting)",
+		Example: "pause-currency-proposal eth --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitCurrencyPauseProposal(cdc, cmd, args[0], true)
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+	})
+
+	return cmd
+}
+
+// Send governance unpause currency proposal.
+func UnpauseCurrencyProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause-currency-proposal [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit currency unpause proposal",
+		Example: "unpause-currency-proposal eth --deposit 100xfi --fees 1xfi",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitCurrencyPauseProposal(cdc, cmd, args[0], false)
+		},
+	}
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
+	helpers.BuildCmdHelp(cmd, []string{
+		"currency denomination symbol",
+	})
+
+	return cmd
+}
+
+// submitCurrencyPauseProposal builds and sends pause / unpause currency proposal.
+func submitCurrencyPauseProposal(cdc *codec.Codec, cmd *cobra.Command, denom string, pause bool) error {
+	cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+	// parse inputs
+	fromAddr, err := helpers.ParseFromFlag(cliCtx)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	if err := helpers.ValidateDenomParam("denom", denom, helpers.ParamTypeCliArg); err != nil {
+		return err
+	}
+
+	// prepare and send message
+	var content gov.Content = types.NewUnpauseCurrencyProposal(denom)
+	if pause {
+		content = types.NewPauseCurrencyProposal(denom)
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+}
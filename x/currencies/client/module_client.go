@@ -19,10 +19,13 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd.AddCommand(
 		sdkClient.GetCommands(
 			cli.GetIssue(types.ModuleName, cdc),
+			cli.GetIssuesByTxHash(types.ModuleName, cdc),
 			cli.GetCurrency(types.ModuleName, cdc),
 			cli.GetCurrencies(types.ModuleName, cdc),
 			cli.GetWithdraw(types.ModuleName, cdc),
 			cli.GetWithdraws(types.ModuleName, cdc),
+			cli.GetWithdrawLimits(types.ModuleName, cdc),
+			cli.GetWithdrawQuota(types.ModuleName, cdc),
 		)...)
 
 	return queryCmd
@@ -38,8 +41,15 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 	txCmd.AddCommand(sdkClient.PostCommands(
 		cli.PostMsIssueCurrency(cdc),
 		cli.PostMsUnstakeCurrency(cdc),
+		cli.PostMsConfirmWithdraw(cdc),
+		cli.PostMsRefundWithdraw(cdc),
 		cli.PostWithdrawCurrency(cdc),
 		cli.AddCurrencyProposal(cdc),
+		cli.SetWithdrawLimitsProposal(cdc),
+		cli.UpdateCurrencyContractProposal(cdc),
+		cli.SetCurrencyMetadataProposal(cdc),
+		cli.PauseCurrencyProposal(cdc),
+		cli.UnpauseCurrencyProposal(cdc),
 	)...)
 
 	return txCmd
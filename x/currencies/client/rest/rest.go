@@ -19,6 +19,11 @@ const (
 	Denom      = "denom"
 	IssueID    = "issueID"
 	WithdrawID = "withdrawID"
+	//
+	WithdrawsState = "state"
+	//
+	Address = "address"
+	TxHash  = "txHash"
 )
 
 type SubmitIssueReq struct {
@@ -29,6 +34,8 @@ type SubmitIssueReq struct {
 	Coin sdk.Coin `json:"coin" yaml:"coin"`
 	// Payee account (whose balance is increased)
 	Payee string `json:"payee" yaml:"payee" format:"bech32/hex" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Source chain deposit info
+	Deposit types.IssueDeposit `json:"deposit" yaml:"deposit"`
 }
 
 type UnstakeReq struct {
@@ -39,6 +46,24 @@ type UnstakeReq struct {
 	Staker string `json:"staker" yaml:"staker" format:"bech32/hex" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
 }
 
+type ConfirmWithdrawReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Multisig call unique ID
+	UniqueID string `json:"unique_id" yaml:"unique_id"`
+	// Pending withdraw ID
+	WithdrawID string `json:"withdraw_id" yaml:"withdraw_id" format:"string representation for big.Uint" example:"0"`
+	// Second blockchain: transfer tx hash
+	PegZoneTxHash string `json:"pegzone_tx_hash" yaml:"pegzone_tx_hash"`
+}
+
+type RefundWithdrawReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Multisig call unique ID
+	UniqueID string `json:"unique_id" yaml:"unique_id"`
+	// Pending withdraw ID
+	WithdrawID string `json:"withdraw_id" yaml:"withdraw_id" format:"string representation for big.Uint" example:"0"`
+}
+
 type WithdrawReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	// Target currency withdraw coin
@@ -54,11 +79,16 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/currency/{%s}", types.ModuleName, Denom), getCurrency(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s", types.ModuleName), getCurrencies(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/issue/{%s}", types.ModuleName, IssueID), getIssue(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/issues/tx/{%s}", types.ModuleName, TxHash), getIssuesByTxHash(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/withdraw/{%s}", types.ModuleName, WithdrawID), getWithdraw(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/withdraws", types.ModuleName), getWithdraws(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/withdraw_limits/{%s}", types.ModuleName, Denom), getWithdrawLimits(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/withdraw_quota/{%s}/{%s}", types.ModuleName, Denom, Address), getWithdrawQuota(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/issue", types.ModuleName), submitIssue(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/unstake", types.ModuleName), submitUnstake(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/withdraw", types.ModuleName), withdraw(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/confirm_withdraw", types.ModuleName), submitConfirmWithdraw(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/refund_withdraw", types.ModuleName), submitRefundWithdraw(cliCtx)).Methods("PUT")
 }
 
 // GetCurrency godoc
@@ -151,15 +181,50 @@ func getIssue(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// GetIssuesByTxHash godoc
+// @Tags Currencies
+// @Summary Get currency issues by deposit tx hash
+// @Description Get currency issues by the source chain deposit tx hash
+// @ID currenciesGetIssuesByTxHash
+// @Accept  json
+// @Produce json
+// @Param txHash path string true "source chain deposit tx hash"
+// @Success 200 {object} CCRespGetIssuesByTxHash
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /currencies/issues/tx/{txHash} [get]
+func getIssuesByTxHash(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		req := types.IssuesByTxHashReq{TxHash: vars[TxHash]}
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryIssuesByTxHash), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // GetWithdraws godoc
 // @Tags Currencies
 // @Summary Get currency withdraws
-// @Description Get array of Withdraw objects with pagination
+// @Description Get array of Withdraw objects with pagination and state filter
 // @ID currenciesGetWithdraws
 // @Accept  json
 // @Produce json
 // @Param page query uint false "page number (first page: 1)"
 // @Param limit query uint false "items per page (default: 100)"
+// @Param state query string false "withdraw state filter (pending / confirmed / refunded)"
 // @Success 200 {object} CCRespGetWithdraws
 // @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
 // @Failure 500 {object} rest.ErrorResponse "Returned on server error"
@@ -179,6 +244,7 @@ func getWithdraws(cliCtx context.CLIContext) http.HandlerFunc {
 		req := types.WithdrawsReq{
 			Page:  page,
 			Limit: limit,
+			State: types.WithdrawState(r.URL.Query().Get(WithdrawsState)),
 		}
 		bz, err := cliCtx.Codec.MarshalJSON(req)
 		if err != nil {
@@ -240,6 +306,84 @@ func getWithdraw(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// GetWithdrawLimits godoc
+// @Tags Currencies
+// @Summary Get currency withdraw limits
+// @Description Get currency withdraw limits by denom (zero value - no limit)
+// @ID currenciesGetWithdrawLimits
+// @Accept  json
+// @Produce json
+// @Param denom path string true "currency denomination symbol"
+// @Success 200 {object} CCRespGetWithdrawLimits
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /currencies/withdraw_limits/{denom} [get]
+func getWithdrawLimits(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		req := types.WithdrawLimitsReq{Denom: vars[Denom]}
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryWithdrawLimits), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetWithdrawQuota godoc
+// @Tags Currencies
+// @Summary Get account withdraw quota
+// @Description Get account remaining withdraw quota for the currency
+// @ID currenciesGetWithdrawQuota
+// @Accept  json
+// @Produce json
+// @Param denom path string true "currency denomination symbol"
+// @Param address path string true "account address"
+// @Success 200 {object} CCRespGetWithdrawQuota
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /currencies/withdraw_quota/{denom}/{address} [get]
+func getWithdrawQuota(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+		address, err := helpers.ParseSdkAddressParam(Address, vars[Address], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req := types.WithdrawQuotaReq{
+			Denom:   vars[Denom],
+			Address: address,
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryWithdrawQuota), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // SubmitIssue godoc
 // @Tags Currencies
 // @Summary Submit issue
@@ -287,7 +431,7 @@ func submitIssue(cliCtx context.CLIContext) http.HandlerFunc {
 		issueID := req.ID
 
 		// create the message
-		msg := types.NewMsgIssueCurrency(issueID, coin, payeeAddr)
+		msg := types.NewMsgIssueCurrency(issueID, coin, payeeAddr, req.Deposit)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -409,3 +553,111 @@ func withdraw(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+// Submit confirm withdraw godoc
+// @Tags Currencies
+// @Summary Confirm withdraw tx
+// @Description Get new withdraw peg zone transfer confirmation multi signature message stdTx object
+// @ID currenciesSubmitConfirmWithdraw
+// @Accept  json
+// @Produce json
+// @Param request body ConfirmWithdrawReq true "Submit confirm withdraw request"
+// @Success 200 {object} CCRespStdTx
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /currencies/confirm_withdraw [put]
+func submitConfirmWithdraw(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req ConfirmWithdrawReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := helpers.ParseDnIDParam("withdraw_id", req.WithdrawID, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgConfirmWithdraw(id, req.PegZoneTxHash)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		callMsg := msClient.NewMsgSubmitCall(msg, req.UniqueID, fromAddr)
+		if err := callMsg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{callMsg})
+	}
+}
+
+// Submit refund withdraw godoc
+// @Tags Currencies
+// @Summary Refund withdraw tx
+// @Description Get new withdraw refund multi signature message stdTx object
+// @ID currenciesSubmitRefundWithdraw
+// @Accept  json
+// @Produce json
+// @Param request body RefundWithdrawReq true "Submit refund withdraw request"
+// @Success 200 {object} CCRespStdTx
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /currencies/refund_withdraw [put]
+func submitRefundWithdraw(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req RefundWithdrawReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := helpers.ParseDnIDParam("withdraw_id", req.WithdrawID, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgRefundWithdraw(id)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		callMsg := msClient.NewMsgSubmitCall(msg, req.UniqueID, fromAddr)
+		if err := callMsg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{callMsg})
+	}
+}
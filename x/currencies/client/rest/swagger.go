@@ -24,6 +24,11 @@ type (
 		Result types.Issue `json:"result"`
 	}
 
+	CCRespGetIssuesByTxHash struct {
+		Height int64                `json:"height"`
+		Result []types.GenesisIssue `json:"result"`
+	}
+
 	CCRespGetCurrency struct {
 		Height int64              `json:"height"`
 		Result ccstorage.Currency `json:"result"`
@@ -34,6 +39,16 @@ type (
 		Result ccstorage.Currencies `json:"result"`
 	}
 
+	CCRespGetWithdrawLimits struct {
+		Height int64                `json:"height"`
+		Result types.WithdrawLimits `json:"result"`
+	}
+
+	CCRespGetWithdrawQuota struct {
+		Height int64               `json:"height"`
+		Result types.WithdrawQuota `json:"result"`
+	}
+
 	CCRespStdTx struct {
 		Height int64      `json:"height"`
 		Result auth.StdTx `json:"result"`
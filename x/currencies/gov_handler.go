@@ -22,6 +22,16 @@ func NewGovHandler(k Keeper) gov.Handler {
 		switch p := c.(type) {
 		case AddCurrencyProposal:
 			return handleAddCurrencyProposal(ctx, k, p)
+		case SetWithdrawLimitsProposal:
+			return handleSetWithdrawLimitsProposal(ctx, k, p)
+		case UpdateCurrencyContractProposal:
+			return handleUpdateCurrencyContractProposal(ctx, k, p)
+		case SetCurrencyMetadataProposal:
+			return handleSetCurrencyMetadataProposal(ctx, k, p)
+		case PauseCurrencyProposal:
+			return handlePauseCurrencyProposal(ctx, k, p)
+		case UnpauseCurrencyProposal:
+			return handleUnpauseCurrencyProposal(ctx, k, p)
 		default:
 			return fmt.Errorf("unsupported proposal content type %q for module %q", c.ProposalType(), ModuleName)
 		}
@@ -43,3 +53,78 @@ func handleAddCurrencyProposal(ctx sdk.Context, k Keeper, p AddCurrencyProposal)
 
 	return nil
 }
+
+// handleSetWithdrawLimitsProposal handles currency withdraw limits update proposal.
+func handleSetWithdrawLimitsProposal(ctx sdk.Context, k Keeper, p SetWithdrawLimitsProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.SetWithdrawLimits(ctx, p.GetWithdrawLimits()); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "setting withdraw limits: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
+// handleUpdateCurrencyContractProposal handles currency contract address update proposal.
+func handleUpdateCurrencyContractProposal(ctx sdk.Context, k Keeper, p UpdateCurrencyContractProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.UpdateCurrencyContract(ctx, p.Denom, p.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "updating currency contract: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ccstorage.ModuleName))
+
+	return nil
+}
+
+// handleSetCurrencyMetadataProposal handles currency metadata update proposal.
+func handleSetCurrencyMetadataProposal(ctx sdk.Context, k Keeper, p SetCurrencyMetadataProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.SetCurrencyMetadata(ctx, p.Denom, p.Name, p.Description, p.LogoURI); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "setting currency metadata: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ccstorage.ModuleName))
+
+	return nil
+}
+
+// handlePauseCurrencyProposal handles currency pause proposal.
+func handlePauseCurrencyProposal(ctx sdk.Context, k Keeper, p PauseCurrencyProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.PauseCurrency(ctx, p.Denom); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "pausing currency: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ccstorage.ModuleName))
+
+	return nil
+}
+
+// handleUnpauseCurrencyProposal handles currency unpause proposal.
+func handleUnpauseCurrencyProposal(ctx sdk.Context, k Keeper, p UnpauseCurrencyProposal) error {
+	logger := k.GetLogger(ctx)
+
+	if err := k.UnpauseCurrency(ctx, p.Denom); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "unpausing currency: %v", err)
+	}
+
+	logger.Info(fmt.Sprintf("proposal executed:\n%s", p.String()))
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ccstorage.ModuleName))
+
+	return nil
+}
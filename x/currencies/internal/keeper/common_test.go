@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -79,6 +80,16 @@ func (input *TestInput) CreateAccount(t *testing.T, accName string, coins sdk.Co
 	return addr
 }
 
+// EnableWithdraws enables currency withdraws setting no withdraw limits.
+func (input *TestInput) EnableWithdraws(t *testing.T, denom string) {
+	require.NoError(t, input.keeper.SetWithdrawLimits(input.ctx, types.NewEmptyWithdrawLimits(denom)), "enabling withdraws for denom: %s", denom)
+}
+
+// newIssueDeposit creates a source chain deposit unique for the issueID.
+func newIssueDeposit(issueID string) types.IssueDeposit {
+	return types.NewIssueDeposit("ethereum", "0x"+hex.EncodeToString([]byte(issueID)), 0, "0xsender")
+}
+
 func NewTestInput(t *testing.T) TestInput {
 	input := TestInput{
 		cdc:        codec.New(),
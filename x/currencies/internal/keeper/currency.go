@@ -13,3 +13,31 @@ func (k Keeper) CreateCurrency(ctx sdk.Context, params ccstorage.CurrencyParams)
 
 	return k.ccsKeeper.CreateCurrency(ctx, params)
 }
+
+// UpdateCurrencyContract redirects UpdateCurrencyContract request to the currencies storage.
+func (k Keeper) UpdateCurrencyContract(ctx sdk.Context, denom, contractAddress string) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	return k.ccsKeeper.UpdateCurrencyContract(ctx, denom, contractAddress)
+}
+
+// SetCurrencyMetadata redirects SetCurrencyMetadata request to the currencies storage.
+func (k Keeper) SetCurrencyMetadata(ctx sdk.Context, denom, name, description, logoURI string) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	return k.ccsKeeper.SetCurrencyMetadata(ctx, denom, name, description, logoURI)
+}
+
+// PauseCurrency pauses currency issue, withdraw and order posting.
+func (k Keeper) PauseCurrency(ctx sdk.Context, denom string) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	return k.ccsKeeper.SetCurrencyPaused(ctx, denom, true)
+}
+
+// UnpauseCurrency resumes previously paused currency.
+func (k Keeper) UnpauseCurrency(ctx sdk.Context, denom string) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	return k.ccsKeeper.SetCurrencyPaused(ctx, denom, false)
+}
@@ -0,0 +1,91 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/ccstorage"
+)
+
+// Test keeper currency update methods.
+func TestCurrenciesKeeper_UpdateCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	ctx, keeper := input.ctx, input.keeper
+
+	// ok: contract address
+	{
+		contractAddress := "0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1"
+		require.NoError(t, keeper.UpdateCurrencyContract(ctx, defDenom, contractAddress))
+
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.Equal(t, contractAddress, currency.ContractAddress)
+	}
+
+	// ok: metadata
+	{
+		require.NoError(t, keeper.SetCurrencyMetadata(ctx, defDenom, "Test", "Test currency", "https://dfinance.co/test.png"))
+
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.Equal(t, "Test", currency.Name)
+		require.Equal(t, "Test currency", currency.Description)
+		require.Equal(t, "https://dfinance.co/test.png", currency.LogoURI)
+	}
+
+	// fail: unknown currency
+	{
+		require.True(t, ccstorage.ErrWrongDenom.Is(keeper.UpdateCurrencyContract(ctx, "test", "")))
+		require.True(t, ccstorage.ErrWrongDenom.Is(keeper.SetCurrencyMetadata(ctx, "test", "", "", "")))
+		require.True(t, ccstorage.ErrWrongDenom.Is(keeper.PauseCurrency(ctx, "test")))
+	}
+}
+
+// Test keeper IssueCurrency / WithdrawCurrency methods with paused currency.
+func TestCurrenciesKeeper_PauseCurrency(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	addr := input.CreateAccount(t, "addr1", nil)
+	ctx, keeper := input.ctx, input.keeper
+	input.EnableWithdraws(t, defDenom)
+
+	recipient := sdk.AccAddress("addr2").String()
+	coin := sdk.NewCoin(defDenom, sdk.NewInt(100))
+
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, coin, addr, newIssueDeposit(defIssueID1)))
+
+	// pause
+	require.NoError(t, keeper.PauseCurrency(ctx, defDenom))
+	require.Error(t, keeper.PauseCurrency(ctx, defDenom))
+
+	// fail: issue
+	{
+		err := keeper.IssueCurrency(ctx, defIssueID2, coin, addr, newIssueDeposit(defIssueID2))
+		require.True(t, ccstorage.ErrPaused.Is(err))
+		require.False(t, keeper.HasIssue(ctx, defIssueID2))
+	}
+
+	// fail: withdraw
+	{
+		err := keeper.WithdrawCurrency(ctx, coin, addr, recipient, ctx.ChainID())
+		require.True(t, ccstorage.ErrPaused.Is(err))
+		require.Equal(t, coin.Amount.String(), input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).String())
+	}
+
+	// unpause
+	require.NoError(t, keeper.UnpauseCurrency(ctx, defDenom))
+	require.Error(t, keeper.UnpauseCurrency(ctx, defDenom))
+
+	// ok: issue and withdraw
+	{
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID2, coin, addr, newIssueDeposit(defIssueID2)))
+		require.NoError(t, keeper.WithdrawCurrency(ctx, coin, addr, recipient, ctx.ChainID()))
+	}
+}
@@ -43,6 +43,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 
 		k.storeWithdraw(ctx, withdraw)
 	}
+
+	// withdraw limits
+	for i, limits := range state.WithdrawLimits {
+		if !k.ccsKeeper.HasCurrency(ctx, limits.Denom) {
+			panic(fmt.Errorf("withdraw_limits[%d] denom %q: currency not found", i, limits.Denom))
+		}
+
+		k.storeWithdrawLimits(ctx, limits)
+	}
+
+	// withdraw account usages
+	for i, usage := range state.WithdrawUsages {
+		if !k.ccsKeeper.HasCurrency(ctx, usage.Denom) {
+			panic(fmt.Errorf("withdraw_usages[%d] denom %q: currency not found", i, usage.Denom))
+		}
+
+		k.setWithdrawAccountUsage(ctx, usage.Denom, usage.Address, usage.Usage)
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
@@ -50,8 +68,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	k.modulePerms.AutoCheck(types.PermRead)
 
 	state := types.GenesisState{
-		Issues:    make([]types.GenesisIssue, 0),
-		Withdraws: types.Withdraws{},
+		Issues:         make([]types.GenesisIssue, 0),
+		Withdraws:      types.Withdraws{},
+		WithdrawLimits: types.WithdrawLimitsList{},
+		WithdrawUsages: make([]types.GenesisWithdrawAccountUsage, 0),
 	}
 
 	// last withdrawID
@@ -66,5 +86,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	// withdraws
 	state.Withdraws = append(state.Withdraws, k.getWithdraws(ctx)...)
 
+	// withdraw limits
+	state.WithdrawLimits = append(state.WithdrawLimits, k.getWithdrawLimitsList(ctx)...)
+
+	// withdraw account usages
+	state.WithdrawUsages = append(state.WithdrawUsages, k.getWithdrawAccountUsages(ctx)...)
+
 	return k.cdc.MustMarshalJSON(state)
 }
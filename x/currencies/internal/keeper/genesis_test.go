@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,7 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 
 	input := NewTestInput(t)
 	ctx, keeper := input.ctx, input.keeper
+	ctx = ctx.WithBlockTime(time.Now())
 
 	lastID := dnTypes.NewIDFromUint64(2)
 	state := types.GenesisState{
@@ -26,6 +28,7 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 				Issue: types.NewIssue(
 					sdk.NewCoin("xfi", sdk.NewInt(150)),
 					sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+					types.NewIssueDeposit("ethereum", "0xtx1", 0, "0xsender"),
 				),
 				ID: "issue1",
 			},
@@ -33,6 +36,7 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 				Issue: types.NewIssue(
 					sdk.NewCoin("eth", sdk.NewInt(250)),
 					sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+					types.IssueDeposit{},
 				),
 				ID: "issue2",
 			},
@@ -67,6 +71,19 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 			),
 		},
 		LastWithdrawID: &lastID,
+		WithdrawLimits: types.WithdrawLimitsList{
+			types.NewWithdrawLimits("eth", sdk.NewInt(10), sdk.NewInt(100), sdk.NewInt(1000), sdk.ZeroInt()),
+			types.NewWithdrawLimits("xfi", sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(500)),
+		},
+		WithdrawUsages: []types.GenesisWithdrawAccountUsage{
+			{
+				Denom:   "eth",
+				Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+				Usage: types.WithdrawAccountUsage{
+					{WithdrawID: dnTypes.NewIDFromUint64(1), Timestamp: ctx.BlockTime().Unix(), Amount: sdk.NewInt(200)},
+				},
+			},
+		},
 	}
 
 	// init
@@ -80,11 +97,20 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 		for i, getIssue := range keeper.GetGenesisIssues(ctx) {
 			require.EqualValues(t, state.Issues[i], getIssue)
 		}
+		// issue deposits index
+		require.Len(t, keeper.GetIssuesByTxHash(ctx, "0xtx1"), 1)
 		// withdraws
 		require.Len(t, keeper.getWithdraws(ctx), len(state.Withdraws))
 		for i, getWithdraw := range keeper.getWithdraws(ctx) {
 			require.EqualValues(t, state.Withdraws[i], getWithdraw)
 		}
+		// withdraw limits
+		require.Len(t, keeper.getWithdrawLimitsList(ctx), len(state.WithdrawLimits))
+		for i, getLimits := range keeper.getWithdrawLimitsList(ctx) {
+			require.EqualValues(t, state.WithdrawLimits[i], getLimits)
+		}
+		// withdraw account usages
+		require.EqualValues(t, state.WithdrawUsages, keeper.getWithdrawAccountUsages(ctx))
 	}
 
 	// export
@@ -105,5 +131,12 @@ func TestCurrenciesKeeper_Genesis(t *testing.T) {
 		for i, getWithdraw := range keeper.getWithdraws(ctx) {
 			require.EqualValues(t, getWithdraw, state.Withdraws[i])
 		}
+		// withdraw limits
+		require.Len(t, keeper.getWithdrawLimitsList(ctx), len(state.WithdrawLimits))
+		for i, getLimits := range keeper.getWithdrawLimitsList(ctx) {
+			require.EqualValues(t, getLimits, state.WithdrawLimits[i])
+		}
+		// withdraw account usages
+		require.EqualValues(t, keeper.getWithdrawAccountUsages(ctx), state.WithdrawUsages)
 	}
 }
@@ -8,8 +8,8 @@ import (
 )
 
 // IssueCurrency issues a new currency and increases payee coin balance.
-// Issue is a multisig operation.
-func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee sdk.AccAddress) (retErr error) {
+// Issue is a multisig operation, source chain deposit can be issued only once.
+func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee sdk.AccAddress, deposit types.IssueDeposit) (retErr error) {
 	k.modulePerms.AutoCheck(types.PermIssue)
 
 	// bankKeeper might panic
@@ -27,13 +27,20 @@ func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee s
 		return sdkErrors.Wrapf(types.ErrWrongIssueID, "issue with ID %q: already exists", id)
 	}
 
-	// check and update currency
-	if _, err := k.ccsKeeper.GetCurrency(ctx, coin.Denom); err != nil {
+	if err := deposit.Validate(); err != nil {
+		return sdkErrors.Wrap(types.ErrWrongIssueDeposit, err.Error())
+	}
+	if issueID, found := k.getIssueIDByDeposit(ctx, deposit); found {
+		return sdkErrors.Wrapf(types.ErrWrongIssueDeposit, "deposit %q: already issued with ID %q", deposit.UniqueKey(), issueID)
+	}
+
+	// check currency exists and is not paused
+	if err := k.ccsKeeper.CheckCurrencyActive(ctx, coin.Denom); err != nil {
 		return err
 	}
 
 	// store issue
-	issue := types.NewIssue(coin, payee)
+	issue := types.NewIssue(coin, payee, deposit)
 	k.storeIssue(ctx, id, issue)
 
 	// update account balance
@@ -50,7 +57,7 @@ func (k Keeper) IssueCurrency(ctx sdk.Context, id string, coin sdk.Coin, payee s
 	curSupply = curSupply.SetTotal(curSupply.GetTotal().Add(coin))
 	k.supplyKeeper.SetSupply(ctx, curSupply)
 
-	ctx.EventManager().EmitEvent(types.NewIssueEvent(id, coin, payee))
+	ctx.EventManager().EmitEvent(types.NewIssueEvent(id, issue))
 
 	return
 }
@@ -97,6 +104,27 @@ func (k Keeper) GetGenesisIssues(ctx sdk.Context) []types.GenesisIssue {
 	return issues
 }
 
+// GetIssuesByTxHash returns issues (with IDs) created for the source chain deposit tx hash.
+func (k Keeper) GetIssuesByTxHash(ctx sdk.Context, txHash string) []types.GenesisIssue {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	issues := make([]types.GenesisIssue, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetIssueDepositTxHashPrefix(txHash))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		issueID := string(iterator.Value())
+		issues = append(issues, types.GenesisIssue{
+			Issue: k.getIssue(ctx, issueID),
+			ID:    issueID,
+		})
+	}
+
+	return issues
+}
+
 // getIssue returns issue from the storage.
 func (k Keeper) getIssue(ctx sdk.Context, id string) types.Issue {
 	store := ctx.KVStore(k.storeKey)
@@ -108,8 +136,23 @@ func (k Keeper) getIssue(ctx sdk.Context, id string) types.Issue {
 	return issue
 }
 
-// storeIssue sets issue to the storage.
+// storeIssue sets issue and its source chain deposit index (if deposit is set) to the storage.
 func (k Keeper) storeIssue(ctx sdk.Context, id string, issue types.Issue) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetIssuesKey(id), k.cdc.MustMarshalBinaryBare(issue))
+
+	if !issue.Deposit.IsEmpty() {
+		store.Set(types.GetIssueDepositKey(issue.Deposit), []byte(id))
+	}
+}
+
+// getIssueIDByDeposit returns issue ID for the source chain deposit from the storage.
+func (k Keeper) getIssueIDByDeposit(ctx sdk.Context, deposit types.IssueDeposit) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIssueDepositKey(deposit))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
 }
@@ -3,10 +3,13 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/currencies/internal/types"
 )
 
 // Test keeper IssueCurrency method.
@@ -20,7 +23,7 @@ func TestCurrenciesKeeper_IssueCurrency(t *testing.T) {
 	// ok
 	{
 		require.False(t, keeper.HasIssue(ctx, defIssueID1))
-		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr, newIssueDeposit(defIssueID1)))
 
 		// check account balance changed
 		require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).Equal(defAmount))
@@ -46,7 +49,30 @@ func TestCurrenciesKeeper_IssueCurrency(t *testing.T) {
 
 	// fail: existing issueID
 	{
-		require.Error(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+		require.Error(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr, newIssueDeposit(defIssueID1)))
+	}
+
+	// fail: existing deposit
+	{
+		deposit := newIssueDeposit(defIssueID1)
+		require.True(t, types.ErrWrongIssueDeposit.Is(keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr, deposit)))
+
+		// same deposit tx hash in a different case
+		deposit.TxHash = strings.ToUpper(deposit.TxHash)
+		require.True(t, types.ErrWrongIssueDeposit.Is(keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr, deposit)))
+
+		// same deposit tx hash without the "0x" prefix
+		deposit.TxHash = strings.TrimPrefix(newIssueDeposit(defIssueID1).TxHash, "0x")
+		require.True(t, types.ErrWrongIssueDeposit.Is(keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr, deposit)))
+
+		// same deposit chain ID in a different case
+		deposit.ChainID = strings.ToUpper(deposit.ChainID)
+		require.True(t, types.ErrWrongIssueDeposit.Is(keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr, deposit)))
+	}
+
+	// fail: invalid deposit
+	{
+		require.True(t, types.ErrWrongIssueDeposit.Is(keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr, types.IssueDeposit{})))
 	}
 
 	// ok: issue existing currency, increasing supply
@@ -54,7 +80,7 @@ func TestCurrenciesKeeper_IssueCurrency(t *testing.T) {
 		newAmount := defAmount.MulRaw(2)
 
 		require.False(t, keeper.HasIssue(ctx, defIssueID2))
-		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr))
+		require.NoError(t, keeper.IssueCurrency(ctx, defIssueID2, defCoin, addr, newIssueDeposit(defIssueID2)))
 
 		// check account balance changed
 		require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).Equal(newAmount))
@@ -80,7 +106,7 @@ func TestCurrenciesKeeper_GetIssue(t *testing.T) {
 	ctx, keeper := input.ctx, input.keeper
 
 	// issue currency
-	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr, newIssueDeposit(defIssueID1)))
 
 	// ok
 	{
@@ -111,6 +137,43 @@ func TestCurrenciesKeeper_IssueHugeAmount(t *testing.T) {
 	require.True(t, ok)
 	coin := sdk.NewCoin(defDenom, amount)
 
-	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, coin, addr))
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, coin, addr, newIssueDeposit(defIssueID1)))
 	require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).Equal(amount))
 }
+
+// Test keeper GetIssuesByTxHash method.
+func TestCurrenciesKeeper_GetIssuesByTxHash(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	addr := input.CreateAccount(t, "addr1", nil)
+	ctx, keeper := input.ctx, input.keeper
+
+	// issue two deposits from the same tx and one from the other tx
+	deposit1 := types.NewIssueDeposit("ethereum", "0xABCDEF", 0, "0xsender")
+	deposit2 := types.NewIssueDeposit("ethereum", "0xABCDEF", 1, "0xsender")
+	deposit3 := types.NewIssueDeposit("ethereum", "0xABCDEE", 0, "0xsender")
+	require.NoError(t, keeper.IssueCurrency(ctx, "issue1", defCoin, addr, deposit1))
+	require.NoError(t, keeper.IssueCurrency(ctx, "issue2", defCoin, addr, deposit2))
+	require.NoError(t, keeper.IssueCurrency(ctx, "issue3", defCoin, addr, deposit3))
+
+	// ok
+	{
+		issues := keeper.GetIssuesByTxHash(ctx, "0xabcdef")
+		require.Len(t, issues, 2)
+		require.Equal(t, "issue1", issues[0].ID)
+		require.Equal(t, deposit1, issues[0].Deposit)
+		require.Equal(t, "issue2", issues[1].ID)
+		require.Equal(t, deposit2, issues[1].Deposit)
+	}
+
+	// ok: tx hash without the "0x" prefix
+	{
+		require.Len(t, keeper.GetIssuesByTxHash(ctx, "ABCDEF"), 2)
+	}
+
+	// ok: not found
+	{
+		require.Empty(t, keeper.GetIssuesByTxHash(ctx, "0xabcd"))
+	}
+}
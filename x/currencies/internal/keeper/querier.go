@@ -19,10 +19,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetWithdraw(k, ctx, req)
 		case types.QueryIssue:
 			return queryGetIssue(k, ctx, req)
+		case types.QueryIssuesByTxHash:
+			return queryGetIssuesByTxHash(k, ctx, req)
 		case types.QueryCurrency:
 			return queryGetCurrency(k, ctx, req)
 		case types.QueryCurrencies:
 			return queryGetCurrencies(k, ctx)
+		case types.QueryWithdrawLimits:
+			return queryGetWithdrawLimits(k, ctx, req)
+		case types.QueryWithdrawQuota:
+			return queryGetWithdrawQuota(k, ctx, req)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -89,6 +95,25 @@ func queryGetIssue(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, er
 	return bz, nil
 }
 
+// queryGetIssuesByTxHash handles getIssuesByTxHash query which return issues by the source chain deposit tx hash.
+func queryGetIssuesByTxHash(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
+	params := types.IssuesByTxHashReq{}
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	if params.TxHash == "" {
+		return nil, sdkErrors.Wrap(types.ErrWrongIssueDeposit, "tx_hash: empty")
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetIssuesByTxHash(ctx, params.TxHash))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "issues marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
 // queryGetCurrency handles getCurrency query which return currency by denom.
 func queryGetCurrency(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
 	params := types.CurrencyReq{}
@@ -120,3 +145,42 @@ func queryGetCurrencies(k Keeper, ctx sdk.Context) ([]byte, error) {
 
 	return bz, nil
 }
+
+// queryGetWithdrawLimits handles getWithdrawLimits query which return currency withdraw limits.
+func queryGetWithdrawLimits(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
+	params := types.WithdrawLimitsReq{}
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	if !k.ccsKeeper.HasCurrency(ctx, params.Denom) {
+		return nil, sdkErrors.Wrapf(types.ErrWrongDenom, "currency %q: not found", params.Denom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetWithdrawLimits(ctx, params.Denom))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "withdraw limits marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// queryGetWithdrawQuota handles getWithdrawQuota query which return account remaining withdraw quota.
+func queryGetWithdrawQuota(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
+	params := types.WithdrawQuotaReq{}
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	quota, err := k.GetWithdrawQuota(ctx, params.Denom, params.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, quota)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "withdraw quota marshal: %v", err)
+	}
+
+	return bz, nil
+}
@@ -4,11 +4,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/dfinance/dnode/helpers"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 	"github.com/dfinance/dnode/x/currencies/internal/types"
 )
 
 // WithdrawCurrency lowers payee coin balance.
+// Withdraw is checked against currency withdraw limits (withdraws are disabled until limits are set).
 func (k Keeper) WithdrawCurrency(ctx sdk.Context, coin sdk.Coin, spender sdk.AccAddress, recipient, chainID string) (retErr error) {
 	k.modulePerms.AutoCheck(types.PermWithdraw)
 
@@ -19,13 +21,18 @@ func (k Keeper) WithdrawCurrency(ctx sdk.Context, coin sdk.Coin, spender sdk.Acc
 		}
 	}()
 
-	// check and update currency
-	if _, err := k.ccsKeeper.GetCurrency(ctx, coin.Denom); err != nil {
+	// check currency exists and is not paused
+	if err := k.ccsKeeper.CheckCurrencyActive(ctx, coin.Denom); err != nil {
 		return err
 	}
 
-	// store withdraw
+	// check and update withdraw limits usage
 	newId := k.getNextWithdrawID(ctx)
+	if err := k.checkAndUpdateWithdrawLimits(ctx, newId, coin, spender); err != nil {
+		return err
+	}
+
+	// store withdraw
 	withdraw := types.NewWithdraw(newId, coin, spender, recipient, chainID, ctx.BlockHeader().Time.Unix(), ctx.TxBytes())
 
 	k.storeWithdraw(ctx, withdraw)
@@ -51,6 +58,67 @@ func (k Keeper) WithdrawCurrency(ctx sdk.Context, coin sdk.Coin, spender sdk.Acc
 	return
 }
 
+// ConfirmWithdraw marks pending withdraw as confirmed storing the peg zone transfer tx hash.
+// Confirm is a multisig operation.
+func (k Keeper) ConfirmWithdraw(ctx sdk.Context, id dnTypes.ID, pzTxHash string) error {
+	k.modulePerms.AutoCheck(types.PermWithdraw)
+
+	withdraw, err := k.getPendingWithdraw(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	withdraw.State = types.WithdrawStateConfirmed
+	withdraw.PegZoneTxHash = pzTxHash
+	k.storeWithdraw(ctx, withdraw)
+
+	ctx.EventManager().EmitEvent(types.NewWithdrawConfirmEvent(withdraw))
+
+	return nil
+}
+
+// RefundWithdraw marks pending withdraw as refunded and mints withdrawn coins back to the spender.
+// Withdraw amount is released from the spender account daily withdraw usage.
+// Refund is a multisig operation.
+func (k Keeper) RefundWithdraw(ctx sdk.Context, id dnTypes.ID) (retErr error) {
+	k.modulePerms.AutoCheck(types.PermWithdraw)
+
+	withdraw, err := k.getPendingWithdraw(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// bankKeeper might panic
+	defer func() {
+		if r := recover(); r != nil {
+			retErr = sdkErrors.Wrapf(types.ErrInternal, "bankKeeper.AddCoins for address %q panic: %v", withdraw.Spender, r)
+		}
+	}()
+
+	withdraw.State = types.WithdrawStateRefunded
+	k.storeWithdraw(ctx, withdraw)
+	k.releaseWithdrawLimitsUsage(ctx, withdraw)
+
+	// update account balance
+	coins := sdk.NewCoins(withdraw.Coin)
+	if _, err := k.bankKeeper.AddCoins(ctx, withdraw.Spender, coins); err != nil {
+		return sdkErrors.Wrapf(types.ErrInternal, "bankKeeper.AddCoins for address %q: %v", withdraw.Spender, err)
+	}
+
+	// increase supply
+	if err := k.ccsKeeper.IncreaseCurrencySupply(ctx, withdraw.Coin); err != nil {
+		return err
+	}
+
+	curSupply := k.supplyKeeper.GetSupply(ctx)
+	curSupply = curSupply.SetTotal(curSupply.GetTotal().Add(coins...))
+	k.supplyKeeper.SetSupply(ctx, curSupply)
+
+	ctx.EventManager().EmitEvent(types.NewWithdrawRefundEvent(withdraw))
+
+	return
+}
+
 // HasWithdraw checks that withdraw exists.
 func (k Keeper) HasWithdraw(ctx sdk.Context, id dnTypes.ID) bool {
 	k.modulePerms.AutoCheck(types.PermRead)
@@ -71,10 +139,14 @@ func (k Keeper) GetWithdraw(ctx sdk.Context, id dnTypes.ID) (types.Withdraw, err
 	return k.getWithdraw(ctx, id), nil
 }
 
-// GetWithdrawsFiltered returns withdraw objects list with pagination params.
+// GetWithdrawsFiltered returns withdraw objects list with pagination and state filter params.
 func (k Keeper) GetWithdrawsFiltered(ctx sdk.Context, params types.WithdrawsReq) (types.Withdraws, error) {
 	k.modulePerms.AutoCheck(types.PermRead)
 
+	if params.State != "" {
+		return k.getWithdrawsFilteredByState(ctx, params)
+	}
+
 	if params.Page.GT(sdk.ZeroUint()) {
 		params.Page = params.Page.SubUint64(1)
 	}
@@ -96,6 +168,31 @@ func (k Keeper) GetWithdrawsFiltered(ctx sdk.Context, params types.WithdrawsReq)
 	return withdraws, nil
 }
 
+// getWithdrawsFilteredByState returns withdraw objects list filtered by state with pagination params.
+func (k Keeper) getWithdrawsFilteredByState(ctx sdk.Context, params types.WithdrawsReq) (types.Withdraws, error) {
+	if !params.State.IsValid() {
+		return nil, sdkErrors.Wrapf(types.ErrWrongWithdrawState, "state %q: unknown", params.State)
+	}
+
+	withdraws := make(types.Withdraws, 0)
+	for _, withdraw := range k.getWithdraws(ctx) {
+		if withdraw.State.String() == params.State.String() {
+			withdraws = append(withdraws, withdraw)
+		}
+	}
+
+	if params.Page.IsZero() {
+		params.Page = sdk.OneUint()
+	}
+
+	start, end, err := helpers.PaginateSlice(len(withdraws), params.Page, params.Limit)
+	if err != nil {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, err.Error())
+	}
+
+	return withdraws[start:end], nil
+}
+
 // getWithdraw returns withdraw from the storage.
 func (k Keeper) getWithdraw(ctx sdk.Context, id dnTypes.ID) types.Withdraw {
 	store := ctx.KVStore(k.storeKey)
@@ -125,6 +222,20 @@ func (k Keeper) getWithdraws(ctx sdk.Context) types.Withdraws {
 	return withdraws
 }
 
+// getPendingWithdraw returns withdraw from the storage checking it is waiting for the peg zone transfer confirmation.
+func (k Keeper) getPendingWithdraw(ctx sdk.Context, id dnTypes.ID) (types.Withdraw, error) {
+	if !k.HasWithdraw(ctx, id) {
+		return types.Withdraw{}, sdkErrors.Wrapf(types.ErrWrongWithdrawID, "withdrawID %q: not found", id.String())
+	}
+
+	withdraw := k.getWithdraw(ctx, id)
+	if !withdraw.IsPending() {
+		return types.Withdraw{}, sdkErrors.Wrapf(types.ErrWrongWithdrawState, "withdrawID %q: %s (not %s)", id.String(), withdraw.State, types.WithdrawStatePending)
+	}
+
+	return withdraw, nil
+}
+
 // storeWithdraw sets withdraw to the storage.
 func (k Keeper) storeWithdraw(ctx sdk.Context, withdraw types.Withdraw) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/currencies/internal/types"
+)
+
+// SetWithdrawLimits sets currency withdraw limits (zero values disable limits).
+func (k Keeper) SetWithdrawLimits(ctx sdk.Context, limits types.WithdrawLimits) error {
+	k.modulePerms.AutoCheck(types.PermCreate)
+
+	if err := limits.Validate(); err != nil {
+		return sdkErrors.Wrap(types.ErrWrongWithdrawLimit, err.Error())
+	}
+
+	if !k.ccsKeeper.HasCurrency(ctx, limits.Denom) {
+		return sdkErrors.Wrapf(types.ErrWrongDenom, "currency %q: not found", limits.Denom)
+	}
+
+	k.storeWithdrawLimits(ctx, limits)
+
+	return nil
+}
+
+// GetWithdrawLimits returns currency withdraw limits (all limits are disabled if not set).
+func (k Keeper) GetWithdrawLimits(ctx sdk.Context, denom string) types.WithdrawLimits {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawLimitsKey(denom))
+	if bz == nil {
+		return types.NewEmptyWithdrawLimits(denom)
+	}
+
+	limits := types.WithdrawLimits{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &limits)
+
+	return limits
+}
+
+// GetWithdrawQuota returns account remaining withdraw quota for the currency.
+func (k Keeper) GetWithdrawQuota(ctx sdk.Context, denom string, address sdk.AccAddress) (types.WithdrawQuota, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.ccsKeeper.HasCurrency(ctx, denom) {
+		return types.WithdrawQuota{}, sdkErrors.Wrapf(types.ErrWrongDenom, "currency %q: not found", denom)
+	}
+
+	limits := k.GetWithdrawLimits(ctx, denom)
+	accountUsed := k.getWithdrawAccountUsage(ctx, denom, address).Total()
+	blockUsed := k.getWithdrawBlockUsage(ctx, denom)
+
+	quota := types.WithdrawQuota{
+		Limits:                limits,
+		Address:               address,
+		AccountDailyUsed:      accountUsed,
+		AccountDailyRemaining: sdk.ZeroInt(),
+		BlockRemaining:        sdk.ZeroInt(),
+	}
+	if limits.AccountDailyCap.GT(accountUsed) {
+		quota.AccountDailyRemaining = limits.AccountDailyCap.Sub(accountUsed)
+	}
+	if limits.BlockCap.GT(blockUsed) {
+		quota.BlockRemaining = limits.BlockCap.Sub(blockUsed)
+	}
+
+	return quota, nil
+}
+
+// checkAndUpdateWithdrawLimits checks withdraw against currency limits and updates account / block usage.
+// Withdraws are disabled for currencies with no withdraw limits set.
+func (k Keeper) checkAndUpdateWithdrawLimits(ctx sdk.Context, withdrawID dnTypes.ID, coin sdk.Coin, spender sdk.AccAddress) error {
+	if !k.hasWithdrawLimits(ctx, coin.Denom) {
+		return sdkErrors.Wrapf(types.ErrWithdrawDisabled, "currency %q: withdraw limits not set", coin.Denom)
+	}
+	limits := k.GetWithdrawLimits(ctx, coin.Denom)
+
+	if err := limits.CheckAmount(coin.Amount); err != nil {
+		return sdkErrors.Wrap(types.ErrWithdrawLimit, err.Error())
+	}
+
+	accountUsage := k.getWithdrawAccountUsage(ctx, coin.Denom, spender)
+	if limits.AccountDailyCap.IsPositive() {
+		if accountUsed := accountUsage.Total(); accountUsed.Add(coin.Amount).GT(limits.AccountDailyCap) {
+			return sdkErrors.Wrapf(types.ErrWithdrawLimit, "account daily cap %s: used %s", limits.AccountDailyCap, accountUsed)
+		}
+	}
+
+	blockUsed := k.getWithdrawBlockUsage(ctx, coin.Denom)
+	if limits.BlockCap.IsPositive() {
+		if blockUsed.Add(coin.Amount).GT(limits.BlockCap) {
+			return sdkErrors.Wrapf(types.ErrWithdrawLimit, "block cap %s: used %s", limits.BlockCap, blockUsed)
+		}
+	}
+
+	accountUsage = append(accountUsage, types.WithdrawUsageEntry{
+		WithdrawID: withdrawID,
+		Timestamp:  ctx.BlockTime().Unix(),
+		Amount:     coin.Amount,
+	})
+	k.setWithdrawAccountUsage(ctx, coin.Denom, spender, accountUsage)
+	k.setWithdrawBlockUsage(ctx, coin.Denom, types.WithdrawBlockUsage{
+		Height: ctx.BlockHeight(),
+		Amount: blockUsed.Add(coin.Amount),
+	})
+
+	return nil
+}
+
+// releaseWithdrawLimitsUsage removes refunded withdraw from the spender account usage.
+// Block usage is kept as block cap limits the withdraws throughput.
+func (k Keeper) releaseWithdrawLimitsUsage(ctx sdk.Context, withdraw types.Withdraw) {
+	accountUsage := k.getWithdrawAccountUsage(ctx, withdraw.Coin.Denom, withdraw.Spender)
+	k.setWithdrawAccountUsage(ctx, withdraw.Coin.Denom, withdraw.Spender, accountUsage.Remove(withdraw.ID))
+}
+
+// getWithdrawLimitsList returns all stored currency withdraw limits.
+func (k Keeper) getWithdrawLimitsList(ctx sdk.Context) types.WithdrawLimitsList {
+	list := types.WithdrawLimitsList{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetWithdrawLimitsPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var limits types.WithdrawLimits
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &limits)
+
+		list = append(list, limits)
+	}
+
+	return list
+}
+
+// hasWithdrawLimits checks if currency withdraw limits are set.
+func (k Keeper) hasWithdrawLimits(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetWithdrawLimitsKey(denom))
+}
+
+// storeWithdrawLimits sets currency withdraw limits to the storage.
+func (k Keeper) storeWithdrawLimits(ctx sdk.Context, limits types.WithdrawLimits) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawLimitsKey(limits.Denom), k.cdc.MustMarshalBinaryBare(limits))
+}
+
+// getWithdrawAccountUsage returns account withdraws within the rolling cap period.
+func (k Keeper) getWithdrawAccountUsage(ctx sdk.Context, denom string, address sdk.AccAddress) types.WithdrawAccountUsage {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawAccountUsageKey(denom, address))
+	if bz == nil {
+		return types.WithdrawAccountUsage{}
+	}
+
+	usage := types.WithdrawAccountUsage{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+
+	return usage.Prune(ctx.BlockTime())
+}
+
+// setWithdrawAccountUsage sets account withdraws within the rolling cap period to the storage (empty usage is removed).
+func (k Keeper) setWithdrawAccountUsage(ctx sdk.Context, denom string, address sdk.AccAddress, usage types.WithdrawAccountUsage) {
+	store := ctx.KVStore(k.storeKey)
+	if len(usage) == 0 {
+		store.Delete(types.GetWithdrawAccountUsageKey(denom, address))
+		return
+	}
+
+	store.Set(types.GetWithdrawAccountUsageKey(denom, address), k.cdc.MustMarshalBinaryBare(usage))
+}
+
+// getWithdrawAccountUsages returns all non-empty accounts withdraws within the rolling cap period.
+func (k Keeper) getWithdrawAccountUsages(ctx sdk.Context) []types.GenesisWithdrawAccountUsage {
+	usages := make([]types.GenesisWithdrawAccountUsage, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetWithdrawAccountUsagePrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom, address := types.MustParseWithdrawAccountUsageKey(iterator.Key())
+
+		usage := types.WithdrawAccountUsage{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+
+		if usage = usage.Prune(ctx.BlockTime()); len(usage) > 0 {
+			usages = append(usages, types.GenesisWithdrawAccountUsage{
+				Denom:   denom,
+				Address: address,
+				Usage:   usage,
+			})
+		}
+	}
+
+	return usages
+}
+
+// getWithdrawBlockUsage returns withdraws amount sum for the current block.
+func (k Keeper) getWithdrawBlockUsage(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawBlockUsageKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	usage := types.WithdrawBlockUsage{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+	if usage.Height != ctx.BlockHeight() {
+		return sdk.ZeroInt()
+	}
+
+	return usage.Amount
+}
+
+// setWithdrawBlockUsage sets withdraws amount sum for the block to the storage.
+func (k Keeper) setWithdrawBlockUsage(ctx sdk.Context, denom string, usage types.WithdrawBlockUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawBlockUsageKey(denom), k.cdc.MustMarshalBinaryBare(usage))
+}
@@ -0,0 +1,149 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/currencies/internal/types"
+)
+
+// Test keeper WithdrawCurrency method with withdraw limits.
+func TestCurrenciesKeeper_WithdrawLimits(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	addr1 := input.CreateAccount(t, "addr1", nil)
+	addr2 := input.CreateAccount(t, "addr2", nil)
+	ctx, keeper := input.ctx, input.keeper
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Now())
+
+	recipient := sdk.AccAddress("addr3").String()
+	newCoin := func(amount int64) sdk.Coin {
+		return sdk.NewCoin(defDenom, sdk.NewInt(amount))
+	}
+
+	// fail: unknown currency
+	{
+		limits := types.NewEmptyWithdrawLimits("test")
+		require.True(t, types.ErrWrongDenom.Is(keeper.SetWithdrawLimits(ctx, limits)))
+	}
+
+	// issue currency
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, newCoin(1000), addr1, newIssueDeposit(defIssueID1)))
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID2, newCoin(1000), addr2, newIssueDeposit(defIssueID2)))
+
+	// check no limits by default
+	{
+		limits := keeper.GetWithdrawLimits(ctx, defDenom)
+		require.True(t, limits.MinAmount.IsZero())
+		require.True(t, limits.MaxAmount.IsZero())
+		require.True(t, limits.AccountDailyCap.IsZero())
+		require.True(t, limits.BlockCap.IsZero())
+	}
+
+	// fail: invalid limits
+	{
+		limits := types.NewWithdrawLimits(defDenom, sdk.NewInt(10), sdk.NewInt(5), sdk.ZeroInt(), sdk.ZeroInt())
+		require.True(t, types.ErrWrongWithdrawLimit.Is(keeper.SetWithdrawLimits(ctx, limits)))
+	}
+
+	// set limits
+	limits := types.NewWithdrawLimits(defDenom, sdk.NewInt(10), sdk.NewInt(100), sdk.NewInt(150), sdk.NewInt(200))
+	require.NoError(t, keeper.SetWithdrawLimits(ctx, limits))
+	require.Equal(t, limits, keeper.GetWithdrawLimits(ctx, defDenom))
+
+	// fail: min / max amount
+	{
+		require.True(t, types.ErrWithdrawLimit.Is(keeper.WithdrawCurrency(ctx, newCoin(9), addr1, recipient, ctx.ChainID())))
+		require.True(t, types.ErrWithdrawLimit.Is(keeper.WithdrawCurrency(ctx, newCoin(101), addr1, recipient, ctx.ChainID())))
+	}
+
+	// ok: within account and block caps
+	{
+		require.NoError(t, keeper.WithdrawCurrency(ctx, newCoin(100), addr1, recipient, ctx.ChainID()))
+
+		quota, err := keeper.GetWithdrawQuota(ctx, defDenom, addr1)
+		require.NoError(t, err)
+		require.Equal(t, limits, quota.Limits)
+		require.Equal(t, sdk.NewInt(100).String(), quota.AccountDailyUsed.String())
+		require.Equal(t, sdk.NewInt(50).String(), quota.AccountDailyRemaining.String())
+		require.Equal(t, sdk.NewInt(100).String(), quota.BlockRemaining.String())
+	}
+
+	// fail: account daily cap
+	{
+		require.True(t, types.ErrWithdrawLimit.Is(keeper.WithdrawCurrency(ctx, newCoin(51), addr1, recipient, ctx.ChainID())))
+	}
+
+	// ok: refund releases account daily usage
+	{
+		require.Len(t, keeper.getWithdrawAccountUsages(ctx), 1)
+		require.NoError(t, keeper.RefundWithdraw(ctx, keeper.getLastWithdrawID(ctx)))
+
+		quota, err := keeper.GetWithdrawQuota(ctx, defDenom, addr1)
+		require.NoError(t, err)
+		require.True(t, quota.AccountDailyUsed.IsZero())
+		require.Equal(t, limits.AccountDailyCap.String(), quota.AccountDailyRemaining.String())
+		require.Equal(t, sdk.NewInt(100).String(), quota.BlockRemaining.String())
+		require.Empty(t, keeper.getWithdrawAccountUsages(ctx))
+	}
+
+	// fail: block cap
+	{
+		require.NoError(t, keeper.WithdrawCurrency(ctx, newCoin(90), addr2, recipient, ctx.ChainID()))
+		require.True(t, types.ErrWithdrawLimit.Is(keeper.WithdrawCurrency(ctx, newCoin(11), addr2, recipient, ctx.ChainID())))
+		require.NoError(t, keeper.WithdrawCurrency(ctx, newCoin(10), addr2, recipient, ctx.ChainID()))
+	}
+
+	// ok: block cap is reset for the next block
+	{
+		ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+
+		quota, err := keeper.GetWithdrawQuota(ctx, defDenom, addr2)
+		require.NoError(t, err)
+		require.Equal(t, limits.BlockCap.String(), quota.BlockRemaining.String())
+		require.Equal(t, sdk.NewInt(50).String(), quota.AccountDailyRemaining.String())
+
+		require.NoError(t, keeper.WithdrawCurrency(ctx, newCoin(50), addr2, recipient, ctx.ChainID()))
+	}
+
+	// check account usages export
+	{
+		usages := keeper.getWithdrawAccountUsages(ctx)
+		require.Len(t, usages, 1)
+		require.Equal(t, defDenom, usages[0].Denom)
+		require.Equal(t, addr2, usages[0].Address)
+		require.Len(t, usages[0].Usage, 3)
+		require.Equal(t, sdk.NewInt(150).String(), usages[0].Usage.Total().String())
+	}
+
+	// ok: account daily cap is rolled over
+	{
+		ctx = ctx.WithBlockHeight(3).WithBlockTime(ctx.BlockTime().Add(types.WithdrawAccountCapPeriod))
+
+		quota, err := keeper.GetWithdrawQuota(ctx, defDenom, addr1)
+		require.NoError(t, err)
+		require.True(t, quota.AccountDailyUsed.IsZero())
+		require.Equal(t, limits.AccountDailyCap.String(), quota.AccountDailyRemaining.String())
+
+		require.NoError(t, keeper.WithdrawCurrency(ctx, newCoin(100), addr1, recipient, ctx.ChainID()))
+	}
+
+	// ok: limits disabled
+	{
+		require.NoError(t, keeper.SetWithdrawLimits(ctx, types.NewEmptyWithdrawLimits(defDenom)))
+		require.NoError(t, keeper.WithdrawCurrency(ctx, newCoin(500), addr1, recipient, ctx.ChainID()))
+	}
+
+	// check limits list
+	{
+		list := keeper.getWithdrawLimitsList(ctx)
+		require.Len(t, list, 1)
+		require.Equal(t, defDenom, list[0].Denom)
+	}
+}
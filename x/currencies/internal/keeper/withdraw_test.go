@@ -29,7 +29,16 @@ func TestCurrenciesKeeper_WithdrawCurrency(t *testing.T) {
 	}
 
 	// issue currency
-	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr, newIssueDeposit(defIssueID1)))
+
+	// fail: withdraws disabled (no withdraw limits set)
+	{
+		err := keeper.WithdrawCurrency(ctx, defCoin, addr, recipient.String(), ctx.ChainID())
+		require.Error(t, err)
+		require.True(t, types.ErrWithdrawDisabled.Is(err))
+	}
+
+	input.EnableWithdraws(t, defDenom)
 
 	// ok
 	{
@@ -69,11 +78,12 @@ func TestCurrenciesKeeper_GetWithdraw(t *testing.T) {
 	input := NewTestInput(t)
 	addr := input.CreateAccount(t, "addr1", nil)
 	ctx, keeper := input.ctx, input.keeper
+	input.EnableWithdraws(t, defDenom)
 
 	recipient := sdk.AccAddress("addr2")
 
 	// issue currency
-	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr))
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr, newIssueDeposit(defIssueID1)))
 
 	// withdraw currency
 	require.NoError(t, keeper.WithdrawCurrency(ctx, defCoin, addr, recipient.String(), ctx.ChainID()))
@@ -106,6 +116,7 @@ func TestCurrenciesKeeper_GetWithdrawsFiltered(t *testing.T) {
 	input := NewTestInput(t)
 	addr := input.CreateAccount(t, "addr1", nil)
 	ctx, keeper := input.ctx, input.keeper
+	input.EnableWithdraws(t, defDenom)
 
 	recipient := sdk.AccAddress("addr2")
 	withdrawCount := 5
@@ -114,7 +125,7 @@ func TestCurrenciesKeeper_GetWithdrawsFiltered(t *testing.T) {
 
 	// issue currency
 	coin := sdk.NewCoin(defDenom, amount)
-	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, coin, addr))
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, coin, addr, newIssueDeposit(defIssueID1)))
 
 	// multiple withdraws
 	for i := 0; i < withdrawCount; i++ {
@@ -181,3 +192,102 @@ func TestCurrenciesKeeper_GetWithdrawsFiltered(t *testing.T) {
 		require.Len(t, withdraws, 0)
 	}
 }
+
+// Test keeper ConfirmWithdraw and RefundWithdraw methods.
+func TestCurrenciesKeeper_WithdrawLifecycle(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	addr := input.CreateAccount(t, "addr1", nil)
+	ctx, keeper := input.ctx, input.keeper
+	input.EnableWithdraws(t, defDenom)
+
+	recipient := sdk.AccAddress("addr2")
+	withdrawCoin := sdk.NewCoin(defDenom, defCoin.Amount.QuoRaw(2))
+
+	// issue currency and withdraw twice
+	require.NoError(t, keeper.IssueCurrency(ctx, defIssueID1, defCoin, addr, newIssueDeposit(defIssueID1)))
+	require.NoError(t, keeper.WithdrawCurrency(ctx, withdrawCoin, addr, recipient.String(), ctx.ChainID()))
+	require.NoError(t, keeper.WithdrawCurrency(ctx, withdrawCoin, addr, recipient.String(), ctx.ChainID()))
+	confirmID, refundID := dnTypes.NewIDFromUint64(0), dnTypes.NewIDFromUint64(1)
+
+	// check pending
+	{
+		withdraw, err := keeper.GetWithdraw(ctx, confirmID)
+		require.NoError(t, err)
+		require.Equal(t, types.WithdrawStatePending, withdraw.State)
+		require.True(t, withdraw.IsPending())
+	}
+
+	// fail: non-existing
+	{
+		nonExistingID := dnTypes.NewIDFromUint64(2)
+		require.True(t, types.ErrWrongWithdrawID.Is(keeper.ConfirmWithdraw(ctx, nonExistingID, "pzHash")))
+		require.True(t, types.ErrWrongWithdrawID.Is(keeper.RefundWithdraw(ctx, nonExistingID)))
+	}
+
+	// ok: confirm
+	{
+		require.NoError(t, keeper.ConfirmWithdraw(ctx, confirmID, "pzHash"))
+
+		withdraw, err := keeper.GetWithdraw(ctx, confirmID)
+		require.NoError(t, err)
+		require.Equal(t, types.WithdrawStateConfirmed, withdraw.State)
+		require.Equal(t, "pzHash", withdraw.PegZoneTxHash)
+	}
+
+	// ok: refund
+	{
+		require.NoError(t, keeper.RefundWithdraw(ctx, refundID))
+
+		withdraw, err := keeper.GetWithdraw(ctx, refundID)
+		require.NoError(t, err)
+		require.Equal(t, types.WithdrawStateRefunded, withdraw.State)
+		require.Empty(t, withdraw.PegZoneTxHash)
+
+		// check account balance restored
+		require.True(t, input.bankKeeper.GetCoins(ctx, addr).AmountOf(defDenom).Equal(withdrawCoin.Amount))
+
+		// check currency supply increased
+		currency, err := input.ccsStorage.GetCurrency(ctx, defDenom)
+		require.NoError(t, err)
+		require.True(t, currency.Supply.Equal(withdrawCoin.Amount))
+
+		// check supply mod supply increased
+		supply := input.supplyKeeper.GetSupply(ctx)
+		require.True(t, supply.GetTotal().AmountOf(defDenom).Equal(withdrawCoin.Amount))
+	}
+
+	// fail: not pending
+	{
+		require.True(t, types.ErrWrongWithdrawState.Is(keeper.ConfirmWithdraw(ctx, confirmID, "pzHash")))
+		require.True(t, types.ErrWrongWithdrawState.Is(keeper.RefundWithdraw(ctx, confirmID)))
+		require.True(t, types.ErrWrongWithdrawState.Is(keeper.ConfirmWithdraw(ctx, refundID, "pzHash")))
+		require.True(t, types.ErrWrongWithdrawState.Is(keeper.RefundWithdraw(ctx, refundID)))
+	}
+
+	// check state filter
+	{
+		require.NoError(t, keeper.WithdrawCurrency(ctx, withdrawCoin, addr, recipient.String(), ctx.ChainID()))
+
+		for state, expectedID := range map[types.WithdrawState]dnTypes.ID{
+			types.WithdrawStateConfirmed: confirmID,
+			types.WithdrawStateRefunded:  refundID,
+			types.WithdrawStatePending:   dnTypes.NewIDFromUint64(2),
+		} {
+			withdraws, err := keeper.GetWithdrawsFiltered(ctx, types.WithdrawsReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), State: state})
+			require.NoError(t, err)
+			require.Len(t, withdraws, 1, "state %s", state)
+			require.True(t, withdraws[0].ID.Equal(expectedID), "state %s", state)
+		}
+
+		// wrong page
+		withdraws, err := keeper.GetWithdrawsFiltered(ctx, types.WithdrawsReq{Page: sdk.NewUint(2), Limit: sdk.NewUint(10), State: types.WithdrawStatePending})
+		require.NoError(t, err)
+		require.Empty(t, withdraws)
+
+		// unknown state
+		_, err = keeper.GetWithdrawsFiltered(ctx, types.WithdrawsReq{Page: sdk.NewUint(1), Limit: sdk.NewUint(10), State: "unknown"})
+		require.Error(t, err)
+	}
+}
@@ -12,6 +12,14 @@ const (
 	CodecNameMsgWithdrawCurrency = ModuleName + "/WithdrawCurrency"
 	CodecNameAddCurrencyProposal = ModuleName + "/AddCurrencyProposal"
 	CodecNameMsgUnstakeCurrency  = ModuleName + "/UnstakeCurrency"
+	CodecNameMsgConfirmWithdraw  = ModuleName + "/ConfirmWithdraw"
+	CodecNameMsgRefundWithdraw   = ModuleName + "/RefundWithdraw"
+	//
+	CodecNameSetWithdrawLimitsProposal      = ModuleName + "/SetWithdrawLimitsProposal"
+	CodecNameUpdateCurrencyContractProposal = ModuleName + "/UpdateCurrencyContractProposal"
+	CodecNameSetCurrencyMetadataProposal    = ModuleName + "/SetCurrencyMetadataProposal"
+	CodecNamePauseCurrencyProposal          = ModuleName + "/PauseCurrencyProposal"
+	CodecNameUnpauseCurrencyProposal        = ModuleName + "/UnpauseCurrencyProposal"
 )
 
 var ModuleCdc *codec.Codec
@@ -22,6 +30,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawCurrency{}, CodecNameMsgWithdrawCurrency, nil)
 	cdc.RegisterConcrete(AddCurrencyProposal{}, CodecNameAddCurrencyProposal, nil)
 	cdc.RegisterConcrete(MsgUnstakeCurrency{}, CodecNameMsgUnstakeCurrency, nil)
+	cdc.RegisterConcrete(MsgConfirmWithdraw{}, CodecNameMsgConfirmWithdraw, nil)
+	cdc.RegisterConcrete(MsgRefundWithdraw{}, CodecNameMsgRefundWithdraw, nil)
+	cdc.RegisterConcrete(SetWithdrawLimitsProposal{}, CodecNameSetWithdrawLimitsProposal, nil)
+	cdc.RegisterConcrete(UpdateCurrencyContractProposal{}, CodecNameUpdateCurrencyContractProposal, nil)
+	cdc.RegisterConcrete(SetCurrencyMetadataProposal{}, CodecNameSetCurrencyMetadataProposal, nil)
+	cdc.RegisterConcrete(PauseCurrencyProposal{}, CodecNamePauseCurrencyProposal, nil)
+	cdc.RegisterConcrete(UnpauseCurrencyProposal{}, CodecNameUnpauseCurrencyProposal, nil)
 }
 
 func init() {
@@ -33,7 +48,19 @@ func init() {
 	msClient.RegisterMultiSigTypeCodec(MsgIssueCurrency{}, CodecNameMsgIssueCurrency)
 	msClient.RegisterMultiSigTypeCodec(MsgUnstakeCurrency{}, CodecNameMsgUnstakeCurrency)
 	msClient.RegisterMultiSigTypeCodec(MsgWithdrawCurrency{}, CodecNameMsgWithdrawCurrency)
+	msClient.RegisterMultiSigTypeCodec(MsgConfirmWithdraw{}, CodecNameMsgConfirmWithdraw)
+	msClient.RegisterMultiSigTypeCodec(MsgRefundWithdraw{}, CodecNameMsgRefundWithdraw)
 
 	gov.RegisterProposalType(ProposalTypeAddCurrency)
 	gov.RegisterProposalTypeCodec(AddCurrencyProposal{}, CodecNameAddCurrencyProposal)
+	gov.RegisterProposalType(ProposalTypeSetWithdrawLimits)
+	gov.RegisterProposalTypeCodec(SetWithdrawLimitsProposal{}, CodecNameSetWithdrawLimitsProposal)
+	gov.RegisterProposalType(ProposalTypeUpdateCurrencyContract)
+	gov.RegisterProposalTypeCodec(UpdateCurrencyContractProposal{}, CodecNameUpdateCurrencyContractProposal)
+	gov.RegisterProposalType(ProposalTypeSetCurrencyMetadata)
+	gov.RegisterProposalTypeCodec(SetCurrencyMetadataProposal{}, CodecNameSetCurrencyMetadataProposal)
+	gov.RegisterProposalType(ProposalTypePauseCurrency)
+	gov.RegisterProposalTypeCodec(PauseCurrencyProposal{}, CodecNamePauseCurrencyProposal)
+	gov.RegisterProposalType(ProposalTypeUnpauseCurrency)
+	gov.RegisterProposalTypeCodec(UnpauseCurrencyProposal{}, CodecNameUnpauseCurrencyProposal)
 }
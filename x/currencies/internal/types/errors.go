@@ -11,6 +11,12 @@ var (
 	ErrWrongIssueID       = sdkErrors.Register(ModuleName, 103, "wrong issueID")
 	ErrWrongWithdrawID    = sdkErrors.Register(ModuleName, 104, "wrong withdrawID")
 	ErrWrongPegZonePayee  = sdkErrors.Register(ModuleName, 105, "wrong PegZone payee")
+	ErrWrongWithdrawState = sdkErrors.Register(ModuleName, 106, "wrong withdraw state")
+	ErrWrongPegZoneTxHash = sdkErrors.Register(ModuleName, 107, "wrong PegZone tx hash")
+	ErrWithdrawLimit      = sdkErrors.Register(ModuleName, 108, "withdraw limit exceeded")
+	ErrWrongWithdrawLimit = sdkErrors.Register(ModuleName, 109, "wrong withdraw limits")
+	ErrWrongIssueDeposit  = sdkErrors.Register(ModuleName, 110, "wrong issue deposit")
+	ErrWithdrawDisabled   = sdkErrors.Register(ModuleName, 111, "withdraw disabled")
 	ErrGovInvalidProposal = sdkErrors.Register(ModuleName, 200, "invalid proposal")
 	ErrForceUnstake       = sdkErrors.Register(ModuleName, 300, "force unstake")
 	ErrNulifyBalance      = sdkErrors.Register(ModuleName, 301, "nullify balance")
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
@@ -10,21 +12,33 @@ const (
 	EventTypesIssue    = ModuleName + ".issue"
 	EventTypesWithdraw = ModuleName + ".withdraw"
 	//
+	EventTypesWithdrawConfirm = ModuleName + ".withdraw_confirm"
+	EventTypesWithdrawRefund  = ModuleName + ".withdraw_refund"
+	//
 	AttributeDenom      = "denom"
 	AttributeAmount     = "amount"
 	AttributeIssueId    = "issue_id"
 	AttributeWithdrawId = "withdraw_id"
 	AttributeSender     = "sender"
+	//
+	AttributePegZoneTxHash = "pegzone_tx_hash"
+	//
+	AttributeSourceChainID  = "source_chain_id"
+	AttributeSourceTxHash   = "source_tx_hash"
+	AttributeSourceLogIndex = "source_log_index"
 )
 
 // NewIssueEvent creates an Event on currency issue.
-func NewIssueEvent(id string, coin sdk.Coin, payee sdk.AccAddress) sdk.Event {
+func NewIssueEvent(id string, issue Issue) sdk.Event {
 	return sdk.NewEvent(
 		EventTypesIssue,
 		sdk.NewAttribute(AttributeIssueId, id),
-		sdk.NewAttribute(AttributeDenom, coin.Denom),
-		sdk.NewAttribute(AttributeAmount, coin.Amount.String()),
-		sdk.NewAttribute(AttributeSender, payee.String()),
+		sdk.NewAttribute(AttributeDenom, issue.Coin.Denom),
+		sdk.NewAttribute(AttributeAmount, issue.Coin.Amount.String()),
+		sdk.NewAttribute(AttributeSender, issue.Payee.String()),
+		sdk.NewAttribute(AttributeSourceChainID, issue.Deposit.ChainID),
+		sdk.NewAttribute(AttributeSourceTxHash, issue.Deposit.TxHash),
+		sdk.NewAttribute(AttributeSourceLogIndex, strconv.FormatUint(issue.Deposit.LogIndex, 10)),
 	)
 }
 
@@ -38,3 +52,23 @@ func NewWithdrawEvent(id dnTypes.ID, coin sdk.Coin, spender sdk.AccAddress) sdk.
 		sdk.NewAttribute(AttributeSender, spender.String()),
 	)
 }
+
+// NewWithdrawConfirmEvent creates an Event on currency withdraw peg zone transfer confirmation.
+func NewWithdrawConfirmEvent(withdraw Withdraw) sdk.Event {
+	return sdk.NewEvent(
+		EventTypesWithdrawConfirm,
+		sdk.NewAttribute(AttributeWithdrawId, withdraw.ID.String()),
+		sdk.NewAttribute(AttributePegZoneTxHash, withdraw.PegZoneTxHash),
+	)
+}
+
+// NewWithdrawRefundEvent creates an Event on currency withdraw refund.
+func NewWithdrawRefundEvent(withdraw Withdraw) sdk.Event {
+	return sdk.NewEvent(
+		EventTypesWithdrawRefund,
+		sdk.NewAttribute(AttributeWithdrawId, withdraw.ID.String()),
+		sdk.NewAttribute(AttributeDenom, withdraw.Coin.Denom),
+		sdk.NewAttribute(AttributeAmount, withdraw.Coin.Amount.String()),
+		sdk.NewAttribute(AttributeSender, withdraw.Spender.String()),
+	)
+}
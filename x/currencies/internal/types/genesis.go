@@ -4,20 +4,26 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 // GenesisState is module's genesis (initial state).
 type GenesisState struct {
-	Issues         []GenesisIssue `json:"issues" yaml:"issues"`
-	Withdraws      Withdraws      `json:"withdraws" yaml:"withdraws"`
-	LastWithdrawID *dnTypes.ID    `json:"last_withdraw_id" yaml:"last_withdraw_id"`
+	Issues         []GenesisIssue     `json:"issues" yaml:"issues"`
+	Withdraws      Withdraws          `json:"withdraws" yaml:"withdraws"`
+	LastWithdrawID *dnTypes.ID        `json:"last_withdraw_id" yaml:"last_withdraw_id"`
+	WithdrawLimits WithdrawLimitsList `json:"withdraw_limits" yaml:"withdraw_limits"`
+	// Accounts withdraws within the rolling 24h period
+	WithdrawUsages []GenesisWithdrawAccountUsage `json:"withdraw_usages" yaml:"withdraw_usages"`
 }
 
 // Valid checks that genesis state is valid.
 // Contract: withdraw timestamp check is performed if {curBlockTime} is not empty.
 func (s GenesisState) Validate(curBlockTime time.Time) error {
 	issueIdsSet := make(map[string]bool, len(s.Issues))
+	issueDepositsSet := make(map[string]bool, len(s.Issues))
 	for i, issue := range s.Issues {
 		if err := issue.Valid(); err != nil {
 			return fmt.Errorf("issue[%d]: %w", i, err)
@@ -27,6 +33,14 @@ func (s GenesisState) Validate(curBlockTime time.Time) error {
 			return fmt.Errorf("issue[%d]: duplicated ID %q", i, issue.ID)
 		}
 		issueIdsSet[issue.ID] = true
+
+		if !issue.Deposit.IsEmpty() {
+			depositKey := issue.Deposit.UniqueKey()
+			if issueDepositsSet[depositKey] {
+				return fmt.Errorf("issue[%d]: duplicated deposit %q", i, depositKey)
+			}
+			issueDepositsSet[depositKey] = true
+		}
 	}
 
 	maxWithdrawID := dnTypes.NewZeroID()
@@ -61,6 +75,31 @@ func (s GenesisState) Validate(curBlockTime time.Time) error {
 		}
 	}
 
+	limitsDenomsSet := make(map[string]bool, len(s.WithdrawLimits))
+	for i, limits := range s.WithdrawLimits {
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("withdraw_limits[%d]: %w", i, err)
+		}
+
+		if limitsDenomsSet[limits.Denom] {
+			return fmt.Errorf("withdraw_limits[%d]: duplicated denom %q", i, limits.Denom)
+		}
+		limitsDenomsSet[limits.Denom] = true
+	}
+
+	usagesSet := make(map[string]bool, len(s.WithdrawUsages))
+	for i, usage := range s.WithdrawUsages {
+		if err := usage.Valid(); err != nil {
+			return fmt.Errorf("withdraw_usages[%d]: %w", i, err)
+		}
+
+		usageKey := usage.Denom + "/" + usage.Address.String()
+		if usagesSet[usageKey] {
+			return fmt.Errorf("withdraw_usages[%d]: duplicated denom / address pair", i)
+		}
+		usagesSet[usageKey] = true
+	}
+
 	return nil
 }
 
@@ -82,11 +121,34 @@ func (issue GenesisIssue) Valid() error {
 	return nil
 }
 
+// GenesisWithdrawAccountUsage stores account withdraws within the rolling 24h period for genesisState.
+type GenesisWithdrawAccountUsage struct {
+	Denom   string               `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress       `json:"address" yaml:"address"`
+	Usage   WithdrawAccountUsage `json:"usage" yaml:"usage"`
+}
+
+func (usage GenesisWithdrawAccountUsage) Valid() error {
+	if err := dnTypes.DenomFilter(usage.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+	if usage.Address.Empty() {
+		return fmt.Errorf("address: empty")
+	}
+	if err := usage.Usage.Validate(); err != nil {
+		return fmt.Errorf("usage: %w", err)
+	}
+
+	return nil
+}
+
 // DefaultGenesisState returns default genesis state (validation is done on module init).
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		LastWithdrawID: nil,
 		Issues:         make([]GenesisIssue, 0),
 		Withdraws:      Withdraws{},
+		WithdrawLimits: WithdrawLimitsList{},
+		WithdrawUsages: make([]GenesisWithdrawAccountUsage, 0),
 	}
 }
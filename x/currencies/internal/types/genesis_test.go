@@ -15,7 +15,7 @@ import (
 // GenesisIssue validation.
 func TestCurrencies_GenesisIssue_Valid(t *testing.T) {
 	issue := GenesisIssue{
-		Issue: NewIssue(sdk.NewCoin("eth", sdk.ZeroInt()), sdk.AccAddress("addr1")),
+		Issue: NewIssue(sdk.NewCoin("eth", sdk.ZeroInt()), sdk.AccAddress("addr1"), IssueDeposit{}),
 		ID:    "",
 	}
 
@@ -25,7 +25,7 @@ func TestCurrencies_GenesisIssue_Valid(t *testing.T) {
 	}
 	// fail: id empty
 	{
-		issue.Issue = NewIssue(sdk.NewCoin("eth", sdk.OneInt()), sdk.AccAddress("addr1"))
+		issue.Issue = NewIssue(sdk.NewCoin("eth", sdk.OneInt()), sdk.AccAddress("addr1"), IssueDeposit{})
 		require.Error(t, issue.Valid())
 	}
 	// ok
@@ -47,7 +47,7 @@ func TestCurrencies_Genesis_Valid(t *testing.T) {
 		state := GenesisState{
 			Issues: []GenesisIssue{
 				{
-					Issue: NewIssue(coin, addr),
+					Issue: NewIssue(coin, addr, IssueDeposit{}),
 					ID:    "",
 				},
 			},
@@ -59,21 +59,37 @@ func TestCurrencies_Genesis_Valid(t *testing.T) {
 		state := GenesisState{
 			Issues: []GenesisIssue{
 				{
-					Issue: NewIssue(coin, addr),
+					Issue: NewIssue(coin, addr, IssueDeposit{}),
 					ID:    "1",
 				},
 				{
-					Issue: NewIssue(coin, addr),
+					Issue: NewIssue(coin, addr, IssueDeposit{}),
 					ID:    "2",
 				},
 				{
-					Issue: NewIssue(coin, addr),
+					Issue: NewIssue(coin, addr, IssueDeposit{}),
 					ID:    "1",
 				},
 			},
 		}
 		require.Error(t, state.Validate(time.Time{}))
 	}
+	// fail: duplicated issue deposits
+	{
+		state := GenesisState{
+			Issues: []GenesisIssue{
+				{
+					Issue: NewIssue(coin, addr, NewIssueDeposit("ethereum", "0xabcd", 0, "0xsender")),
+					ID:    "1",
+				},
+				{
+					Issue: NewIssue(coin, addr, NewIssueDeposit("ethereum", "0xABCD", 0, "0xsender")),
+					ID:    "2",
+				},
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
 	// fail: invalid withdraw
 	{
 		state := GenesisState{
@@ -133,19 +149,67 @@ func TestCurrencies_Genesis_Valid(t *testing.T) {
 		}
 		require.Error(t, state.Validate(time.Time{}))
 	}
+	// fail: invalid withdraw limits
+	{
+		state := GenesisState{
+			WithdrawLimits: WithdrawLimitsList{
+				NewWithdrawLimits("eth", sdk.NewInt(10), sdk.NewInt(5), sdk.ZeroInt(), sdk.ZeroInt()),
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
+	// fail: duplicated withdraw limits denom
+	{
+		state := GenesisState{
+			WithdrawLimits: WithdrawLimitsList{
+				NewEmptyWithdrawLimits("eth"),
+				NewEmptyWithdrawLimits("eth"),
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
+	// fail: invalid withdraw usage
+	{
+		state := GenesisState{
+			WithdrawUsages: []GenesisWithdrawAccountUsage{
+				{
+					Denom:   "eth",
+					Address: addr,
+					Usage:   WithdrawAccountUsage{{WithdrawID: dnTypes.NewIDFromUint64(1), Amount: sdk.ZeroInt()}},
+				},
+			},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
+	// fail: duplicated withdraw usage denom / address
+	{
+		usage := GenesisWithdrawAccountUsage{
+			Denom:   "eth",
+			Address: addr,
+			Usage:   WithdrawAccountUsage{{WithdrawID: dnTypes.NewIDFromUint64(1), Amount: sdk.OneInt()}},
+		}
+		state := GenesisState{
+			WithdrawUsages: []GenesisWithdrawAccountUsage{usage, usage},
+		}
+		require.Error(t, state.Validate(time.Time{}))
+	}
 	// ok
 	{
 		id := dnTypes.NewIDFromUint64(3)
 		state := GenesisState{
 			Issues: []GenesisIssue{
 				{
-					Issue: NewIssue(coin, addr),
+					Issue: NewIssue(coin, addr, IssueDeposit{}),
 					ID:    "1",
 				},
 				{
-					Issue: NewIssue(coin, addr),
+					Issue: NewIssue(coin, addr, NewIssueDeposit("ethereum", "0xabcd", 0, "0xsender")),
 					ID:    "2",
 				},
+				{
+					Issue: NewIssue(coin, addr, NewIssueDeposit("ethereum", "0xabcd", 1, "0xsender")),
+					ID:    "3",
+				},
 			},
 			Withdraws: Withdraws{
 				NewWithdraw(dnTypes.NewIDFromUint64(1), coin, addr, pgPayee, pgChainID, timestamp, txHash),
@@ -153,6 +217,17 @@ func TestCurrencies_Genesis_Valid(t *testing.T) {
 				NewWithdraw(dnTypes.NewIDFromUint64(3), coin, addr, pgPayee, pgChainID, timestamp, txHash),
 			},
 			LastWithdrawID: &id,
+			WithdrawLimits: WithdrawLimitsList{
+				NewEmptyWithdrawLimits("eth"),
+				NewEmptyWithdrawLimits("xfi"),
+			},
+			WithdrawUsages: []GenesisWithdrawAccountUsage{
+				{
+					Denom:   "eth",
+					Address: addr,
+					Usage:   WithdrawAccountUsage{{WithdrawID: dnTypes.NewIDFromUint64(1), Amount: sdk.OneInt()}},
+				},
+			},
 		}
 		require.NoError(t, state.Validate(time.Time{}))
 	}
@@ -45,8 +45,9 @@ func (p AddCurrencyProposal) ValidateBasic() error {
 
 func (p AddCurrencyProposal) GetCurrencyParams() ccstorage.CurrencyParams {
 	return ccstorage.CurrencyParams{
-		Denom:    p.Denom,
-		Decimals: p.Decimals,
+		Denom:           p.Denom,
+		Decimals:        p.Decimals,
+		ContractAddress: p.ContractAddress,
 	}
 }
 
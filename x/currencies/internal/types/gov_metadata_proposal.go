@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/ccstorage"
+)
+
+const (
+	ProposalTypeSetCurrencyMetadata = "SetCurrencyMetadata"
+)
+
+var (
+	_ gov.Content = SetCurrencyMetadataProposal{}
+)
+
+// SetCurrencyMetadataProposal is a gov proposal to set currency display metadata.
+type SetCurrencyMetadataProposal struct {
+	Denom       string
+	Name        string
+	Description string
+	LogoURI     string
+}
+
+func (p SetCurrencyMetadataProposal) GetTitle() string { return "Set currency metadata" }
+func (p SetCurrencyMetadataProposal) GetDescription() string {
+	return "Sets currency display name, description and logo URI"
+}
+func (p SetCurrencyMetadataProposal) ProposalRoute() string { return GovRouterKey }
+func (p SetCurrencyMetadataProposal) ProposalType() string  { return ProposalTypeSetCurrencyMetadata }
+
+func (p SetCurrencyMetadataProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+	if err := ccstorage.ValidateCurrencyMetadata(p.Name, p.Description, p.LogoURI); err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (p SetCurrencyMetadataProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s\n", p.Denom))
+	b.WriteString(fmt.Sprintf("  Name: %s\n", p.Name))
+	b.WriteString(fmt.Sprintf("  CurrencyDescription: %s\n", p.Description))
+	b.WriteString(fmt.Sprintf("  LogoURI: %s", p.LogoURI))
+
+	return b.String()
+}
+
+// NewSetCurrencyMetadataProposal creates a SetCurrencyMetadataProposal object.
+func NewSetCurrencyMetadataProposal(denom, name, description, logoURI string) SetCurrencyMetadataProposal {
+	return SetCurrencyMetadataProposal{
+		Denom:       denom,
+		Name:        name,
+		Description: description,
+		LogoURI:     logoURI,
+	}
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypePauseCurrency   = "PauseCurrency"
+	ProposalTypeUnpauseCurrency = "UnpauseCurrency"
+)
+
+var (
+	_ gov.Content = PauseCurrencyProposal{}
+	_ gov.Content = UnpauseCurrencyProposal{}
+)
+
+// PauseCurrencyProposal is a gov proposal to pause currency (blocks issue, withdraw and order posting).
+type PauseCurrencyProposal struct {
+	Denom string
+}
+
+func (p PauseCurrencyProposal) GetTitle() string { return "Pause currency" }
+func (p PauseCurrencyProposal) GetDescription() string {
+	return "Pauses currency issue, withdraw and trading"
+}
+func (p PauseCurrencyProposal) ProposalRoute() string { return GovRouterKey }
+func (p PauseCurrencyProposal) ProposalType() string  { return ProposalTypePauseCurrency }
+
+func (p PauseCurrencyProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	return nil
+}
+
+func (p PauseCurrencyProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s", p.Denom))
+
+	return b.String()
+}
+
+// NewPauseCurrencyProposal creates a PauseCurrencyProposal object.
+func NewPauseCurrencyProposal(denom string) PauseCurrencyProposal {
+	return PauseCurrencyProposal{
+		Denom: denom,
+	}
+}
+
+// UnpauseCurrencyProposal is a gov proposal to unpause previously paused currency.
+type UnpauseCurrencyProposal struct {
+	Denom string
+}
+
+func (p UnpauseCurrencyProposal) GetTitle() string { return "Unpause currency" }
+func (p UnpauseCurrencyProposal) GetDescription() string {
+	return "Resumes currency issue, withdraw and trading"
+}
+func (p UnpauseCurrencyProposal) ProposalRoute() string { return GovRouterKey }
+func (p UnpauseCurrencyProposal) ProposalType() string  { return ProposalTypeUnpauseCurrency }
+
+func (p UnpauseCurrencyProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	return nil
+}
+
+func (p UnpauseCurrencyProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s", p.Denom))
+
+	return b.String()
+}
+
+// NewUnpauseCurrencyProposal creates a UnpauseCurrencyProposal object.
+func NewUnpauseCurrencyProposal(denom string) UnpauseCurrencyProposal {
+	return UnpauseCurrencyProposal{
+		Denom: denom,
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/dfinance/dnode/helpers"
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	ProposalTypeUpdateCurrencyContract = "UpdateCurrencyContract"
+)
+
+var (
+	_ gov.Content = UpdateCurrencyContractProposal{}
+)
+
+// UpdateCurrencyContractProposal is a gov proposal to update currency ERC20 contract address.
+type UpdateCurrencyContractProposal struct {
+	Denom           string
+	ContractAddress string
+}
+
+func (p UpdateCurrencyContractProposal) GetTitle() string { return "Update currency contract" }
+func (p UpdateCurrencyContractProposal) GetDescription() string {
+	return "Updates currency ERC20 contract address"
+}
+func (p UpdateCurrencyContractProposal) ProposalRoute() string { return GovRouterKey }
+func (p UpdateCurrencyContractProposal) ProposalType() string {
+	return ProposalTypeUpdateCurrencyContract
+}
+
+func (p UpdateCurrencyContractProposal) ValidateBasic() error {
+	if err := dnTypes.DenomFilter(p.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+	if p.ContractAddress != "" && !helpers.IsEthereumAddress(p.ContractAddress) {
+		return fmt.Errorf("contract_address: ethereum address validation failed")
+	}
+
+	return nil
+}
+
+func (p UpdateCurrencyContractProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s\n", p.Denom))
+	b.WriteString(fmt.Sprintf("  ContractAddress: %s", p.ContractAddress))
+
+	return b.String()
+}
+
+// NewUpdateCurrencyContractProposal creates a UpdateCurrencyContractProposal object.
+func NewUpdateCurrencyContractProposal(denom, contractAddress string) UpdateCurrencyContractProposal {
+	return UpdateCurrencyContractProposal{
+		Denom:           denom,
+		ContractAddress: contractAddress,
+	}
+}
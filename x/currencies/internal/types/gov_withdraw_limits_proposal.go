@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+const (
+	ProposalTypeSetWithdrawLimits = "SetWithdrawLimits"
+)
+
+var (
+	_ gov.Content = SetWithdrawLimitsProposal{}
+)
+
+// SetWithdrawLimitsProposal is a gov proposal to set currency withdraw limits.
+type SetWithdrawLimitsProposal struct {
+	Denom           string
+	MinAmount       sdk.Int
+	MaxAmount       sdk.Int
+	AccountDailyCap sdk.Int
+	BlockCap        sdk.Int
+}
+
+func (p SetWithdrawLimitsProposal) GetTitle() string { return "Set withdraw limits" }
+func (p SetWithdrawLimitsProposal) GetDescription() string {
+	return "Sets currency withdraw amount limits and rate caps"
+}
+func (p SetWithdrawLimitsProposal) ProposalRoute() string { return GovRouterKey }
+func (p SetWithdrawLimitsProposal) ProposalType() string  { return ProposalTypeSetWithdrawLimits }
+
+func (p SetWithdrawLimitsProposal) ValidateBasic() error {
+	if err := p.GetWithdrawLimits().Validate(); err != nil {
+		return fmt.Errorf("limits: %w", err)
+	}
+
+	return nil
+}
+
+func (p SetWithdrawLimitsProposal) GetWithdrawLimits() WithdrawLimits {
+	return NewWithdrawLimits(p.Denom, p.MinAmount, p.MaxAmount, p.AccountDailyCap, p.BlockCap)
+}
+
+func (p SetWithdrawLimitsProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString(fmt.Sprintf("  Denom: %s\n", p.Denom))
+	b.WriteString(fmt.Sprintf("  MinAmount: %s\n", p.MinAmount))
+	b.WriteString(fmt.Sprintf("  MaxAmount: %s\n", p.MaxAmount))
+	b.WriteString(fmt.Sprintf("  AccountDailyCap: %s\n", p.AccountDailyCap))
+	b.WriteString(fmt.Sprintf("  BlockCap: %s", p.BlockCap))
+
+	return b.String()
+}
+
+// NewSetWithdrawLimitsProposal creates a SetWithdrawLimitsProposal object.
+func NewSetWithdrawLimitsProposal(denom string, minAmount, maxAmount, accountDailyCap, blockCap sdk.Int) SetWithdrawLimitsProposal {
+	return SetWithdrawLimitsProposal{
+		Denom:           denom,
+		MinAmount:       minAmount,
+		MaxAmount:       maxAmount,
+		AccountDailyCap: accountDailyCap,
+		BlockCap:        blockCap,
+	}
+}
@@ -2,18 +2,119 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
+// hexChars is a set of lower case HEX characters used for tx hash normalization.
+const hexChars = "0123456789abcdef"
+
+// IssueDeposit is a source chain deposit info the issue corresponds to.
+// Deposit is identified by the (ChainID, TxHash, LogIndex) tuple.
+type IssueDeposit struct {
+	// Source chain ID
+	ChainID string `json:"chain_id" yaml:"chain_id" example:"ethereum"`
+	// Source chain deposit tx hash
+	TxHash string `json:"tx_hash" yaml:"tx_hash" example:"0x5f1c3ea7d3a8e7f14d7d6b3c71e3ed9b6bfc9d31fa89b3ae4f6e4b2e8fb2b1ac"`
+	// Source chain deposit event log index within the tx
+	LogIndex uint64 `json:"log_index" yaml:"log_index" example:"0"`
+	// Source chain deposit sender
+	Sender string `json:"sender" yaml:"sender" example:"0x82A978B3f5962A5b0957d9ee9eEf472EE55B42F1"`
+}
+
+// IsEmpty checks if deposit info is not set (issues created before deposit info introduction).
+func (d IssueDeposit) IsEmpty() bool {
+	return d.ChainID == "" && d.TxHash == "" && d.LogIndex == 0 && d.Sender == ""
+}
+
+// Validate checks that deposit info is valid.
+func (d IssueDeposit) Validate() error {
+	if err := validateDepositField(d.ChainID); err != nil {
+		return fmt.Errorf("chain_id: %w", err)
+	}
+	if err := validateDepositField(d.TxHash); err != nil {
+		return fmt.Errorf("tx_hash: %w", err)
+	}
+	if err := validateDepositField(d.Sender); err != nil {
+		return fmt.Errorf("sender: %w", err)
+	}
+
+	return nil
+}
+
+// NormalizedChainID returns chain ID in lower case.
+func (d IssueDeposit) NormalizedChainID() string {
+	return strings.ToLower(d.ChainID)
+}
+
+// NormalizedTxHash returns tx hash in lower case without the "0x" prefix if it is a HEX string (case insensitive),
+// returns as is otherwise.
+func (d IssueDeposit) NormalizedTxHash() string {
+	txHash := strings.TrimPrefix(strings.ToLower(d.TxHash), "0x")
+	if txHash == "" {
+		return d.TxHash
+	}
+
+	for _, c := range txHash {
+		if !strings.ContainsRune(hexChars, c) {
+			return d.TxHash
+		}
+	}
+
+	return txHash
+}
+
+// UniqueKey returns deposit (ChainID, TxHash, LogIndex) tuple normalized string representation.
+func (d IssueDeposit) UniqueKey() string {
+	return fmt.Sprintf("%s/%s/%d", d.NormalizedChainID(), d.NormalizedTxHash(), d.LogIndex)
+}
+
+func (d IssueDeposit) String() string {
+	return fmt.Sprintf("IssueDeposit:\n"+
+		"  ChainID:  %s\n"+
+		"  TxHash:   %s\n"+
+		"  LogIndex: %d\n"+
+		"  Sender:   %s",
+		d.ChainID,
+		d.TxHash,
+		d.LogIndex,
+		d.Sender,
+	)
+}
+
+// NewIssueDeposit creates a new IssueDeposit object.
+func NewIssueDeposit(chainID, txHash string, logIndex uint64, sender string) IssueDeposit {
+	return IssueDeposit{
+		ChainID:  chainID,
+		TxHash:   txHash,
+		LogIndex: logIndex,
+		Sender:   sender,
+	}
+}
+
+// validateDepositField checks deposit string field (field is also used as a part of a storage key).
+func validateDepositField(value string) error {
+	if value == "" {
+		return fmt.Errorf("empty")
+	}
+	if strings.ContainsAny(value, " \t\n"+string(KeyDelimiter)) {
+		return fmt.Errorf("contains whitespaces or %q", string(KeyDelimiter))
+	}
+
+	return nil
+}
+
 // Issue is an info about issuing currency to the payee (recipient).
 type Issue struct {
 	// Issuing coin
 	Coin sdk.Coin `json:"coin" yaml:"coin" swaggertype:"string" example:"100xfi"`
 	// Target account for increasing coin balance
 	Payee sdk.AccAddress `json:"payee" yaml:"payee" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Source chain deposit info (empty for issues created before v1.0)
+	Deposit IssueDeposit `json:"deposit" yaml:"deposit"`
 }
 
 // Valid checks that issue is valid (used for genesis ops).
@@ -29,22 +130,31 @@ func (issue Issue) Valid() error {
 		return fmt.Errorf("payee: empty")
 	}
 
+	if !issue.Deposit.IsEmpty() {
+		if err := issue.Deposit.Validate(); err != nil {
+			return fmt.Errorf("deposit: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (issue Issue) String() string {
 	return fmt.Sprintf("Issue:\n"+
 		"  Coin:  %s\n"+
-		"  Payee: %s",
+		"  Payee: %s\n"+
+		"%s",
 		issue.Coin.String(),
 		issue.Payee.String(),
+		issue.Deposit.String(),
 	)
 }
 
 // NewIssue creates a new Issue object.
-func NewIssue(coin sdk.Coin, payee sdk.AccAddress) Issue {
+func NewIssue(coin sdk.Coin, payee sdk.AccAddress, deposit IssueDeposit) Issue {
 	return Issue{
-		Coin:  coin,
-		Payee: payee,
+		Coin:    coin,
+		Payee:   payee,
+		Deposit: deposit,
 	}
 }
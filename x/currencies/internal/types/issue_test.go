@@ -28,9 +28,70 @@ func TestCurrencies_Issue_Valid(t *testing.T) {
 		issue.Coin = sdk.NewCoin("eth", sdk.NewInt(100))
 		require.Error(t, issue.Valid())
 	}
-	// ok
+	// ok: no deposit
 	{
 		issue.Payee = sdk.AccAddress("addr1")
 		require.NoError(t, issue.Valid())
 	}
+	// fail: deposit invalid
+	{
+		issue.Deposit = NewIssueDeposit("ethereum", "", 0, "0xsender")
+		require.Error(t, issue.Valid())
+	}
+	// ok
+	{
+		issue.Deposit.TxHash = "0xabcd"
+		require.NoError(t, issue.Valid())
+	}
+}
+
+// IssueDeposit validation.
+func TestCurrencies_IssueDeposit_Validate(t *testing.T) {
+	deposit := NewIssueDeposit("Ethereum", "0xABCD", 1, "0xsender")
+
+	// ok
+	{
+		require.NoError(t, deposit.Validate())
+		require.Equal(t, "ethereum", deposit.NormalizedChainID())
+		require.Equal(t, "abcd", deposit.NormalizedTxHash())
+		require.Equal(t, "ethereum/abcd/1", deposit.UniqueKey())
+	}
+	// ok: HEX tx hash without the prefix has the same key
+	{
+		d := deposit
+		d.TxHash = "abcd"
+		d.ChainID = "ethereum"
+		require.Equal(t, "abcd", d.NormalizedTxHash())
+		require.Equal(t, deposit.UniqueKey(), d.UniqueKey())
+	}
+	// ok: non-HEX tx hash is not normalized
+	{
+		d := deposit
+		d.TxHash = "ABCXYZ"
+		require.NoError(t, d.Validate())
+		require.Equal(t, "ABCXYZ", d.NormalizedTxHash())
+
+		d.TxHash = "0x"
+		require.Equal(t, "0x", d.NormalizedTxHash())
+	}
+	// fail: empty fields
+	{
+		d := deposit
+		d.ChainID = ""
+		require.Error(t, d.Validate())
+
+		d = deposit
+		d.TxHash = ""
+		require.Error(t, d.Validate())
+
+		d = deposit
+		d.Sender = ""
+		require.Error(t, d.Validate())
+	}
+	// fail: key delimiter
+	{
+		d := deposit
+		d.ChainID = "eth:main"
+		require.Error(t, d.Validate())
+	}
 }
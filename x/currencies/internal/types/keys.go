@@ -3,6 +3,9 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
@@ -11,6 +14,11 @@ var (
 	IssuePrefix    = []byte("issue")
 	WithdrawPrefix = []byte("withdraw")
 	KeyDelimiter   = []byte(":")
+	//
+	WithdrawLimitsPrefix       = []byte("withdrawLimits")
+	WithdrawAccountUsagePrefix = []byte("withdrawAccountUsage")
+	WithdrawBlockUsagePrefix   = []byte("withdrawBlockUsage")
+	IssueDepositPrefix         = []byte("issueDeposit")
 )
 
 // GetIssuesKey returns key for storing issues.
@@ -63,3 +71,89 @@ func GetWithdrawKey(id dnTypes.ID) []byte {
 func GetLastWithdrawIDKey() []byte {
 	return []byte("lastWithdrawID")
 }
+
+// GetWithdrawLimitsKey returns key for storing currency withdraw limits.
+func GetWithdrawLimitsKey(denom string) []byte {
+	return bytes.Join(
+		[][]byte{
+			WithdrawLimitsPrefix,
+			[]byte(denom),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetWithdrawLimitsPrefix returns key prefix for withdraw limits objects iteration.
+func GetWithdrawLimitsPrefix() []byte {
+	return append(WithdrawLimitsPrefix, KeyDelimiter...)
+}
+
+// GetWithdrawAccountUsageKey returns key for storing account withdraw usage for the currency.
+func GetWithdrawAccountUsageKey(denom string, address sdk.AccAddress) []byte {
+	return bytes.Join(
+		[][]byte{
+			WithdrawAccountUsagePrefix,
+			[]byte(denom),
+			address.Bytes(),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetWithdrawAccountUsagePrefix returns key prefix for account withdraw usage objects iteration.
+func GetWithdrawAccountUsagePrefix() []byte {
+	return append(WithdrawAccountUsagePrefix, KeyDelimiter...)
+}
+
+// MustParseWithdrawAccountUsageKey parses account withdraw usage storage key.
+// Denom can't contain the delimiter, so the rest of the key is the address.
+func MustParseWithdrawAccountUsageKey(key []byte) (denom string, address sdk.AccAddress) {
+	prefix := GetWithdrawAccountUsagePrefix()
+	if !bytes.HasPrefix(key, prefix) {
+		panic(fmt.Errorf("key %q: wrong prefix", string(key)))
+	}
+
+	values := bytes.SplitN(key[len(prefix):], KeyDelimiter, 2)
+	if len(values) != 2 {
+		panic(fmt.Errorf("key %q: invalid splitted length %d", string(key), len(values)))
+	}
+
+	return string(values[0]), sdk.AccAddress(values[1])
+}
+
+// GetWithdrawBlockUsageKey returns key for storing block withdraw usage for the currency.
+func GetWithdrawBlockUsageKey(denom string) []byte {
+	return bytes.Join(
+		[][]byte{
+			WithdrawBlockUsagePrefix,
+			[]byte(denom),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetIssueDepositKey returns key for storing issue ID by the source chain deposit.
+func GetIssueDepositKey(deposit IssueDeposit) []byte {
+	return bytes.Join(
+		[][]byte{
+			IssueDepositPrefix,
+			[]byte(deposit.NormalizedTxHash()),
+			[]byte(deposit.NormalizedChainID()),
+			[]byte(strconv.FormatUint(deposit.LogIndex, 10)),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetIssueDepositTxHashPrefix returns key prefix for issue IDs iteration by the source chain deposit tx hash.
+func GetIssueDepositTxHashPrefix(txHash string) []byte {
+	key := bytes.Join(
+		[][]byte{
+			IssueDepositPrefix,
+			[]byte(NewIssueDeposit("", txHash, 0, "").NormalizedTxHash()),
+		},
+		KeyDelimiter,
+	)
+
+	return append(key, KeyDelimiter...)
+}
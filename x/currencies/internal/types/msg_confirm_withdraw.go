@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// Client multisig message to confirm withdraw peg zone transfer.
+type MsgConfirmWithdraw struct {
+	// Withdraw ID
+	ID dnTypes.ID `json:"id" yaml:"id"`
+	// Second blockchain: transfer tx hash
+	PegZoneTxHash string `json:"pegzone_tx_hash" yaml:"pegzone_tx_hash"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgConfirmWithdraw) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgConfirmWithdraw) Type() string {
+	return "confirm_withdraw"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgConfirmWithdraw) ValidateBasic() error {
+	if err := msg.ID.Valid(); err != nil {
+		return sdkErrors.Wrap(ErrWrongWithdrawID, err.Error())
+	}
+
+	if msg.PegZoneTxHash == "" {
+		return sdkErrors.Wrap(ErrWrongPegZoneTxHash, "empty")
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgConfirmWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+// Msg is a multisig, so there are not signers.
+func (msg MsgConfirmWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+// NewMsgConfirmWithdraw creates a new MsgConfirmWithdraw message.
+func NewMsgConfirmWithdraw(id dnTypes.ID, pzTxHash string) MsgConfirmWithdraw {
+	return MsgConfirmWithdraw{
+		ID:            id,
+		PegZoneTxHash: pzTxHash,
+	}
+}
@@ -0,0 +1,54 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// Test MsgConfirmWithdraw ValidateBasic.
+func TestCurrenciesMsg_ConfirmWithdraw_ValidateBasic(t *testing.T) {
+	t.Parallel()
+
+	target := NewMsgConfirmWithdraw(dnTypes.NewZeroID(), "pzHash")
+	// ok
+	{
+		require.NoError(t, target.ValidateBasic())
+	}
+
+	// invalid: id
+	{
+		invalidTarget := target
+		invalidTarget.ID = dnTypes.ID{}
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+
+	// invalid: pegZoneTxHash
+	{
+		invalidTarget := target
+		invalidTarget.PegZoneTxHash = ""
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+}
+
+// Test MsgRefundWithdraw ValidateBasic.
+func TestCurrenciesMsg_RefundWithdraw_ValidateBasic(t *testing.T) {
+	t.Parallel()
+
+	target := NewMsgRefundWithdraw(dnTypes.NewZeroID())
+	// ok
+	{
+		require.NoError(t, target.ValidateBasic())
+	}
+
+	// invalid: id
+	{
+		invalidTarget := target
+		invalidTarget.ID = dnTypes.ID{}
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
+}
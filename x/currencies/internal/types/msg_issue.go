@@ -15,6 +15,8 @@ type MsgIssueCurrency struct {
 	Coin sdk.Coin `json:"coin" yaml:"coin"`
 	// Payee account (whose balance is increased)
 	Payee sdk.AccAddress `json:"payee" yaml:"payee"`
+	// Source chain deposit info
+	Deposit IssueDeposit `json:"deposit" yaml:"deposit"`
 }
 
 // Implements sdk.Msg interface.
@@ -45,6 +47,10 @@ func (msg MsgIssueCurrency) ValidateBasic() error {
 		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "payee: empty")
 	}
 
+	if err := msg.Deposit.Validate(); err != nil {
+		return sdkErrors.Wrap(ErrWrongIssueDeposit, err.Error())
+	}
+
 	return nil
 }
 
@@ -60,10 +66,11 @@ func (msg MsgIssueCurrency) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgIssueCurrency creates a new MsgIssueCurrency message.
-func NewMsgIssueCurrency(id string, coin sdk.Coin, payee sdk.AccAddress) MsgIssueCurrency {
+func NewMsgIssueCurrency(id string, coin sdk.Coin, payee sdk.AccAddress, deposit IssueDeposit) MsgIssueCurrency {
 	return MsgIssueCurrency{
-		ID:    id,
-		Coin:  coin,
-		Payee: payee,
+		ID:      id,
+		Coin:    coin,
+		Payee:   payee,
+		Deposit: deposit,
 	}
 }
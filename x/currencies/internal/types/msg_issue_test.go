@@ -14,7 +14,7 @@ func TestCurrenciesMsg_IssueCurrency_ValidateBasic(t *testing.T) {
 	t.Parallel()
 
 	coin := sdk.NewCoin("symbol", sdk.NewInt(10))
-	target := NewMsgIssueCurrency("issue1", coin, sdk.AccAddress([]byte("addr1")))
+	target := NewMsgIssueCurrency("issue1", coin, sdk.AccAddress([]byte("addr1")), NewIssueDeposit("ethereum", "0xabcd", 0, "0xsender"))
 	// ok
 	{
 		require.NoError(t, target.ValidateBasic())
@@ -54,6 +54,13 @@ func TestCurrenciesMsg_IssueCurrency_ValidateBasic(t *testing.T) {
 		invalidTarget.Payee = sdk.AccAddress([]byte{})
 		require.Error(t, invalidTarget.ValidateBasic())
 	}
+
+	// invalid: deposit
+	{
+		invalidTarget := target
+		invalidTarget.Deposit = IssueDeposit{}
+		require.Error(t, invalidTarget.ValidateBasic())
+	}
 }
 
 // Test MsgIssueCurrency implements msmodule.MsMsg interface.
@@ -61,7 +68,7 @@ func TestCurrenciesMsg_IssueCurrency_MsgInterface(t *testing.T) {
 	t.Parallel()
 
 	coin := sdk.NewCoin("symbol", sdk.NewInt(10))
-	target := NewMsgIssueCurrency("issue1", coin, sdk.AccAddress([]byte("addr1")))
+	target := NewMsgIssueCurrency("issue1", coin, sdk.AccAddress([]byte("addr1")), NewIssueDeposit("ethereum", "0xabcd", 0, "0xsender"))
 	require.Equal(t, "issue_currency", target.Type())
 	require.Equal(t, RouterKey, target.Route())
 	require.True(t, len(target.GetSignBytes()) > 0)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// Client multisig message to refund failed withdraw (coins are minted back to the spender).
+type MsgRefundWithdraw struct {
+	// Withdraw ID
+	ID dnTypes.ID `json:"id" yaml:"id"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRefundWithdraw) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRefundWithdraw) Type() string {
+	return "refund_withdraw"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRefundWithdraw) ValidateBasic() error {
+	if err := msg.ID.Valid(); err != nil {
+		return sdkErrors.Wrap(ErrWrongWithdrawID, err.Error())
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRefundWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+// Msg is a multisig, so there are not signers.
+func (msg MsgRefundWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{}
+}
+
+// NewMsgRefundWithdraw creates a new MsgRefundWithdraw message.
+func NewMsgRefundWithdraw(id dnTypes.ID) MsgRefundWithdraw {
+	return MsgRefundWithdraw{
+		ID: id,
+	}
+}
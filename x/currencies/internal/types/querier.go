@@ -12,6 +12,11 @@ const (
 	QueryIssue      = "issue"
 	QueryWithdraws  = "withdraws"
 	QueryWithdraw   = "withdraw"
+	//
+	QueryWithdrawLimits = "withdrawLimits"
+	QueryWithdrawQuota  = "withdrawQuota"
+	//
+	QueryIssuesByTxHash = "issuesByTxHash"
 )
 
 // Client request for currency.
@@ -33,4 +38,22 @@ type WithdrawReq struct {
 type WithdrawsReq struct {
 	Page  sdk.Uint `json:"page" yaml:"page"`
 	Limit sdk.Uint `json:"limit" yaml:"limit"`
+	// Withdraw state filter (optional)
+	State WithdrawState `json:"state" yaml:"state"`
+}
+
+// Client request for currency withdraw limits.
+type WithdrawLimitsReq struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// Client request for account withdraw quota.
+type WithdrawQuotaReq struct {
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// Client request for issues by the source chain deposit tx hash.
+type IssuesByTxHashReq struct {
+	TxHash string `json:"tx_hash" yaml:"tx_hash"`
 }
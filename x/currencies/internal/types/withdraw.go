@@ -12,6 +12,37 @@ import (
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
+// Enum type to define withdraw peg zone lifecycle state.
+type WithdrawState string
+
+const (
+	// Coins are burned, waiting for the peg zone transfer confirmation.
+	WithdrawStatePending WithdrawState = "pending"
+	// Peg zone transfer is confirmed.
+	WithdrawStateConfirmed WithdrawState = "confirmed"
+	// Peg zone transfer failed, coins are minted back to the spender.
+	WithdrawStateRefunded WithdrawState = "refunded"
+)
+
+// IsValid validates enum (empty value is the default pending state).
+func (s WithdrawState) IsValid() bool {
+	switch s {
+	case "", WithdrawStatePending, WithdrawStateConfirmed, WithdrawStateRefunded:
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (s WithdrawState) String() string {
+	if s == "" {
+		return string(WithdrawStatePending)
+	}
+
+	return string(s)
+}
+
 // Withdraw is an info about reducing currency balance for the spender.
 // swagger:model
 type Withdraw struct {
@@ -29,6 +60,10 @@ type Withdraw struct {
 	Timestamp int64 `json:"timestamp" yaml:"timestamp" format:"seconds" example:"1585295757"`
 	// Tx hash
 	TxHash string `json:"tx_hash" yaml:"tx_hash" example:"fd82ce32835dfd7042808eaf6ff09cece952b9da20460fa462420a93607fa96f"`
+	// Peg zone lifecycle state (pending / confirmed / refunded)
+	State WithdrawState `json:"state" yaml:"state" swaggertype:"string" example:"pending"`
+	// Second blockchain: transfer tx hash (set on confirmation)
+	PegZoneTxHash string `json:"pegzone_tx_hash" yaml:"pegzone_tx_hash" example:"0x5f1c3ea7d3a8e7f14d7d6b3c71e3ed9b6bfc9d31fa89b3ae4f6e4b2e8fb2b1ac"`
 }
 
 // Valid checks that withdraw is valid (used for genesis ops).
@@ -62,6 +97,16 @@ func (withdraw Withdraw) Valid(curBlockTime time.Time) error {
 		return fmt.Errorf("tx_hash: empty")
 	}
 
+	if !withdraw.State.IsValid() {
+		return fmt.Errorf("state %q: unknown", withdraw.State)
+	}
+	if withdraw.State == WithdrawStateConfirmed && withdraw.PegZoneTxHash == "" {
+		return fmt.Errorf("pegzone_tx_hash: empty for %s withdraw", withdraw.State)
+	}
+	if withdraw.State != WithdrawStateConfirmed && withdraw.PegZoneTxHash != "" {
+		return fmt.Errorf("pegzone_tx_hash: must be empty for %s withdraw", withdraw.State)
+	}
+
 	if !curBlockTime.IsZero() {
 		timestamp := time.Unix(withdraw.Timestamp, 0)
 		if timestamp.After(curBlockTime) {
@@ -80,7 +125,9 @@ func (withdraw Withdraw) String() string {
 		"  PegZoneSpender: %s\n"+
 		"  PegZoneChainID: %s\n"+
 		"  Timestamp:      %d\n"+
-		"  TxHash:         %s",
+		"  TxHash:         %s\n"+
+		"  State:          %s\n"+
+		"  PegZoneTxHash:  %s",
 		withdraw.ID,
 		withdraw.Coin.String(),
 		withdraw.Spender,
@@ -88,6 +135,8 @@ func (withdraw Withdraw) String() string {
 		withdraw.PegZoneChainID,
 		withdraw.Timestamp,
 		withdraw.TxHash,
+		withdraw.State,
+		withdraw.PegZoneTxHash,
 	)
 }
 
@@ -102,9 +151,15 @@ func NewWithdraw(id dnTypes.ID, coin sdk.Coin, spender sdk.AccAddress, pzSpender
 		PegZoneChainID: pzChainID,
 		Timestamp:      timestamp,
 		TxHash:         hex.EncodeToString(hash[:]),
+		State:          WithdrawStatePending,
 	}
 }
 
+// IsPending checks if withdraw is waiting for the peg zone transfer confirmation.
+func (withdraw Withdraw) IsPending() bool {
+	return withdraw.State.String() == string(WithdrawStatePending)
+}
+
 // Withdraw slice.
 type Withdraws []Withdraw
 
@@ -0,0 +1,230 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	// Account withdraw cap rolling window duration.
+	WithdrawAccountCapPeriod = 24 * time.Hour
+)
+
+// WithdrawLimits defines per-currency withdraw restrictions.
+// Zero value disables the corresponding limit.
+// swagger:model
+type WithdrawLimits struct {
+	// Currency denom
+	Denom string `json:"denom" yaml:"denom" example:"xfi"`
+	// Minimum single withdraw amount
+	MinAmount sdk.Int `json:"min_amount" yaml:"min_amount" swaggertype:"string" example:"100"`
+	// Maximum single withdraw amount
+	MaxAmount sdk.Int `json:"max_amount" yaml:"max_amount" swaggertype:"string" example:"1000"`
+	// Rolling 24h withdraw amount cap per account
+	AccountDailyCap sdk.Int `json:"account_daily_cap" yaml:"account_daily_cap" swaggertype:"string" example:"5000"`
+	// Withdraw amount cap per block (all accounts)
+	BlockCap sdk.Int `json:"block_cap" yaml:"block_cap" swaggertype:"string" example:"10000"`
+}
+
+// Validate checks that withdraw limits are valid.
+func (l WithdrawLimits) Validate() error {
+	if err := dnTypes.DenomFilter(l.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	for _, v := range []struct {
+		name  string
+		value sdk.Int
+	}{
+		{"min_amount", l.MinAmount},
+		{"max_amount", l.MaxAmount},
+		{"account_daily_cap", l.AccountDailyCap},
+		{"block_cap", l.BlockCap},
+	} {
+		if v.value.IsNil() {
+			return fmt.Errorf("%s: nil", v.name)
+		}
+		if v.value.IsNegative() {
+			return fmt.Errorf("%s: negative", v.name)
+		}
+	}
+
+	if l.MinAmount.IsPositive() {
+		if l.MaxAmount.IsPositive() && l.MinAmount.GT(l.MaxAmount) {
+			return fmt.Errorf("min_amount: GT max_amount")
+		}
+		if l.AccountDailyCap.IsPositive() && l.MinAmount.GT(l.AccountDailyCap) {
+			return fmt.Errorf("min_amount: GT account_daily_cap")
+		}
+		if l.BlockCap.IsPositive() && l.MinAmount.GT(l.BlockCap) {
+			return fmt.Errorf("min_amount: GT block_cap")
+		}
+	}
+
+	return nil
+}
+
+// CheckAmount checks single withdraw amount against min / max limits.
+func (l WithdrawLimits) CheckAmount(amount sdk.Int) error {
+	if l.MinAmount.IsPositive() && amount.LT(l.MinAmount) {
+		return fmt.Errorf("amount %s: LT min_amount %s", amount, l.MinAmount)
+	}
+	if l.MaxAmount.IsPositive() && amount.GT(l.MaxAmount) {
+		return fmt.Errorf("amount %s: GT max_amount %s", amount, l.MaxAmount)
+	}
+
+	return nil
+}
+
+func (l WithdrawLimits) String() string {
+	return fmt.Sprintf("WithdrawLimits:\n"+
+		"  Denom:           %s\n"+
+		"  MinAmount:       %s\n"+
+		"  MaxAmount:       %s\n"+
+		"  AccountDailyCap: %s\n"+
+		"  BlockCap:        %s",
+		l.Denom,
+		l.MinAmount,
+		l.MaxAmount,
+		l.AccountDailyCap,
+		l.BlockCap,
+	)
+}
+
+// NewWithdrawLimits creates a new WithdrawLimits object.
+func NewWithdrawLimits(denom string, minAmount, maxAmount, accountDailyCap, blockCap sdk.Int) WithdrawLimits {
+	return WithdrawLimits{
+		Denom:           denom,
+		MinAmount:       minAmount,
+		MaxAmount:       maxAmount,
+		AccountDailyCap: accountDailyCap,
+		BlockCap:        blockCap,
+	}
+}
+
+// NewEmptyWithdrawLimits creates a new WithdrawLimits object with all limits disabled.
+func NewEmptyWithdrawLimits(denom string) WithdrawLimits {
+	return NewWithdrawLimits(denom, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt())
+}
+
+// WithdrawLimitsList is a WithdrawLimits slice.
+type WithdrawLimitsList []WithdrawLimits
+
+func (list WithdrawLimitsList) String() string {
+	var s strings.Builder
+	for i, l := range list {
+		s.WriteString(l.String())
+		if i < len(list)-1 {
+			s.WriteString("\n")
+		}
+	}
+
+	return s.String()
+}
+
+// WithdrawAccountUsage stores account withdraws within the rolling cap period.
+type WithdrawAccountUsage []WithdrawUsageEntry
+
+// WithdrawUsageEntry is a single account withdraw record.
+type WithdrawUsageEntry struct {
+	// Withdraw ID
+	WithdrawID dnTypes.ID `json:"withdraw_id" yaml:"withdraw_id"`
+	// Withdraw block UNIX time [s]
+	Timestamp int64 `json:"timestamp" yaml:"timestamp"`
+	// Withdraw amount
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// Prune returns usage entries not older than {WithdrawAccountCapPeriod} relative to {now}.
+func (u WithdrawAccountUsage) Prune(now time.Time) WithdrawAccountUsage {
+	periodStart := now.Add(-WithdrawAccountCapPeriod).Unix()
+
+	pruned := make(WithdrawAccountUsage, 0, len(u))
+	for _, entry := range u {
+		if entry.Timestamp > periodStart {
+			pruned = append(pruned, entry)
+		}
+	}
+
+	return pruned
+}
+
+// Remove returns usage entries without the {withdrawID} one.
+func (u WithdrawAccountUsage) Remove(withdrawID dnTypes.ID) WithdrawAccountUsage {
+	filtered := make(WithdrawAccountUsage, 0, len(u))
+	for _, entry := range u {
+		if !entry.WithdrawID.Equal(withdrawID) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// Validate checks that usage entries are valid.
+func (u WithdrawAccountUsage) Validate() error {
+	for i, entry := range u {
+		if err := entry.WithdrawID.Valid(); err != nil {
+			return fmt.Errorf("entry[%d]: withdraw_id: %w", i, err)
+		}
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return fmt.Errorf("entry[%d]: amount: must be GT 0", i)
+		}
+	}
+
+	return nil
+}
+
+// Total returns usage amounts sum.
+func (u WithdrawAccountUsage) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, entry := range u {
+		total = total.Add(entry.Amount)
+	}
+
+	return total
+}
+
+// WithdrawBlockUsage stores withdraws amount sum for the block.
+type WithdrawBlockUsage struct {
+	// Block height
+	Height int64 `json:"height" yaml:"height"`
+	// Withdraws amount sum
+	Amount sdk.Int `json:"amount" yaml:"amount"`
+}
+
+// WithdrawQuota is an account remaining withdraw quota for the currency.
+// Remaining values are only meaningful if the corresponding cap is set (non-zero).
+// swagger:model
+type WithdrawQuota struct {
+	// Currency withdraw limits
+	Limits WithdrawLimits `json:"limits" yaml:"limits"`
+	// Target account
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Account withdraws amount within the last 24h
+	AccountDailyUsed sdk.Int `json:"account_daily_used" yaml:"account_daily_used" swaggertype:"string" example:"1000"`
+	// Account remaining withdraw amount within the rolling 24h period
+	AccountDailyRemaining sdk.Int `json:"account_daily_remaining" yaml:"account_daily_remaining" swaggertype:"string" example:"4000"`
+	// Remaining withdraw amount for the current block
+	BlockRemaining sdk.Int `json:"block_remaining" yaml:"block_remaining" swaggertype:"string" example:"10000"`
+}
+
+func (q WithdrawQuota) String() string {
+	return fmt.Sprintf("WithdrawQuota:\n"+
+		"  Address:               %s\n"+
+		"  AccountDailyUsed:      %s\n"+
+		"  AccountDailyRemaining: %s\n"+
+		"  BlockRemaining:        %s\n"+
+		"%s",
+		q.Address,
+		q.AccountDailyUsed,
+		q.AccountDailyRemaining,
+		q.BlockRemaining,
+		q.Limits.String(),
+	)
+}
@@ -0,0 +1,77 @@
+//+build unit
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// WithdrawLimits validation.
+func TestCurrencies_WithdrawLimits_Validate(t *testing.T) {
+	// fail: denom invalid
+	{
+		require.Error(t, NewEmptyWithdrawLimits("eth1").Validate())
+	}
+	// fail: nil value
+	{
+		limits := NewEmptyWithdrawLimits("eth")
+		limits.BlockCap = sdk.Int{}
+		require.Error(t, limits.Validate())
+	}
+	// fail: negative value
+	{
+		limits := NewEmptyWithdrawLimits("eth")
+		limits.MaxAmount = sdk.NewInt(-1)
+		require.Error(t, limits.Validate())
+	}
+	// fail: min GT max
+	{
+		limits := NewWithdrawLimits("eth", sdk.NewInt(10), sdk.NewInt(5), sdk.ZeroInt(), sdk.ZeroInt())
+		require.Error(t, limits.Validate())
+	}
+	// fail: min GT caps
+	{
+		limits := NewWithdrawLimits("eth", sdk.NewInt(10), sdk.ZeroInt(), sdk.NewInt(5), sdk.ZeroInt())
+		require.Error(t, limits.Validate())
+
+		limits = NewWithdrawLimits("eth", sdk.NewInt(10), sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(5))
+		require.Error(t, limits.Validate())
+	}
+	// ok
+	{
+		require.NoError(t, NewEmptyWithdrawLimits("eth").Validate())
+		require.NoError(t, NewWithdrawLimits("eth", sdk.NewInt(1), sdk.NewInt(10), sdk.NewInt(100), sdk.ZeroInt()).Validate())
+	}
+}
+
+// WithdrawLimits amount check.
+func TestCurrencies_WithdrawLimits_CheckAmount(t *testing.T) {
+	limits := NewWithdrawLimits("eth", sdk.NewInt(10), sdk.NewInt(100), sdk.ZeroInt(), sdk.ZeroInt())
+
+	require.Error(t, limits.CheckAmount(sdk.NewInt(9)))
+	require.Error(t, limits.CheckAmount(sdk.NewInt(101)))
+	require.NoError(t, limits.CheckAmount(sdk.NewInt(10)))
+	require.NoError(t, limits.CheckAmount(sdk.NewInt(100)))
+
+	require.NoError(t, NewEmptyWithdrawLimits("eth").CheckAmount(sdk.NewInt(1000000)))
+}
+
+// WithdrawAccountUsage rolling period pruning.
+func TestCurrencies_WithdrawAccountUsage_Prune(t *testing.T) {
+	now := time.Now()
+	usage := WithdrawAccountUsage{
+		{Timestamp: now.Add(-WithdrawAccountCapPeriod).Unix(), Amount: sdk.NewInt(1)},
+		{Timestamp: now.Add(-WithdrawAccountCapPeriod).Add(time.Second).Unix(), Amount: sdk.NewInt(10)},
+		{Timestamp: now.Unix(), Amount: sdk.NewInt(100)},
+	}
+
+	require.Equal(t, sdk.NewInt(111).String(), usage.Total().String())
+
+	pruned := usage.Prune(now)
+	require.Len(t, pruned, 2)
+	require.Equal(t, sdk.NewInt(110).String(), pruned.Total().String())
+}
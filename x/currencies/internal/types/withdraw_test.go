@@ -61,4 +61,32 @@ func TestCurrencies_Withdraw_Valid(t *testing.T) {
 		withdraw.TxHash = "hash"
 		require.NoError(t, withdraw.Valid(time.Time{}))
 	}
+	// fail: state unknown
+	{
+		withdraw.State = "unknown"
+		require.Error(t, withdraw.Valid(time.Time{}))
+	}
+	// fail: pegZoneTxHash for pending
+	{
+		withdraw.State = WithdrawStatePending
+		withdraw.PegZoneTxHash = "pzHash"
+		require.Error(t, withdraw.Valid(time.Time{}))
+	}
+	// fail: pegZoneTxHash empty for confirmed
+	{
+		withdraw.State = WithdrawStateConfirmed
+		withdraw.PegZoneTxHash = ""
+		require.Error(t, withdraw.Valid(time.Time{}))
+	}
+	// ok: confirmed
+	{
+		withdraw.PegZoneTxHash = "pzHash"
+		require.NoError(t, withdraw.Valid(time.Time{}))
+	}
+	// ok: refunded
+	{
+		withdraw.State = WithdrawStateRefunded
+		withdraw.PegZoneTxHash = ""
+		require.NoError(t, withdraw.Valid(time.Time{}))
+	}
 }
@@ -19,6 +19,12 @@ func NewMsHandler(keeper keeper.Keeper) msmodule.MsHandler {
 		case MsgUnstakeCurrency:
 			return handleMsMsgUnstakeCurrency(ctx, keeper, msg)
 
+		case MsgConfirmWithdraw:
+			return handleMsMsgConfirmWithdraw(ctx, keeper, msg)
+
+		case MsgRefundWithdraw:
+			return handleMsMsgRefundWithdraw(ctx, keeper, msg)
+
 		default:
 			return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized %s module multisig msg type: %v", ModuleName, msg.Type())
 		}
@@ -35,7 +41,29 @@ func handleMsMsgUnstakeCurrency(ctx sdk.Context, keeper keeper.Keeper, msg MsgUn
 
 // handleMsMsgIssueCurrency hanldes MsgIssueCurrency multisig message.
 func handleMsMsgIssueCurrency(ctx sdk.Context, keeper keeper.Keeper, msg MsgIssueCurrency) error {
-	if err := keeper.IssueCurrency(ctx, msg.ID, msg.Coin, msg.Payee); err != nil {
+	if err := keeper.IssueCurrency(ctx, msg.ID, msg.Coin, msg.Payee, msg.Deposit); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
+// handleMsMsgConfirmWithdraw handles MsgConfirmWithdraw multisig message.
+func handleMsMsgConfirmWithdraw(ctx sdk.Context, keeper keeper.Keeper, msg MsgConfirmWithdraw) error {
+	if err := keeper.ConfirmWithdraw(ctx, msg.ID, msg.PegZoneTxHash); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
+
+// handleMsMsgRefundWithdraw handles MsgRefundWithdraw multisig message.
+func handleMsMsgRefundWithdraw(ctx sdk.Context, keeper keeper.Keeper, msg MsgRefundWithdraw) error {
+	if err := keeper.RefundWithdraw(ctx, msg.ID); err != nil {
 		return err
 	}
 
@@ -6,29 +6,35 @@ import (
 )
 
 type (
-	Keeper          = keeper.Keeper
-	Market          = types.Market
-	Markets         = types.Markets
-	MarketExtended  = types.MarketExtended
-	MsgCreateMarket = types.MsgCreateMarket
-	GenesisState    = types.GenesisState
+	Keeper           = keeper.Keeper
+	Market           = types.Market
+	Markets          = types.Markets
+	MarketExtended   = types.MarketExtended
+	MarketFees       = types.MarketFees
+	MsgCreateMarket  = types.MsgCreateMarket
+	MsgSetMarketFees = types.MsgSetMarketFees
+	GenesisState     = types.GenesisState
 )
 
 const (
 	ModuleName = types.ModuleName
 	StoreKey   = types.StoreKey
 	// Event types, attribute types and values
-	EventTypeCreate = types.EventTypeCreate
+	EventTypeCreate     = types.EventTypeCreate
+	EventTypeFeesUpdate = types.EventTypeFeesUpdate
 	//
 	AttributeMarketId   = types.AttributeMarketId
 	AttributeBaseDenom  = types.AttributeBaseDenom
 	AttributeQuoteDenom = types.AttributeQuoteDenom
+	AttributeMakerFee   = types.AttributeMakerFee
+	AttributeTakerFee   = types.AttributeTakerFee
 )
 
 var (
 	// variable aliases
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
+	MaxFeeRate           = types.MaxFeeRate
 	// function aliases
 	RegisterCodec       = types.RegisterCodec
 	NewKeeper           = keeper.NewKeeper
@@ -37,6 +43,9 @@ var (
 	NewMarket           = types.NewMarket
 	NewMarketsFilter    = types.NewMarketsFilter
 	NewMarketExtended   = types.NewMarketExtended
+	NewMarketFees       = types.NewMarketFees
+	NewMsgSetMarketFees = types.NewMsgSetMarketFees
+	ValidateFee         = types.ValidateFee
 	// perms requests
 	RequestCCStoragePerms = types.RequestCCStoragePerms
 	// error aliases
@@ -45,4 +54,5 @@ var (
 	ErrMarketExists    = types.ErrMarketExists
 	ErrInvalidQuantity = types.ErrInvalidQuantity
 	ErrWrongFrom       = types.ErrWrongFrom
+	ErrWrongFee        = types.ErrWrongFee
 )
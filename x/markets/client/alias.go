@@ -4,6 +4,8 @@ import "github.com/dfinance/dnode/x/markets/internal/types"
 
 const (
 	// Permissions
-	PermCreate = types.PermCreate
-	PermRead   = types.PermRead
+	PermCreate      = types.PermCreate
+	PermRead        = types.PermRead
+	PermFeesUpdate  = types.PermFeesUpdate
+	PermFeesCollect = types.PermFeesCollect
 )
@@ -82,11 +82,7 @@ func AddMarketGenCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome stri
 			marketID = &id
 
 			genesisMarket.LastMarketID = marketID
-			genesisMarket.Markets = append(genesisMarket.Markets, types.Market{
-				ID:              *marketID,
-				BaseAssetDenom:  baseDenom,
-				QuoteAssetDenom: quoteDenom,
-			})
+			genesisMarket.Markets = append(genesisMarket.Markets, types.NewMarket(*marketID, baseDenom, quoteDenom))
 
 			// update the app state
 			genesisStateBz := cdc.MustMarshalJSON(genesisMarket)
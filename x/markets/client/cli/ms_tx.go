@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/markets/internal/types"
+	msClient "github.com/dfinance/dnode/x/multisig/client"
+)
+
+// PostMsSetMarketFees returns tx command which post a new multisig market fee rates update request.
+func PostMsSetMarketFees(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ms-set-fees [uniqueID] [market_id] [maker_fee] [taker_fee]",
+		Short:   "Update market fee rates via multi signature",
+		Example: "ms-set-fees fees1 0 0.001 0.002 --from {account}",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			marketID, err := helpers.ParseDnIDParam("market_id", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			makerFee, err := helpers.ParseSdkDecParam("maker_fee", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			takerFee, err := helpers.ParseSdkDecParam("taker_fee", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send multisig message
+			msg := types.NewMsgSetMarketFees(marketID, makerFee, takerFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			callMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
+			if err := callMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{callMsg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"unique multisig call ID",
+		"market ID [uint]",
+		"maker fee rate [decimal]",
+		"taker fee rate [decimal]",
+	})
+
+	return cmd
+}
@@ -114,3 +114,48 @@ func GetCmdMarket(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdMarketFees returns query command that returns market fee rates and total collected fees by market id.
+func GetCmdMarketFees(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fees [id]",
+		Example: "dncli markets fees 1",
+		Short:   "Get market fee rates and total collected fees by market id",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			id, err := helpers.ParseDnIDParam("id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.MarketReq{
+				ID: id,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFees), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.MarketFees
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+	})
+
+	return cmd
+}
@@ -20,6 +20,7 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd.AddCommand(sdkClient.GetCommands(
 		cli.GetCmdListMarkets(types.ModuleName, cdc),
 		cli.GetCmdMarket(types.ModuleName, cdc),
+		cli.GetCmdMarketFees(types.ModuleName, cdc),
 	)...)
 
 	return queryCmd
@@ -37,6 +38,7 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(sdkClient.PostCommands(
 		cli.GetCmdAddMarket(cdc),
+		cli.PostMsSetMarketFees(cdc),
 	)...,
 	)
 
@@ -22,6 +22,7 @@ const (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s", types.ModuleName), getMarketsWithParams(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/{%s}", types.ModuleName, MarketID), getMarket(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/{%s}/fees", types.ModuleName, MarketID), getMarketFees(cliCtx)).Methods("GET")
 }
 
 // GetMarketsWithParams godoc
@@ -121,3 +122,47 @@ func getMarket(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// GetMarketFees godoc
+// @Tags Markets
+// @Summary Get market fees
+// @Description Get market fee rates and total collected fees by marketID
+// @ID marketsGetMarketFees
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Success 200 {object} MarketsRespGetMarketFees
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /markets/{marketID}/fees [get]
+func getMarketFees(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		id, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.MarketReq{
+			ID: id,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryFees), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
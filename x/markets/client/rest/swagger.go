@@ -15,4 +15,9 @@ type (
 		Height int64        `json:"height"`
 		Result types.Market `json:"result"`
 	}
+
+	MarketsRespGetMarketFees struct {
+		Height int64            `json:"height"`
+		Result types.MarketFees `json:"result"`
+	}
 )
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets/internal/types"
+)
+
+// GetFees returns market fee rates and total collected fees.
+func (k Keeper) GetFees(ctx sdk.Context, id dnTypes.ID) (types.MarketFees, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	market, err := k.Get(ctx, id)
+	if err != nil {
+		return types.MarketFees{}, err
+	}
+
+	return types.NewMarketFees(market, k.getCollectedFees(ctx, id)), nil
+}
+
+// AddCollectedFees increases market total collected fees.
+// Action is only allowed to the orders module (on order fills execution).
+func (k Keeper) AddCollectedFees(ctx sdk.Context, id dnTypes.ID, coins sdk.Coins) {
+	k.modulePerms.AutoCheck(types.PermFeesCollect)
+
+	if coins.Empty() {
+		return
+	}
+
+	k.setCollectedFees(ctx, id, k.getCollectedFees(ctx, id).Add(coins...))
+}
+
+// getCollectedFees returns market total collected fees from the storage.
+func (k Keeper) getCollectedFees(ctx sdk.Context, id dnTypes.ID) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetCollectedFeesKey(id))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	coins := sdk.Coins{}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &coins)
+
+	return coins
+}
+
+// setCollectedFees sets market total collected fees to the storage.
+func (k Keeper) setCollectedFees(ctx sdk.Context, id dnTypes.ID, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCollectedFeesKey(id), k.cdc.MustMarshalBinaryLengthPrefixed(coins))
+}
+
+// getCollectedFeesList returns all non-empty markets collected fees.
+func (k Keeper) getCollectedFeesList(ctx sdk.Context) []types.MarketCollectedFees {
+	list := make([]types.MarketCollectedFees, 0)
+
+	k.iterateMarkets(ctx, func(m types.Market) bool {
+		if coins := k.getCollectedFees(ctx, m.ID); !coins.Empty() {
+			list = append(list, types.MarketCollectedFees{MarketID: m.ID, Collected: coins})
+		}
+		return true
+	})
+
+	return list
+}
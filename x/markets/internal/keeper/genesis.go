@@ -34,13 +34,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 			k.set(ctx, market)
 		}
 	}
+
+	// collected fees
+	for i, fees := range state.CollectedFees {
+		if !k.Has(ctx, fees.MarketID) {
+			panic(fmt.Errorf("collected_fees[%d]: market not found", i))
+		}
+
+		k.setCollectedFees(ctx, fees.MarketID, fees.Collected)
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	state := types.GenesisState{
-		Markets:      k.GetList(ctx),
-		LastMarketID: k.getLastMarketID(ctx),
+		Markets:       k.GetList(ctx),
+		LastMarketID:  k.getLastMarketID(ctx),
+		CollectedFees: k.getCollectedFeesList(ctx),
 	}
 
 	return k.cdc.MustMarshalJSON(state)
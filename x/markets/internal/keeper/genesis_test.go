@@ -5,6 +5,7 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
@@ -32,6 +33,12 @@ func TestMarketsKeeper_Genesis(t *testing.T) {
 			},
 		},
 		LastMarketID: &lastID,
+		CollectedFees: []types.MarketCollectedFees{
+			{
+				MarketID:  dnTypes.NewIDFromUint64(1),
+				Collected: sdk.NewCoins(sdk.NewInt64Coin(input.baseEthDenom, 100), sdk.NewInt64Coin(input.quoteDenom, 50)),
+			},
+		},
 	}
 
 	// init
@@ -56,6 +63,11 @@ func TestMarketsKeeper_Genesis(t *testing.T) {
 			}
 			require.Equal(t, 1, foundCnt)
 		}
+
+		// collected fees
+		fees, err := keeper.GetFees(ctx, dnTypes.NewIDFromUint64(1))
+		require.NoError(t, err)
+		require.Equal(t, initState.CollectedFees[0].Collected.String(), fees.Collected.String())
 	}
 
 	// export
@@ -81,6 +93,11 @@ func TestMarketsKeeper_Genesis(t *testing.T) {
 			}
 			require.Equal(t, 1, foundCnt)
 		}
+
+		// collected fees
+		require.Len(t, exportState.CollectedFees, 1)
+		require.True(t, exportState.CollectedFees[0].MarketID.Equal(initState.CollectedFees[0].MarketID))
+		require.Equal(t, initState.CollectedFees[0].Collected.String(), exportState.CollectedFees[0].Collected.String())
 	}
 
 	// init with non-existing currency
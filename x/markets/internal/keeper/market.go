@@ -98,6 +98,31 @@ func (k Keeper) Add(ctx sdk.Context, baseAsset, quoteAsset string) (types.Market
 	return market, nil
 }
 
+// SetFees updates market fee rates.
+// Action is only allowed via multisig.
+func (k Keeper) SetFees(ctx sdk.Context, id dnTypes.ID, makerFee, takerFee sdk.Dec) (types.Market, error) {
+	k.modulePerms.AutoCheck(types.PermFeesUpdate)
+
+	market, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Market{}, err
+	}
+
+	if err := types.ValidateFee(makerFee); err != nil {
+		return types.Market{}, sdkErrors.Wrap(err, "MakerFee")
+	}
+	if err := types.ValidateFee(takerFee); err != nil {
+		return types.Market{}, sdkErrors.Wrap(err, "TakerFee")
+	}
+
+	market.MakerFee, market.TakerFee = makerFee, takerFee
+	k.set(ctx, market)
+
+	ctx.EventManager().EmitEvent(types.NewMarketFeesUpdatedEvent(market))
+
+	return market, nil
+}
+
 // GetList returns all market objects.
 func (k Keeper) GetList(ctx sdk.Context) types.Markets {
 	k.modulePerms.AutoCheck(types.PermRead)
@@ -156,3 +156,79 @@ func TestMarketsKeeper_AddDuplicate(t *testing.T) {
 	_, err = input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
 	require.Error(t, err)
 }
+
+func TestMarketsKeeper_SetFees(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+
+	market, err := input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	require.True(t, market.MakerFee.IsZero())
+	require.True(t, market.TakerFee.IsZero())
+
+	// non-existing market
+	{
+		_, err := input.keeper.SetFees(input.ctx, dnTypes.NewIDFromUint64(1), sdk.ZeroDec(), sdk.ZeroDec())
+		require.Error(t, err)
+	}
+
+	// invalid fees
+	{
+		_, err := input.keeper.SetFees(input.ctx, market.ID, sdk.NewDecWithPrec(-1, 3), sdk.ZeroDec())
+		require.Error(t, err)
+
+		_, err = input.keeper.SetFees(input.ctx, market.ID, sdk.ZeroDec(), types.MaxFeeRate)
+		require.Error(t, err)
+	}
+
+	// ok
+	{
+		makerFee, takerFee := sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(2, 3)
+
+		_, err := input.keeper.SetFees(input.ctx, market.ID, makerFee, takerFee)
+		require.NoError(t, err)
+
+		updMarket, err := input.keeper.Get(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.True(t, updMarket.MakerFee.Equal(makerFee))
+		require.True(t, updMarket.TakerFee.Equal(takerFee))
+		require.True(t, updMarket.FeeRate(true).Equal(makerFee))
+		require.True(t, updMarket.FeeRate(false).Equal(takerFee))
+	}
+}
+
+func TestMarketsKeeper_CollectedFees(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+
+	market, err := input.keeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// non-existing market
+	{
+		_, err := input.keeper.GetFees(input.ctx, dnTypes.NewIDFromUint64(1))
+		require.Error(t, err)
+	}
+
+	// empty by default
+	{
+		fees, err := input.keeper.GetFees(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.True(t, fees.Collected.Empty())
+	}
+
+	// ok
+	{
+		input.keeper.AddCollectedFees(input.ctx, market.ID, sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, sdk.NewInt(10))))
+		input.keeper.AddCollectedFees(input.ctx, market.ID, sdk.NewCoins(sdk.NewCoin(input.quoteDenom, sdk.NewInt(5))))
+		input.keeper.AddCollectedFees(input.ctx, market.ID, sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, sdk.NewInt(15))))
+		input.keeper.AddCollectedFees(input.ctx, market.ID, sdk.NewCoins())
+
+		fees, err := input.keeper.GetFees(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.True(t, fees.Collected.AmountOf(input.baseBtcDenom).Equal(sdk.NewInt(25)))
+		require.True(t, fees.Collected.AmountOf(input.quoteDenom).Equal(sdk.NewInt(5)))
+	}
+}
@@ -19,6 +19,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryList(ctx, k, req)
 		case types.QueryMarket:
 			return queryMarket(ctx, k, req)
+		case types.QueryFees:
+			return queryFees(ctx, k, req)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -64,3 +66,24 @@ func queryMarket(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, erro
 
 	return res, nil
 }
+
+// queryFees handles fees query which return market fee rates and total collected fees by market id.
+func queryFees(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.MarketReq
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	fees, err := k.GetFees(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, fees)
+	if err != nil {
+		return nil, fmt.Errorf("market fees marshal: %w", err)
+	}
+
+	return res, nil
+}
@@ -11,6 +11,7 @@ var ModuleCdc *codec.Codec
 // RegisterCodec registers module specific messages.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateMarket{}, fmt.Sprintf("%s/MsgCreateMarket", ModuleName), nil)
+	cdc.RegisterConcrete(MsgSetMarketFees{}, fmt.Sprintf("%s/MsgSetMarketFees", ModuleName), nil)
 }
 
 func init() {
@@ -14,4 +14,6 @@ var (
 	ErrInvalidQuantity = sdkErrors.Register(ModuleName, 104, "base to quote asset quantity normalization failed")
 	// MsgCreateMarket.From is empty.
 	ErrWrongFrom = sdkErrors.Register(ModuleName, 105, "wrong from address, should not be empty")
+	// Fee rate is invalid.
+	ErrWrongFee = sdkErrors.Register(ModuleName, 106, "wrong fee rate")
 )
@@ -3,11 +3,14 @@ package types
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
 const (
-	EventTypeCreate = ModuleName + ".create"
+	EventTypeCreate     = ModuleName + ".create"
+	EventTypeFeesUpdate = ModuleName + ".fees_update"
 	//
 	AttributeMarketId   = "market_id"
 	AttributeBaseDenom  = "base_denom"
 	AttributeQuoteDenom = "quote_denom"
+	AttributeMakerFee   = "maker_fee"
+	AttributeTakerFee   = "taker_fee"
 )
 
 // NewMarketCreatedEvent creates an Event on market creation.
@@ -19,3 +22,13 @@ func NewMarketCreatedEvent(market Market) sdk.Event {
 		sdk.NewAttribute(AttributeQuoteDenom, market.QuoteAssetDenom),
 	)
 }
+
+// NewMarketFeesUpdatedEvent creates an Event on market fee rates update.
+func NewMarketFeesUpdatedEvent(market Market) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeFeesUpdate,
+		sdk.NewAttribute(AttributeMarketId, market.ID.String()),
+		sdk.NewAttribute(AttributeMakerFee, market.MakerFee.String()),
+		sdk.NewAttribute(AttributeTakerFee, market.TakerFee.String()),
+	)
+}
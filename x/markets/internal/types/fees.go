@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+var (
+	// Max fee rate value (exclusive): 10%
+	MaxFeeRate = sdk.NewDecWithPrec(1, 1)
+)
+
+// MarketFees is a market fee rates object (query response).
+type MarketFees struct {
+	// Market unique ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" format:"string representation for big.Uint" swaggertype:"string" example:"0"`
+	// Maker fee rate
+	MakerFee sdk.Dec `json:"maker_fee" yaml:"maker_fee" swaggertype:"string" example:"0.001"`
+	// Taker fee rate
+	TakerFee sdk.Dec `json:"taker_fee" yaml:"taker_fee" swaggertype:"string" example:"0.002"`
+	// Total fees collected on market order fills
+	Collected sdk.Coins `json:"collected" yaml:"collected" swaggertype:"string" example:"100btc,50xfi"`
+}
+
+// String returns multi-line text object representation.
+func (f MarketFees) String() string {
+	b := strings.Builder{}
+	b.WriteString("MarketFees:\n")
+	b.WriteString(fmt.Sprintf("  MarketID: %s\n", f.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  MakerFee: %s\n", f.MakerFee.String()))
+	b.WriteString(fmt.Sprintf("  TakerFee: %s\n", f.TakerFee.String()))
+	b.WriteString(fmt.Sprintf("  Collected: %s\n", f.Collected.String()))
+
+	return b.String()
+}
+
+// NewMarketFees creates a new MarketFees object.
+func NewMarketFees(market Market, collected sdk.Coins) MarketFees {
+	return MarketFees{
+		MarketID:  market.ID,
+		MakerFee:  market.FeeRate(true),
+		TakerFee:  market.FeeRate(false),
+		Collected: collected,
+	}
+}
+
+// MarketCollectedFees is a market collected fees object (genesis state item).
+type MarketCollectedFees struct {
+	// Market unique ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// Total fees collected on market order fills
+	Collected sdk.Coins `json:"collected" yaml:"collected"`
+}
+
+// Valid checks object fields.
+func (f MarketCollectedFees) Valid() error {
+	if err := f.MarketID.Valid(); err != nil {
+		return fmt.Errorf("market_id: %w", err)
+	}
+	if !f.Collected.IsValid() {
+		return fmt.Errorf("collected: invalid coins: %s", f.Collected.String())
+	}
+
+	return nil
+}
+
+// ValidateFee checks fee rate value is within [0, MaxFeeRate) range.
+func ValidateFee(fee sdk.Dec) error {
+	if fee.IsNil() {
+		return sdkErrors.Wrap(ErrWrongFee, "nil")
+	}
+	if fee.IsNegative() {
+		return sdkErrors.Wrap(ErrWrongFee, "negative")
+	}
+	if fee.GTE(MaxFeeRate) {
+		return sdkErrors.Wrapf(ErrWrongFee, "should be LT %s", MaxFeeRate)
+	}
+
+	return nil
+}
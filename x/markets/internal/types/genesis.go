@@ -8,8 +8,9 @@ import (
 
 // Module genesis state object.
 type GenesisState struct {
-	Markets      Markets     `json:"markets" yaml:"markets"`
-	LastMarketID *dnTypes.ID `json:"last_market_id" yaml:"last_market_id"`
+	Markets       Markets               `json:"markets" yaml:"markets"`
+	LastMarketID  *dnTypes.ID           `json:"last_market_id" yaml:"last_market_id"`
+	CollectedFees []MarketCollectedFees `json:"collected_fees" yaml:"collected_fees"`
 }
 
 // Validate checks that genesis state is valid.
@@ -46,12 +47,29 @@ func (s GenesisState) Validate() error {
 		}
 	}
 
+	collectedFeesSet := make(map[string]bool, len(s.CollectedFees))
+	for i, f := range s.CollectedFees {
+		if err := f.Valid(); err != nil {
+			return fmt.Errorf("collected_fees[%d]: %v", i, err)
+		}
+
+		if !marketsSet[f.MarketID.String()] {
+			return fmt.Errorf("collected_fees[%d]: market not found", i)
+		}
+
+		if collectedFeesSet[f.MarketID.String()] {
+			return fmt.Errorf("collected_fees[%d]: duplicated market ID", i)
+		}
+		collectedFeesSet[f.MarketID.String()] = true
+	}
+
 	return nil
 }
 
 // DefaultGenesisState returns module default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Markets: Markets{},
+		Markets:       Markets{},
+		CollectedFees: []MarketCollectedFees{},
 	}
 }
@@ -84,6 +84,18 @@ func TestMarkets_Genesis_Invalid(t *testing.T) {
 		require.Error(t, state.Validate())
 	}
 
+	// invalid fee
+	{
+		lastID := dnTypes.NewIDFromUint64(0)
+		market := NewMarket(dnTypes.NewIDFromUint64(0), "btc", "xfi")
+		market.TakerFee = MaxFeeRate
+		state := GenesisState{
+			Markets:      Markets{market},
+			LastMarketID: &lastID,
+		}
+		require.Error(t, state.Validate())
+	}
+
 	// duplicate market
 	{
 		lastID := dnTypes.NewIDFromUint64(0)
@@ -158,4 +170,62 @@ func TestMarkets_Genesis_Invalid(t *testing.T) {
 		}
 		require.Error(t, state.Validate())
 	}
+
+	// collected fees for non-existing market
+	{
+		lastID := dnTypes.NewIDFromUint64(0)
+		state := GenesisState{
+			Markets: Markets{
+				Market{
+					ID:              dnTypes.NewIDFromUint64(0),
+					BaseAssetDenom:  "btc",
+					QuoteAssetDenom: "xfi",
+				},
+			},
+			LastMarketID: &lastID,
+			CollectedFees: []MarketCollectedFees{
+				{MarketID: dnTypes.NewIDFromUint64(1), Collected: sdk.NewCoins(sdk.NewInt64Coin("btc", 1))},
+			},
+		}
+		require.Error(t, state.Validate())
+	}
+
+	// duplicated collected fees
+	{
+		lastID := dnTypes.NewIDFromUint64(0)
+		state := GenesisState{
+			Markets: Markets{
+				Market{
+					ID:              dnTypes.NewIDFromUint64(0),
+					BaseAssetDenom:  "btc",
+					QuoteAssetDenom: "xfi",
+				},
+			},
+			LastMarketID: &lastID,
+			CollectedFees: []MarketCollectedFees{
+				{MarketID: dnTypes.NewIDFromUint64(0), Collected: sdk.NewCoins(sdk.NewInt64Coin("btc", 1))},
+				{MarketID: dnTypes.NewIDFromUint64(0), Collected: sdk.NewCoins(sdk.NewInt64Coin("xfi", 1))},
+			},
+		}
+		require.Error(t, state.Validate())
+	}
+
+	// invalid collected fees coins
+	{
+		lastID := dnTypes.NewIDFromUint64(0)
+		state := GenesisState{
+			Markets: Markets{
+				Market{
+					ID:              dnTypes.NewIDFromUint64(0),
+					BaseAssetDenom:  "btc",
+					QuoteAssetDenom: "xfi",
+				},
+			},
+			LastMarketID: &lastID,
+			CollectedFees: []MarketCollectedFees{
+				{MarketID: dnTypes.NewIDFromUint64(0), Collected: sdk.Coins{sdk.Coin{Denom: "btc", Amount: sdk.NewInt(-1)}}},
+			},
+		}
+		require.Error(t, state.Validate())
+	}
 }
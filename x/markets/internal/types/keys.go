@@ -10,6 +10,8 @@ var (
 	KeyDelimiter    = []byte(":")
 	KeyMarketPrefix = []byte("market")
 	KeyLastMarketId = []byte("last_market_id")
+	//
+	KeyCollectedFeesPrefix = []byte("collected_fees")
 )
 
 // GetMarketsKey returns key for storing markets.
@@ -27,3 +29,19 @@ func GetMarketsKey(id dnTypes.ID) []byte {
 func GetPrefixMarketsKey() []byte {
 	return append(KeyMarketPrefix, KeyDelimiter...)
 }
+
+// GetCollectedFeesKey returns key for storing market collected fees.
+func GetCollectedFeesKey(id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			KeyCollectedFeesPrefix,
+			[]byte(id.String()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetPrefixCollectedFeesKey return storage key prefix for market collected fees (used for iteration).
+func GetPrefixCollectedFeesKey() []byte {
+	return append(KeyCollectedFeesPrefix, KeyDelimiter...)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/olekukonko/tablewriter"
 
@@ -20,6 +21,10 @@ type Market struct {
 	BaseAssetDenom string `json:"base_asset_denom" yaml:"base_asset_denom" example:"btc"`
 	// Quote asset denomination (for ex. xfi)
 	QuoteAssetDenom string `json:"quote_asset_denom" yaml:"quote_asset_denom" example:"xfi"`
+	// Maker fee rate (charged from the fill amount for orders posted before the matching block)
+	MakerFee sdk.Dec `json:"maker_fee" yaml:"maker_fee" swaggertype:"string" example:"0.001"`
+	// Taker fee rate (charged from the fill amount for orders posted within the matching block)
+	TakerFee sdk.Dec `json:"taker_fee" yaml:"taker_fee" swaggertype:"string" example:"0.002"`
 }
 
 // Valid check object validity.
@@ -33,6 +38,17 @@ func (m Market) Valid() error {
 	if err := dnTypes.DenomFilter(m.QuoteAssetDenom); err != nil {
 		return sdkErrors.Wrapf(ErrWrongAssetDenom, "QuoteAsset is invalid: %v", err)
 	}
+	// fees are optional (nil equals to zero fee)
+	if !m.MakerFee.IsNil() {
+		if err := ValidateFee(m.MakerFee); err != nil {
+			return sdkErrors.Wrap(err, "MakerFee")
+		}
+	}
+	if !m.TakerFee.IsNil() {
+		if err := ValidateFee(m.TakerFee); err != nil {
+			return sdkErrors.Wrap(err, "TakerFee")
+		}
+	}
 
 	return nil
 }
@@ -44,6 +60,8 @@ func (m Market) String() string {
 	b.WriteString(fmt.Sprintf("  ID:              %s\n", m.ID.String()))
 	b.WriteString(fmt.Sprintf("  BaseAssetDenom:  %s\n", m.BaseAssetDenom))
 	b.WriteString(fmt.Sprintf("  QuoteAssetDenom: %s\n", m.QuoteAssetDenom))
+	b.WriteString(fmt.Sprintf("  MakerFee:        %s\n", m.MakerFee.String()))
+	b.WriteString(fmt.Sprintf("  TakerFee:        %s\n", m.TakerFee.String()))
 
 	return b.String()
 }
@@ -54,6 +72,8 @@ func (m Market) TableHeaders() []string {
 		"M.ID",
 		"M.BaseAssetDenom",
 		"M.QuoteAssetDenom",
+		"M.MakerFee",
+		"M.TakerFee",
 	}
 }
 
@@ -63,6 +83,8 @@ func (m Market) TableValues() []string {
 		m.ID.String(),
 		m.BaseAssetDenom,
 		m.QuoteAssetDenom,
+		m.MakerFee.String(),
+		m.TakerFee.String(),
 	}
 }
 
@@ -71,12 +93,28 @@ func (m Market) GetAssetCode() dnTypes.AssetCode {
 	return dnTypes.AssetCode(m.BaseAssetDenom + "_" + m.QuoteAssetDenom)
 }
 
-// NewMarket creates a new market object.
+// FeeRate returns maker / taker fee rate (zero if not set).
+func (m Market) FeeRate(isMaker bool) sdk.Dec {
+	fee := m.TakerFee
+	if isMaker {
+		fee = m.MakerFee
+	}
+
+	if fee.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return fee
+}
+
+// NewMarket creates a new market object with zero fees.
 func NewMarket(id dnTypes.ID, baseAsset, quoteAsset string) Market {
 	return Market{
 		ID:              id,
 		BaseAssetDenom:  baseAsset,
 		QuoteAssetDenom: quoteAsset,
+		MakerFee:        sdk.ZeroDec(),
+		TakerFee:        sdk.ZeroDec(),
 	}
 }
 
@@ -38,6 +38,11 @@ func (m MarketExtended) Valid() error {
 	return nil
 }
 
+// IsPaused checks if base or quote currency is paused (market trading is blocked).
+func (m MarketExtended) IsPaused() bool {
+	return m.BaseCurrency.Paused || m.QuoteCurrency.Paused
+}
+
 // BaseToQuoteQuantity converts base asset price and quantity to quote asset quantity.
 // Function normalizes quantity to be used later by OrderBook module, that way quantity for bid and ask
 // order are casted to the same base (base quantity).
@@ -80,6 +85,11 @@ func (m MarketExtended) QuoteDenom() string {
 	return string(m.QuoteCurrency.Denom)
 }
 
+// GetAssetCode returns asset code for market.
+func (m MarketExtended) GetAssetCode() dnTypes.AssetCode {
+	return dnTypes.AssetCode(m.BaseDenom() + "_" + m.QuoteDenom())
+}
+
 // String returns multi-line text object representation.
 func (m MarketExtended) String() string {
 	b := strings.Builder{}
@@ -3,10 +3,14 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/core/msmodule"
 )
 
 var (
-	_ sdk.Msg = MsgCreateMarket{}
+	_ sdk.Msg        = MsgCreateMarket{}
+	_ msmodule.MsMsg = MsgSetMarketFees{}
 )
 
 // Client message to create a market object.
@@ -59,3 +63,44 @@ func NewMsgCreateMarket(fromAddress sdk.AccAddress, baseAsset string, quoteAsset
 		QuoteAssetDenom: quoteAsset,
 	}
 }
+
+// Client multisig message to set market fee rates.
+type MsgSetMarketFees struct {
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	MakerFee sdk.Dec    `json:"maker_fee" yaml:"maker_fee"`
+	TakerFee sdk.Dec    `json:"taker_fee" yaml:"taker_fee"`
+}
+
+// Implements msmodule.MsMsg interface.
+func (msg MsgSetMarketFees) Route() string {
+	return ModuleName
+}
+
+// Implements msmodule.MsMsg interface.
+func (msg MsgSetMarketFees) Type() string {
+	return "set_market_fees"
+}
+
+// Implements msmodule.MsMsg interface.
+func (msg MsgSetMarketFees) ValidateBasic() error {
+	if err := msg.MarketID.Valid(); err != nil {
+		return sdkErrors.Wrap(ErrWrongID, err.Error())
+	}
+	if err := ValidateFee(msg.MakerFee); err != nil {
+		return sdkErrors.Wrap(err, "MakerFee")
+	}
+	if err := ValidateFee(msg.TakerFee); err != nil {
+		return sdkErrors.Wrap(err, "TakerFee")
+	}
+
+	return nil
+}
+
+// NewMsgSetMarketFees creates MsgSetMarketFees multisig message object.
+func NewMsgSetMarketFees(marketID dnTypes.ID, makerFee, takerFee sdk.Dec) MsgSetMarketFees {
+	return MsgSetMarketFees{
+		MarketID: marketID,
+		MakerFee: makerFee,
+		TakerFee: takerFee,
+	}
+}
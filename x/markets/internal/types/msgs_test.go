@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 func TestMarkets_MsgCreateMarket_Valid(t *testing.T) {
@@ -42,3 +44,38 @@ func TestMarkets_MsgCreateMarket_Invalid(t *testing.T) {
 
 	}
 }
+
+func TestMarkets_MsgSetMarketFees_Valid(t *testing.T) {
+	t.Parallel()
+
+	msg := NewMsgSetMarketFees(dnTypes.NewIDFromUint64(0), sdk.ZeroDec(), sdk.NewDecWithPrec(1, 3))
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestMarkets_MsgSetMarketFees_Invalid(t *testing.T) {
+	t.Parallel()
+
+	// invalid marketID
+	{
+		msg := NewMsgSetMarketFees(dnTypes.ID{}, sdk.ZeroDec(), sdk.ZeroDec())
+		require.Error(t, msg.ValidateBasic())
+	}
+
+	// nil fees
+	{
+		msg := NewMsgSetMarketFees(dnTypes.NewIDFromUint64(0), sdk.Dec{}, sdk.ZeroDec())
+		require.Error(t, msg.ValidateBasic())
+	}
+
+	// negative fee
+	{
+		msg := NewMsgSetMarketFees(dnTypes.NewIDFromUint64(0), sdk.ZeroDec(), sdk.NewDecWithPrec(-1, 3))
+		require.Error(t, msg.ValidateBasic())
+	}
+
+	// fee too high
+	{
+		msg := NewMsgSetMarketFees(dnTypes.NewIDFromUint64(0), MaxFeeRate, sdk.ZeroDec())
+		require.Error(t, msg.ValidateBasic())
+	}
+}
@@ -12,10 +12,14 @@ const (
 	PermCreate perms.Permission = ModuleName + "PermCreate"
 	// Read market / markets
 	PermRead perms.Permission = ModuleName + "PermRead"
+	// Update market fee rates
+	PermFeesUpdate perms.Permission = ModuleName + "PermFeesUpdate"
+	// Add market collected fees
+	PermFeesCollect perms.Permission = ModuleName + "PermFeesCollect"
 )
 
 var (
-	AvailablePermissions = perms.Permissions{PermInit, PermCreate, PermRead}
+	AvailablePermissions = perms.Permissions{PermInit, PermCreate, PermRead, PermFeesUpdate, PermFeesCollect}
 )
 
 func NewModulePerms() perms.ModulePermissions {
@@ -9,6 +9,7 @@ import (
 const (
 	QueryList   = "list"
 	QueryMarket = "market"
+	QueryFees   = "fees"
 )
 
 // Client request for market.
@@ -13,12 +13,14 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/dfinance/dnode/x/core/msmodule"
 	"github.com/dfinance/dnode/x/markets/client"
 	"github.com/dfinance/dnode/x/markets/client/rest"
 )
 
 var (
 	_ module.AppModule      = AppModule{}
+	_ msmodule.AppMsModule  = AppModule{}
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
 
@@ -71,8 +73,8 @@ type AppModule struct {
 	keeper Keeper
 }
 
-// NewAppModule creates new AppModule object.
-func NewAppModule(keeper Keeper) AppModule {
+// NewAppMsModule creates new AppMsModule object.
+func NewAppMsModule(keeper Keeper) msmodule.AppMsModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
@@ -97,6 +99,11 @@ func (app AppModule) NewHandler() sdk.Handler {
 	return NewHandler(app.keeper)
 }
 
+// NewMsHandler returns module multisig messages handler.
+func (app AppModule) NewMsHandler() msmodule.MsHandler {
+	return NewMsHandler(app.keeper)
+}
+
 // QuerierRoute returns module querier route.
 func (app AppModule) QuerierRoute() string {
 	return ModuleName
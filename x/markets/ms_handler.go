@@ -0,0 +1,33 @@
+package markets
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/core/msmodule"
+)
+
+// NewMsHandler creates core.MsMsg type messages handler.
+func NewMsHandler(k Keeper) msmodule.MsHandler {
+	return func(ctx sdk.Context, msg msmodule.MsMsg) error {
+		switch msg := msg.(type) {
+		case MsgSetMarketFees:
+			return handleMsMsgSetMarketFees(ctx, k, msg)
+
+		default:
+			return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized %s module multisig msg type: %v", ModuleName, msg.Type())
+		}
+	}
+}
+
+// handleMsMsgSetMarketFees handles MsgSetMarketFees multisig message.
+func handleMsMsgSetMarketFees(ctx sdk.Context, k Keeper, msg MsgSetMarketFees) error {
+	if _, err := k.SetFees(ctx, msg.MarketID, msg.MakerFee, msg.TakerFee); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return nil
+}
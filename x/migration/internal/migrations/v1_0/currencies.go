@@ -0,0 +1,67 @@
+package v1_0
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/currencies"
+)
+
+type (
+	// v0.7 currencies module genesis state.
+	currenciesGenesisStateV07 struct {
+		Issues         []currenciesGenesisIssueV07 `json:"issues"`
+		Withdraws      []currenciesWithdrawV07     `json:"withdraws"`
+		LastWithdrawID *dnTypes.ID                 `json:"last_withdraw_id"`
+	}
+
+	// v0.7 currencies module issue (no source chain deposit info).
+	currenciesGenesisIssueV07 struct {
+		Coin  sdk.Coin       `json:"coin"`
+		Payee sdk.AccAddress `json:"payee"`
+		ID    string         `json:"id"`
+	}
+
+	// v0.7 currencies module withdraw (no peg zone lifecycle state).
+	currenciesWithdrawV07 struct {
+		ID             dnTypes.ID     `json:"id"`
+		Coin           sdk.Coin       `json:"coin"`
+		Spender        sdk.AccAddress `json:"spender"`
+		PegZoneSpender string         `json:"pegzone_spender"`
+		PegZoneChainID string         `json:"pegzone_chain_id"`
+		Timestamp      int64          `json:"timestamp"`
+		TxHash         string         `json:"tx_hash"`
+	}
+)
+
+// migrateCurrencies migrates v0.7 currencies genesis state to v1.0.
+// Source chain deposit info for existing issues is unknown and left empty (such issues are not indexed by deposit).
+func migrateCurrencies(oldState currenciesGenesisStateV07) currencies.GenesisState {
+	newState := currencies.DefaultGenesisState()
+	newState.LastWithdrawID = oldState.LastWithdrawID
+
+	for _, oldIssue := range oldState.Issues {
+		newState.Issues = append(newState.Issues, currencies.GenesisIssue{
+			Issue: currencies.Issue{
+				Coin:  oldIssue.Coin,
+				Payee: oldIssue.Payee,
+			},
+			ID: oldIssue.ID,
+		})
+	}
+
+	for _, oldWithdraw := range oldState.Withdraws {
+		newState.Withdraws = append(newState.Withdraws, currencies.Withdraw{
+			ID:             oldWithdraw.ID,
+			Coin:           oldWithdraw.Coin,
+			Spender:        oldWithdraw.Spender,
+			PegZoneSpender: oldWithdraw.PegZoneSpender,
+			PegZoneChainID: oldWithdraw.PegZoneChainID,
+			Timestamp:      oldWithdraw.Timestamp,
+			TxHash:         oldWithdraw.TxHash,
+			State:          currencies.WithdrawStatePending,
+		})
+	}
+
+	return newState
+}
@@ -0,0 +1,84 @@
+// +build unit
+
+package v1_0
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/currencies"
+)
+
+// Test v0.7 currencies genesis state migration.
+func TestMigration_Currencies(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coin := sdk.NewCoin("xfi", sdk.NewInt(100))
+	lastID := dnTypes.NewIDFromUint64(1)
+
+	oldState := currenciesGenesisStateV07{
+		Issues: []currenciesGenesisIssueV07{
+			{Coin: coin, Payee: addr, ID: "issue1"},
+			{Coin: coin, Payee: addr, ID: "issue2"},
+		},
+		Withdraws: []currenciesWithdrawV07{
+			{ID: dnTypes.NewIDFromUint64(0), Coin: coin, Spender: addr, PegZoneSpender: "pgAcc", PegZoneChainID: "pgID", Timestamp: 1, TxHash: "hash0"},
+			{ID: dnTypes.NewIDFromUint64(1), Coin: coin, Spender: addr, PegZoneSpender: "pgAcc", PegZoneChainID: "pgID", Timestamp: 2, TxHash: "hash1"},
+		},
+		LastWithdrawID: &lastID,
+	}
+
+	// migrateCurrencies
+	{
+		newState := migrateCurrencies(oldState)
+		require.NoError(t, newState.Validate(time.Time{}))
+
+		require.Len(t, newState.Issues, len(oldState.Issues))
+		for i, newIssue := range newState.Issues {
+			require.Equal(t, oldState.Issues[i].ID, newIssue.ID)
+			require.Equal(t, oldState.Issues[i].Coin, newIssue.Coin)
+			require.Equal(t, oldState.Issues[i].Payee, newIssue.Payee)
+			require.True(t, newIssue.Deposit.IsEmpty())
+		}
+
+		require.Len(t, newState.Withdraws, len(oldState.Withdraws))
+		for i, newWithdraw := range newState.Withdraws {
+			require.Equal(t, oldState.Withdraws[i].ID.String(), newWithdraw.ID.String())
+			require.Equal(t, oldState.Withdraws[i].Coin, newWithdraw.Coin)
+			require.Equal(t, oldState.Withdraws[i].TxHash, newWithdraw.TxHash)
+			require.Equal(t, currencies.WithdrawStatePending, newWithdraw.State)
+		}
+
+		require.NotNil(t, newState.LastWithdrawID)
+		require.Equal(t, lastID.String(), newState.LastWithdrawID.String())
+		require.Empty(t, newState.WithdrawLimits)
+		require.Empty(t, newState.WithdrawUsages)
+	}
+
+	// Migrate
+	{
+		cdc := codec.New()
+		codec.RegisterCrypto(cdc)
+
+		oldStateBz, err := cdc.MarshalJSON(oldState)
+		require.NoError(t, err)
+
+		appState, err := Migrate(genutil.AppMap{
+			currencies.ModuleName: json.RawMessage(oldStateBz),
+		})
+		require.NoError(t, err)
+
+		var newState currencies.GenesisState
+		require.NoError(t, cdc.UnmarshalJSON(appState[currencies.ModuleName], &newState))
+		require.NoError(t, newState.Validate(time.Time{}))
+		require.Len(t, newState.Issues, len(oldState.Issues))
+		require.Len(t, newState.Withdraws, len(oldState.Withdraws))
+	}
+}
@@ -13,6 +13,7 @@ import (
 	v03910staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_39-1_0"
 
 	"github.com/dfinance/dnode/cmd/config/genesis/defaults"
+	"github.com/dfinance/dnode/x/currencies"
 )
 
 // Migrate migrates exported genesis state from Dfinance v0.7 Testnet to v1.0 Mainnet.
@@ -67,5 +68,26 @@ func Migrate(appState genutil.AppMap) (genutil.AppMap, error) {
 		}
 	}
 
+	// Dfinance modules
+	// currencies
+	{
+		moduleName := currencies.ModuleName
+		if stateOldBz := appState[moduleName]; stateOldBz != nil {
+			var oldState currenciesGenesisStateV07
+			if err := cdcOld.UnmarshalJSON(stateOldBz, &oldState); err != nil {
+				return nil, fmt.Errorf("module %q: oldState JSON unmarshal: %w", moduleName, err)
+			}
+
+			newState := migrateCurrencies(oldState)
+			stateNewBz, err := cdcNew.MarshalJSON(newState)
+			if err != nil {
+				return nil, fmt.Errorf("module %q: newState JSON marshal: %w", moduleName, err)
+			}
+
+			delete(appState, moduleName)
+			appState[moduleName] = stateNewBz
+		}
+	}
+
 	return appState, nil
 }
@@ -23,6 +23,27 @@ func EndBlocker(ctx sdk.Context, msKeeper keeper.Keeper, poaKeeper poa.Keeper) [
 		start = 0
 	}
 
+	// iterate over approved calls which execution delay has passed (over pending queue)
+	pendingIterator := msKeeper.GetPendingQueueIteratorTill(ctx, ctx.BlockHeight())
+	defer pendingIterator.Close()
+
+	for ; pendingIterator.Valid(); pendingIterator.Next() {
+		bz := pendingIterator.Value()
+
+		var callID dnTypes.ID
+		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &callID)
+
+		call, err := msKeeper.GetCall(ctx, callID)
+		if err != nil {
+			panic(fmt.Errorf("getting pending call %s: %v", callID.String(), err))
+		}
+		call.Pending = false
+
+		// execute call and remove from the pending queue
+		executeCall(ctx, msKeeper, call)
+		msKeeper.RemoveCallFromPendingQueue(ctx, callID, call.ExecutionHeight)
+	}
+
 	// iterate over active calls (over queue)
 	activeIterator := msKeeper.GetQueueIteratorStartEnd(ctx, start, ctx.BlockHeight())
 	defer activeIterator.Close()
@@ -33,53 +54,31 @@ func EndBlocker(ctx sdk.Context, msKeeper keeper.Keeper, poaKeeper poa.Keeper) [
 		var callID dnTypes.ID
 		ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &callID)
 
-		confirmations, err := msKeeper.GetConfirmationsCount(ctx, callID)
+		call, err := msKeeper.GetCall(ctx, callID)
 		if err != nil {
-			//if types.ErrWrongCallId.Is(err) {
-			//	continue
-			//}
+			panic(fmt.Errorf("getting active call %s: %v", callID.String(), err))
+		}
 
+		confirmedWeight, requiredWeight, err := msKeeper.GetCallApproval(ctx, call, poaKeeper)
+		if err != nil {
 			panic(fmt.Errorf("getting active call %s confirmations: %v", callID.String(), err))
 		}
 
-		// check if call is confirmed enough
-		if uint16(confirmations) >= poaKeeper.GetEnoughConfirmations(ctx) {
-			// call confirmed -> execute
-			call, err := msKeeper.GetCall(ctx, callID)
-			if err != nil {
-				panic(fmt.Errorf("getting active call %s: %v", call.ID.String(), err))
-			}
-			call.Approved = true
-			eventManager.EmitEvent(NewCallStateChangedEvent(callID, AttributeValueApproved))
-
-			handler := msKeeper.GetRouteHandler(call.Msg.Route())
-			if handler == nil {
-				panic(fmt.Errorf("handler for route %q: not found", call.Msg.Route()))
-			}
-
-			cacheCtx, writeCache := ctx.CacheContext()
-			if err := handler(cacheCtx, call.Msg); err != nil {
-				// call execution failed, update call status
-				call.Failed = true
-				call.Error = err.Error()
-
-				eventManager.EmitEvent(NewCallStateChangedEvent(callID, AttributeValueFailed))
-				logger.Info(fmt.Sprintf("Call %s execution failed, marking as failed: %v", callID.String(), err))
-			} else {
-				// call executed
-				call.Executed = true
-
-				eventManager.EmitEvents(cacheCtx.EventManager().Events())
-				writeCache()
-
-				eventManager.EmitEvent(NewCallStateChangedEvent(callID, AttributeValueExecuted))
-				logger.Info(fmt.Sprintf("Call %s executed, marking as executed", callID.String()))
-			}
+		// check if call is confirmed enough (weighted sum of confirmations reached the route threshold)
+		if confirmedWeight < requiredWeight {
+			continue
+		}
 
-			// update call and remove from the queue
-			msKeeper.StoreCall(ctx, call)
-			msKeeper.RemoveCallFromQueue(ctx, callID, call.Height)
+		// call confirmed and time-locked -> move to the pending queue
+		if threshold, found := msKeeper.GetCallThreshold(ctx, call); found && threshold.ExecutionDelay > 0 {
+			call = msKeeper.SetCallPending(ctx, call, threshold.ExecutionDelay)
+			logger.Info(fmt.Sprintf("Call %s approved, pending execution at %d", callID.String(), call.ExecutionHeight))
+			continue
 		}
+
+		// call confirmed -> execute and remove from the queue
+		executeCall(ctx, msKeeper, call)
+		msKeeper.RemoveCallFromQueue(ctx, callID, call.Height)
 	}
 
 	// iterate over calls that weren't confirmed after the max interval
@@ -97,6 +96,7 @@ func EndBlocker(ctx sdk.Context, msKeeper keeper.Keeper, poaKeeper poa.Keeper) [
 				panic(fmt.Errorf("getting rejected call %q: %v", call.ID.String(), err))
 			}
 			call.Rejected = true
+			call.Finish(CallReasonExpired, ctx.BlockHeight())
 
 			// update call and remove from the queue
 			msKeeper.StoreCall(ctx, call)
@@ -113,3 +113,56 @@ func EndBlocker(ctx sdk.Context, msKeeper keeper.Keeper, poaKeeper poa.Keeper) [
 
 	return []abci.ValidatorUpdate{}
 }
+
+// executeCall executes an approved call message handler and updates the call state.
+func executeCall(ctx sdk.Context, msKeeper keeper.Keeper, call Call) {
+	logger := msKeeper.GetLogger(ctx)
+	eventManager := ctx.EventManager()
+
+	call.Approved = true
+	eventManager.EmitEvent(NewCallStateChangedEvent(call.ID, AttributeValueApproved))
+
+	// execute call messages sequentially within one context (batch call changes are committed only if all succeeded)
+	cacheCtx, writeCache := ctx.CacheContext()
+	call.Results = make(CallMsgResults, 0, len(call.BatchMsgs)+1)
+
+	var execErr error
+	for i, msg := range call.Msgs() {
+		handler := msKeeper.GetRouteHandler(msg.Route())
+		if handler == nil {
+			panic(fmt.Errorf("handler for route %q: not found", msg.Route()))
+		}
+
+		err := handler(cacheCtx, msg)
+		call.Results = append(call.Results, NewCallMsgResult(msg, err))
+		if err != nil {
+			execErr = err
+			if call.IsBatch() {
+				execErr = fmt.Errorf("batch msg[%d]: %w", i, err)
+			}
+			break
+		}
+	}
+
+	if err := execErr; err != nil {
+		// call execution failed, update call status
+		call.Failed = true
+		call.Error = err.Error()
+		call.Finish(CallReasonFailed, ctx.BlockHeight())
+
+		eventManager.EmitEvent(NewCallStateChangedEvent(call.ID, AttributeValueFailed))
+		logger.Info(fmt.Sprintf("Call %s execution failed, marking as failed: %v", call.ID.String(), err))
+	} else {
+		// call executed
+		call.Executed = true
+		call.Finish(CallReasonExecuted, ctx.BlockHeight())
+
+		eventManager.EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+
+		eventManager.EmitEvent(NewCallStateChangedEvent(call.ID, AttributeValueExecuted))
+		logger.Info(fmt.Sprintf("Call %s executed, marking as executed", call.ID.String()))
+	}
+
+	msKeeper.StoreCall(ctx, call)
+}
@@ -6,15 +6,24 @@ import (
 )
 
 type (
-	Keeper       = keeper.Keeper
-	GenesisState = types.GenesisState
-	Params       = types.Params
+	Keeper        = keeper.Keeper
+	SquashOptions = keeper.SquashOptions
+	GenesisState  = types.GenesisState
+	Params        = types.Params
 	//
-	Call  = types.Call
-	Votes = types.Votes
+	CallThreshold  = types.CallThreshold
+	CallThresholds = types.CallThresholds
+	//
+	Call               = types.Call
+	ArchivedCall       = types.ArchivedCall
+	CallMsgResult      = types.CallMsgResult
+	CallMsgResults     = types.CallMsgResults
+	CallTerminalReason = types.CallTerminalReason
+	Votes              = types.Votes
 	//
 	CallReq           = types.CallReq
 	CallByUniqueIdReq = types.CallByUniqueIdReq
+	CallsHistoryReq   = types.CallsHistoryReq
 	CallsResp         = types.CallsResp
 	CallResp          = types.CallResp
 	LastCallIdResp    = types.LastCallIdResp
@@ -25,11 +34,14 @@ const (
 	StoreKey          = types.StoreKey
 	DefaultParamspace = types.DefaultParamspace
 	RouterKey         = types.RouterKey
+	MaxBatchMsgs      = types.MaxBatchMsgs
 	//
 	QueryCalls        = types.QueryCalls
 	QueryCall         = types.QueryCall
 	QueryCallByUnique = types.QueryCallByUnique
 	QueryLastId       = types.QueryLastId
+	QueryParams       = types.QueryParams
+	QueryHistory      = types.QueryHistory
 	// Event types, attribute types and values
 	EventTypeSubmitCall  = types.EventTypeSubmitCall
 	EventTypeRemoveCall  = types.EventTypeRemoveCall
@@ -48,6 +60,17 @@ const (
 	AttributeValueRejected = types.AttributeValueRejected
 	AttributeValueFailed   = types.AttributeValueFailed
 	AttributeValueExecuted = types.AttributeValueExecuted
+	AttributeValuePending  = types.AttributeValuePending
+	AttributeValueVetoed   = types.AttributeValueVetoed
+	//
+	CallReasonExecuted   = types.CallReasonExecuted
+	CallReasonFailed     = types.CallReasonFailed
+	CallReasonExpired    = types.CallReasonExpired
+	CallReasonRevokedAll = types.CallReasonRevokedAll
+	CallReasonVetoed     = types.CallReasonVetoed
+	CallStateActive      = types.CallStateActive
+	CallStatePending     = types.CallStatePending
+	CallStateRejected    = types.CallStateRejected
 )
 
 var (
@@ -55,16 +78,21 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	// function aliases
-	RegisterCodec       = types.RegisterCodec
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	DefaultGenesisState = types.DefaultGenesisState
+	RegisterCodec         = types.RegisterCodec
+	NewKeeper             = keeper.NewKeeper
+	NewQuerier            = keeper.NewQuerier
+	NewEmptySquashOptions = keeper.NewEmptySquashOptions
+	DefaultGenesisState   = types.DefaultGenesisState
+	NewParams             = types.NewParams
+	DefaultRequiredWeight = types.DefaultRequiredWeight
+	NewCallsHistoryFilter = types.NewCallsHistoryFilter
 	//
 	NewMsgSubmitCall    = types.NewMsgSubmitCall
 	NewMsgConfirmCall   = types.NewMsgConfirmCall
 	NewMsgRevokeConfirm = types.NewMsgRevokeConfirm
 	//
 	NewCallStateChangedEvent = types.NewCallStateChangedEvent
+	NewCallMsgResult         = types.NewCallMsgResult
 	// errors
 	ErrInternal             = types.ErrInternal
 	ErrWrongCallId          = types.ErrWrongCallId
@@ -77,5 +105,6 @@ var (
 	ErrVoteAlreadyRejected  = types.ErrVoteAlreadyRejected
 	ErrVoteNoVotes          = types.ErrVoteNoVotes
 	ErrVoteNotApproved      = types.ErrVoteNotApproved
+	ErrVotePendingExecution = types.ErrVotePendingExecution
 	ErrPoaNotValidator      = types.ErrPoaNotValidator
 )
@@ -4,18 +4,29 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/multisig/internal/types"
 )
 
+const (
+	flagHistoryCreator     = "creator"
+	flagHistoryMsgRoute    = "msg-route"
+	flagHistoryMsgType     = "msg-type"
+	flagHistoryState       = "state"
+	flagHistoryStartHeight = "start-height"
+	flagHistoryEndHeight   = "end-height"
+)
+
 // GetCalls returns query command that return calls.
 func GetCalls(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "calls",
-		Short: "Get active calls to confirm",
+		Short: "Get active calls to confirm and approved calls pending execution",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -132,3 +143,98 @@ func GetLastId(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetParams returns query command that return module params.
+func GetParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get multisig module params (including call approval thresholds)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetHistory returns query command that return calls history (including archived calls) with filters and pagination.
+func GetHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "Get calls history with filters by limit and page",
+		Example: "history --page=1 --limit=10 --msg-route=currencies --state=expired --start-height=100",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			pageStr, limitStr := viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit)
+			page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			req := types.CallsHistoryReq{
+				Page:     page,
+				Limit:    limit,
+				MsgRoute: viper.GetString(flagHistoryMsgRoute),
+				MsgType:  viper.GetString(flagHistoryMsgType),
+				State:    viper.GetString(flagHistoryState),
+			}
+
+			if creatorStr := viper.GetString(flagHistoryCreator); creatorStr != "" {
+				req.Creator, err = helpers.ParseSdkAddressParam(flagHistoryCreator, creatorStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+			}
+			if startHeightStr := viper.GetString(flagHistoryStartHeight); startHeightStr != "" {
+				req.StartHeight, err = helpers.ParseInt64Param(flagHistoryStartHeight, startHeightStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+			}
+			if endHeightStr := viper.GetString(flagHistoryEndHeight); endHeightStr != "" {
+				req.EndHeight, err = helpers.ParseInt64Param(flagHistoryEndHeight, endHeightStr, helpers.ParamTypeCliFlag)
+				if err != nil {
+					return err
+				}
+			}
+
+			// prepare request
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHistory), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.CallsResp
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagHistoryCreator, "", "(optional) filter by call creator address")
+	cmd.Flags().String(flagHistoryMsgRoute, "", "(optional) filter by call message route")
+	cmd.Flags().String(flagHistoryMsgType, "", "(optional) filter by call message type")
+	cmd.Flags().String(flagHistoryState, "", "(optional) filter by call state (active / pending / executed / failed / expired / revoked_all / vetoed / rejected)")
+	cmd.Flags().String(flagHistoryStartHeight, "", "(optional) filter by call submit block height range start")
+	cmd.Flags().String(flagHistoryEndHeight, "", "(optional) filter by call submit block height range end")
+
+	return cmd
+}
@@ -21,6 +21,8 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 		cli.GetCall(types.ModuleName, cdc),
 		cli.GetCalls(types.ModuleName, cdc),
 		cli.GetCallByUniqueID(types.ModuleName, cdc),
+		cli.GetParams(types.ModuleName, cdc),
+		cli.GetHistory(types.ModuleName, cdc),
 	)...)
 
 	return queryCmd
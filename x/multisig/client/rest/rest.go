@@ -17,6 +17,13 @@ import (
 const (
 	CallID   = "callID"
 	UniqueID = "uniqueID"
+	//
+	HistoryCreator     = "creator"
+	HistoryMsgRoute    = "msgRoute"
+	HistoryMsgType     = "msgType"
+	HistoryState       = "state"
+	HistoryStartHeight = "startHeight"
+	HistoryEndHeight   = "endHeight"
 )
 
 type ConfirmReq struct {
@@ -36,6 +43,8 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/call/{%s}", types.ModuleName, CallID), getCall(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/calls", types.ModuleName), getCalls(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/unique/{%s}", types.ModuleName, UniqueID), getCallByUnique(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", types.ModuleName), getParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/history", types.ModuleName), getHistory(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/confirm", types.ModuleName), confirm(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/revoke", types.ModuleName), revoke(cliCtx)).Methods("PUT")
 }
@@ -43,7 +52,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 // GetCalls godoc
 // @Tags Multisig
 // @Summary Get active calls
-// @Description Get active call objects
+// @Description Get active and pending execution (approved, time-locked) call objects
 // @ID multisigGetCalls
 // @Accept  json
 // @Produce json
@@ -63,6 +72,107 @@ func getCalls(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// GetParams godoc
+// @Tags Multisig
+// @Summary Get params
+// @Description Get multisig module params (including call approval thresholds)
+// @ID multisigGetParams
+// @Accept  json
+// @Produce json
+// @Success 200 {object} MSRespGetParams
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /multisig/params [get]
+func getParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetHistory godoc
+// @Tags Multisig
+// @Summary Get calls history
+// @Description Get call objects history (including archived calls) with pagination and filters
+// @ID multisigGetHistory
+// @Accept  json
+// @Produce json
+// @Param page query int false "page number (first page: 1)"
+// @Param limit query int false "items per page (default: 100)"
+// @Param creator query string false "call creator address filter"
+// @Param msgRoute query string false "call message route filter"
+// @Param msgType query string false "call message type filter"
+// @Param state query string false "call state filter (active / pending / executed / failed / expired / revoked_all / vetoed / rejected)"
+// @Param startHeight query int false "call submit block height range start filter"
+// @Param endHeight query int false "call submit block height range end filter"
+// @Success 200 {object} MSRespGetCalls
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /multisig/history [get]
+func getHistory(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		pageStr := r.URL.Query().Get("page")
+		limitStr := r.URL.Query().Get("limit")
+		page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req := types.CallsHistoryReq{
+			Page:     page,
+			Limit:    limit,
+			MsgRoute: r.URL.Query().Get(HistoryMsgRoute),
+			MsgType:  r.URL.Query().Get(HistoryMsgType),
+			State:    r.URL.Query().Get(HistoryState),
+		}
+
+		if creatorStr := r.URL.Query().Get(HistoryCreator); creatorStr != "" {
+			req.Creator, err = helpers.ParseSdkAddressParam(HistoryCreator, creatorStr, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if startHeightStr := r.URL.Query().Get(HistoryStartHeight); startHeightStr != "" {
+			req.StartHeight, err = helpers.ParseInt64Param(HistoryStartHeight, startHeightStr, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if endHeightStr := r.URL.Query().Get(HistoryEndHeight); endHeightStr != "" {
+			req.EndHeight, err = helpers.ParseInt64Param(HistoryEndHeight, endHeightStr, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		// prepare request
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryHistory), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // GetCall godoc
 // @Tags Multisig
 // @Summary Get call
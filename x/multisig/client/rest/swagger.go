@@ -18,6 +18,11 @@ type (
 		Result types.CallsResp `json:"result"`
 	}
 
+	MSRespGetParams struct {
+		Height int64        `json:"height"`
+		Result types.Params `json:"result"`
+	}
+
 	CCRespStdTx struct {
 		Height int64      `json:"height"`
 		Result auth.StdTx `json:"result"`
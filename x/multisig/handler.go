@@ -34,7 +34,7 @@ func handleMsgSubmitCall(ctx sdk.Context, msKeeper keeper.Keeper, poaKeeper poa.
 		return nil, sdkErrors.Wrap(types.ErrPoaNotValidator, msg.Creator.String())
 	}
 
-	if err := msKeeper.SubmitCall(ctx, msg.Msg, msg.UniqueID, msg.Creator); err != nil {
+	if err := msKeeper.SubmitCall(ctx, msg.Msg, msg.UniqueID, msg.Creator, msg.BatchMsgs...); err != nil {
 		return nil, err
 	}
 
@@ -10,7 +10,8 @@ import (
 )
 
 // SubmitCall creates a new call to be executed on validators by confirmation.
-func (k Keeper) SubmitCall(ctx sdk.Context, msg msmodule.MsMsg, uniqueID string, sender sdk.AccAddress) error {
+// Optional {batchMsgs} are executed atomically after the main {msg} (batch call).
+func (k Keeper) SubmitCall(ctx sdk.Context, msg msmodule.MsMsg, uniqueID string, sender sdk.AccAddress, batchMsgs ...msmodule.MsMsg) error {
 	k.modulePerms.AutoCheck(types.PermWrite)
 
 	// confirm call if exists
@@ -19,26 +20,34 @@ func (k Keeper) SubmitCall(ctx sdk.Context, msg msmodule.MsMsg, uniqueID string,
 		if err != nil {
 			return err
 		}
+		if k.hasArchivedCall(ctx, id) {
+			return sdkErrors.Wrapf(types.ErrWrongCallUniqueId, "%q used by archived call %s", uniqueID, id.String())
+		}
 		return k.ConfirmCall(ctx, id, sender)
 	}
 
 	// create a new call and check its validity
-	call, err := types.NewCall(k.nextCallID(ctx), uniqueID, msg, ctx.BlockHeight(), sender)
+	call, err := types.NewCall(k.nextCallID(ctx), uniqueID, msg, ctx.BlockHeight(), sender, batchMsgs...)
 	if err != nil {
 		return err
 	}
 
-	// check msg route and dry-run handler
-	if !k.router.HasRoute(msg.Route()) {
-		return sdkErrors.Wrapf(types.ErrWrongMsgRoute, "%q not found", msg.Route())
-	}
-
+	// check msg routes and dry-run handlers (batch messages are handled sequentially within one context)
 	// TODO: solve do we need it at all during mainnet, probably we don't.
 	cacheCtx, _ := ctx.CacheContext()
 	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
-	handler := k.router.GetRoute(msg.Route())
-	if err := handler(cacheCtx, msg); err != nil {
-		return err
+	for i, callMsg := range call.Msgs() {
+		if !k.router.HasRoute(callMsg.Route()) {
+			return sdkErrors.Wrapf(types.ErrWrongMsgRoute, "msg[%d]: %q not found", i, callMsg.Route())
+		}
+
+		handler := k.router.GetRoute(callMsg.Route())
+		if err := handler(cacheCtx, callMsg); err != nil {
+			if call.IsBatch() {
+				return sdkErrors.Wrapf(err, "batch msg[%d]", i)
+			}
+			return err
+		}
 	}
 
 	// create call and confirm it by its creator
@@ -121,6 +130,24 @@ func (k Keeper) StoreCall(ctx sdk.Context, call types.Call) {
 	store.Set(types.GetCallKey(call.ID), k.cdc.MustMarshalBinaryBare(call))
 }
 
+// SetCallPending moves an approved call from the queue to the pending execution queue (time-locks the call).
+// Call is executed after {executionDelay} blocks if it is not vetoed.
+func (k Keeper) SetCallPending(ctx sdk.Context, call types.Call, executionDelay uint32) types.Call {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	call.Pending = true
+	call.ExecutionHeight = ctx.BlockHeight() + int64(executionDelay)
+	k.StoreCall(ctx, call)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueueKey(call.ID, call.Height))
+	k.addCallToPendingQueue(ctx, call.ID, call.ExecutionHeight)
+
+	ctx.EventManager().EmitEvent(types.NewCallStateChangedEvent(call.ID, types.AttributeValuePending))
+
+	return call
+}
+
 // getCall returns call from the storage.
 func (k Keeper) getCall(ctx sdk.Context, id dnTypes.ID) types.Call {
 	store := ctx.KVStore(k.storeKey)
@@ -149,10 +176,11 @@ func (k Keeper) getCalls(ctx sdk.Context) []types.Call {
 	return calls
 }
 
-// createCall updates lastCallId, sets uniqueID-callID match, stores a new call and adds call to the queue.
+// createCall updates lastCallId, sets uniqueID-callID match, stores a new call and adds call to the queue and the history index.
 func (k Keeper) createCall(ctx sdk.Context, call types.Call) {
 	k.setLastCallID(ctx, call.ID)
 	k.setCallUniqueIDMatch(ctx, call.UniqueID, call.ID)
+	k.addCallToHistoryIndex(ctx, call.ID)
 	k.StoreCall(ctx, call)
 	k.addCallToQueue(ctx, call.ID, call.Height)
 
@@ -23,29 +23,51 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 
 	// params
 	k.SetIntervalToExecute(ctx, state.Parameters.IntervalToExecute)
+	k.SetThresholds(ctx, state.Parameters.Thresholds)
 
 	// last callID
 	if state.LastCallID != nil {
 		k.setLastCallID(ctx, *state.LastCallID)
 	}
 
-	// calls, uniqueID-callID matches and votes per call
+	// calls, uniqueID-callID matches, history index entries and votes per call
 	for i, callItem := range state.CallItems {
-		if !k.router.HasRoute(callItem.Call.Msg.Route()) {
-			panic(fmt.Errorf("call[%d]: route %q not registered in keepers router", i, callItem.Call.Msg.Route()))
+		for _, msg := range callItem.Call.Msgs() {
+			if !k.router.HasRoute(msg.Route()) {
+				panic(fmt.Errorf("call[%d]: route %q not registered in keepers router", i, msg.Route()))
+			}
 		}
 
 		k.setCallUniqueIDMatch(ctx, callItem.Call.UniqueID, callItem.Call.ID)
+		k.addCallToHistoryIndex(ctx, callItem.Call.ID)
 		k.StoreCall(ctx, callItem.Call)
 		if len(callItem.Votes) > 0 {
 			k.setVotes(ctx, callItem.Call.ID, callItem.Votes)
 		}
 	}
 
+	// archived calls with uniqueID-callID matches and history index entries
+	for i, archivedCall := range state.ArchivedCalls {
+		for _, msg := range archivedCall.Call.Msgs() {
+			if !k.router.HasRoute(msg.Route()) {
+				panic(fmt.Errorf("archived_call[%d]: route %q not registered in keepers router", i, msg.Route()))
+			}
+		}
+
+		k.setCallUniqueIDMatch(ctx, archivedCall.Call.UniqueID, archivedCall.Call.ID)
+		k.addCallToHistoryIndex(ctx, archivedCall.Call.ID)
+		k.setArchivedCall(ctx, archivedCall)
+	}
+
 	// queue
 	for _, queueItem := range state.QueueItems {
 		k.addCallToQueue(ctx, queueItem.CallID, queueItem.BlockHeight)
 	}
+
+	// pending execution queue
+	for _, queueItem := range state.PendingQueueItems {
+		k.addCallToPendingQueue(ctx, queueItem.CallID, queueItem.BlockHeight)
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
@@ -54,12 +76,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 	// params and lastCallID
 	state := types.GenesisState{
-		Parameters: types.Params{
-			IntervalToExecute: k.GetIntervalToExecute(ctx),
-		},
-		LastCallID: k.getLastCallID(ctx),
-		CallItems:  make([]types.GenesisCallItem, 0),
-		QueueItems: make([]types.GenesisQueueItem, 0),
+		Parameters:        k.GetParams(ctx),
+		LastCallID:        k.getLastCallID(ctx),
+		CallItems:         make([]types.GenesisCallItem, 0),
+		QueueItems:        make([]types.GenesisQueueItem, 0),
+		PendingQueueItems: make([]types.GenesisQueueItem, 0),
+		ArchivedCalls:     k.getArchivedCalls(ctx),
 	}
 
 	// calls with votes
@@ -83,6 +105,18 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 		})
 	}
 
+	// pending execution queue
+	pendingQueueIterator := k.GetPendingQueueIterator(ctx)
+	defer pendingQueueIterator.Close()
+
+	for ; pendingQueueIterator.Valid(); pendingQueueIterator.Next() {
+		callID, executionHeight := types.MustParsePendingQueueKey(pendingQueueIterator.Key())
+		state.PendingQueueItems = append(state.PendingQueueItems, types.GenesisQueueItem{
+			CallID:      callID,
+			BlockHeight: executionHeight,
+		})
+	}
+
 	return k.cdc.MustMarshalJSON(state)
 }
 
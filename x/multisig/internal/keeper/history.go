@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/multisig/internal/types"
+)
+
+// GetCallsHistory returns filtered and paginated calls (live and archived) sorted by ID (newest first).
+// History index is iterated in the reverse order, so only the requested page calls are kept in memory.
+func (k Keeper) GetCallsHistory(ctx sdk.Context, params types.CallsHistoryReq) (types.CallsResp, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	maxPaginationValue := sdk.NewUint(math.MaxUint64)
+	if params.Page.IsZero() || params.Page.GT(maxPaginationValue) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "pagination: page: must be in [1, %s] range", maxPaginationValue)
+	}
+	if params.Limit.IsZero() || params.Limit.GT(maxPaginationValue) {
+		return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "pagination: limit: must be in [1, %s] range", maxPaginationValue)
+	}
+	skipCnt, takeCnt := params.Page.SubUint64(1).Mul(params.Limit), params.Limit.Uint64()
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetHistoryIndexKeyPrefix())
+	defer iterator.Close()
+
+	resps := make(types.CallsResp, 0)
+	for ; iterator.Valid() && uint64(len(resps)) < takeCnt; iterator.Next() {
+		var callID dnTypes.ID
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &callID); err != nil {
+			return nil, sdkErrors.Wrapf(types.ErrInternal, "callID unmarshal: %v", err)
+		}
+
+		resp, err := k.getHistoryItem(ctx, callID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !params.Match(resp.Call) {
+			continue
+		}
+
+		if !skipCnt.IsZero() {
+			skipCnt = skipCnt.Decr()
+			continue
+		}
+
+		resps = append(resps, resp)
+	}
+
+	return resps, nil
+}
+
+// getHistoryItem returns live or archived call with its votes.
+func (k Keeper) getHistoryItem(ctx sdk.Context, id dnTypes.ID) (types.CallResp, error) {
+	if k.hasArchivedCall(ctx, id) {
+		archivedCall, err := k.GetArchivedCall(ctx, id)
+		if err != nil {
+			return types.CallResp{}, err
+		}
+
+		return types.CallResp{
+			Call:     archivedCall.Call,
+			Votes:    archivedCall.Votes,
+			Archived: true,
+		}, nil
+	}
+
+	call, err := k.GetCall(ctx, id)
+	if err != nil {
+		return types.CallResp{}, err
+	}
+
+	votes, err := k.GetVotes(ctx, id)
+	if err != nil {
+		return types.CallResp{}, err
+	}
+
+	return types.CallResp{
+		Call:  call,
+		Votes: votes,
+	}, nil
+}
+
+// GetArchivedCall returns archived call with its votes.
+func (k Keeper) GetArchivedCall(ctx sdk.Context, id dnTypes.ID) (types.ArchivedCall, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.hasArchivedCall(ctx, id) {
+		return types.ArchivedCall{}, sdkErrors.Wrapf(types.ErrWrongCallId, "archived %s not found", id.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var archivedCall types.ArchivedCall
+	k.cdc.MustUnmarshalBinaryBare(store.Get(types.GetArchivedCallKey(id)), &archivedCall)
+
+	return archivedCall, nil
+}
+
+// hasArchivedCall checks that archived call exists.
+func (k Keeper) hasArchivedCall(ctx sdk.Context, id dnTypes.ID) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetArchivedCallKey(id))
+}
+
+// getArchivedCalls returns all archived call objects.
+func (k Keeper) getArchivedCalls(ctx sdk.Context) []types.ArchivedCall {
+	archivedCalls := make([]types.ArchivedCall, 0)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetArchivedCallKeyPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var archivedCall types.ArchivedCall
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &archivedCall)
+		archivedCalls = append(archivedCalls, archivedCall)
+	}
+
+	return archivedCalls
+}
+
+// archiveCall moves finished call with its votes to the archive (uniqueID-callID match is kept).
+func (k Keeper) archiveCall(ctx sdk.Context, call types.Call) {
+	votes, _ := k.GetVotes(ctx, call.ID)
+	k.setArchivedCall(ctx, types.ArchivedCall{
+		Call:  call,
+		Votes: votes,
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCallKey(call.ID))
+	k.removeVotes(ctx, call.ID)
+}
+
+// addCallToHistoryIndex adds live / archived call to the calls history index.
+func (k Keeper) addCallToHistoryIndex(ctx sdk.Context, id dnTypes.ID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHistoryIndexKey(id), k.cdc.MustMarshalBinaryLengthPrefixed(id))
+}
+
+// setArchivedCall sets archived call object.
+func (k Keeper) setArchivedCall(ctx sdk.Context, archivedCall types.ArchivedCall) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetArchivedCallKey(archivedCall.Call.ID), k.cdc.MustMarshalBinaryBare(archivedCall))
+}
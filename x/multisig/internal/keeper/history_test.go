@@ -0,0 +1,256 @@
+// +build unit
+
+package keeper
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/multisig/internal/types"
+)
+
+// Check calls history filters and pagination.
+func TestMSKeeper_GetCallsHistory(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	addr1, addr2 := sdk.AccAddress("addr1"), sdk.AccAddress("addr2")
+
+	// create calls: 0, 1 by addr1 at height 1; 2 by addr2 at height 2
+	{
+		ctx = ctx.WithBlockHeight(1)
+		require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique0", addr1))
+		require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique1", addr1))
+
+		ctx = ctx.WithBlockHeight(2)
+		require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique2", addr2))
+	}
+
+	// revoke call 1 (all votes revoked)
+	{
+		require.NoError(t, keeper.RevokeConfirmation(ctx, dnTypes.NewIDFromUint64(1), addr1))
+
+		call, err := keeper.GetCall(ctx, dnTypes.NewIDFromUint64(1))
+		require.NoError(t, err)
+		require.True(t, call.Rejected)
+		require.Equal(t, types.CallReasonRevokedAll, call.TerminalReason)
+		require.EqualValues(t, 2, call.TerminalHeight)
+	}
+
+	checkIDs := func(req types.CallsHistoryReq, expIDs ...uint64) {
+		resps, err := keeper.GetCallsHistory(ctx, req)
+		require.NoError(t, err)
+
+		ids := make([]uint64, 0, len(resps))
+		for _, resp := range resps {
+			ids = append(ids, resp.Call.ID.UInt64())
+		}
+		require.ElementsMatch(t, expIDs, ids)
+	}
+
+	// no filters (sorted newest first)
+	{
+		resps, err := keeper.GetCallsHistory(ctx, types.NewCallsHistoryFilter(1, 10))
+		require.NoError(t, err)
+		require.Len(t, resps, 3)
+		require.EqualValues(t, 2, resps[0].Call.ID.UInt64())
+		require.EqualValues(t, 0, resps[2].Call.ID.UInt64())
+	}
+
+	// pagination
+	{
+		checkIDs(types.NewCallsHistoryFilter(1, 2), 2, 1)
+		checkIDs(types.NewCallsHistoryFilter(2, 2), 0)
+		checkIDs(types.NewCallsHistoryFilter(3, 2))
+	}
+
+	// creator filter
+	{
+		req := types.NewCallsHistoryFilter(1, 10)
+		req.Creator = addr1
+		checkIDs(req, 0, 1)
+	}
+
+	// state filter
+	{
+		req := types.NewCallsHistoryFilter(1, 10)
+		req.State = string(types.CallReasonRevokedAll)
+		checkIDs(req, 1)
+
+		req.State = types.CallStateActive
+		checkIDs(req, 0, 2)
+	}
+
+	// route / type filters
+	{
+		req := types.NewCallsHistoryFilter(1, 10)
+		req.MsgRoute, req.MsgType = MockMsgRouteOk, MockMsgType
+		checkIDs(req, 0, 1, 2)
+
+		req.MsgType = "unknown"
+		checkIDs(req)
+	}
+
+	// height range filter
+	{
+		req := types.NewCallsHistoryFilter(1, 10)
+		req.StartHeight = 2
+		checkIDs(req, 2)
+
+		req.StartHeight, req.EndHeight = 1, 1
+		checkIDs(req, 0, 1)
+	}
+
+	// pagination with filters
+	{
+		req := types.NewCallsHistoryFilter(2, 1)
+		req.Creator = addr1
+		checkIDs(req, 0)
+	}
+
+	// pagination: max values
+	{
+		checkIDs(types.NewCallsHistoryFilter(math.MaxUint64, math.MaxUint64))
+	}
+
+	// fail: invalid pagination
+	{
+		_, err := keeper.GetCallsHistory(ctx, types.NewCallsHistoryFilter(0, 10))
+		require.Error(t, err)
+
+		_, err = keeper.GetCallsHistory(ctx, types.NewCallsHistoryFilter(1, 0))
+		require.Error(t, err)
+
+		req := types.NewCallsHistoryFilter(1, 10)
+		req.Page = sdk.NewUint(math.MaxUint64).Incr()
+		_, err = keeper.GetCallsHistory(ctx, req)
+		require.Error(t, err)
+	}
+}
+
+// Check zero-height squashing with finished calls archive.
+func TestMSKeeper_PrepareForZeroHeight_Archive(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx, cdc := input.target, input.ctx, input.cdc
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	activeCallID, finishedCallID := dnTypes.NewIDFromUint64(0), dnTypes.NewIDFromUint64(1)
+
+	// init params, create calls, finish one of them
+	{
+		keeper.InitDefaultGenesis(ctx)
+
+		ctx = ctx.WithBlockHeight(5)
+		require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique0", addr))
+		require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique1", addr))
+
+		ctx = ctx.WithBlockHeight(6)
+		require.NoError(t, keeper.RevokeConfirmation(ctx, finishedCallID, addr))
+	}
+
+	// squash
+	{
+		opts := NewEmptySquashOptions()
+		require.NoError(t, opts.SetArchiveOperation(true))
+		require.NoError(t, keeper.PrepareForZeroHeight(ctx, opts))
+	}
+
+	// check active call is squashed
+	{
+		call, err := keeper.GetCall(ctx, activeCallID)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, call.Height)
+	}
+
+	// check finished call is archived with original heights
+	{
+		require.False(t, keeper.HasCall(ctx, finishedCallID))
+
+		archivedCall, err := keeper.GetArchivedCall(ctx, finishedCallID)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, archivedCall.Call.Height)
+		require.EqualValues(t, 6, archivedCall.Call.TerminalHeight)
+		require.Equal(t, types.CallReasonRevokedAll, archivedCall.Call.TerminalReason)
+	}
+
+	// check queries
+	{
+		res, err := queryGetUnique(keeper, ctx, abci.RequestQuery{Data: cdc.MustMarshalJSON(types.CallByUniqueIdReq{UniqueID: "unique1"})})
+		require.NoError(t, err)
+
+		var resp types.CallResp
+		cdc.MustUnmarshalJSON(res, &resp)
+		require.True(t, resp.Archived)
+		require.EqualValues(t, finishedCallID.UInt64(), resp.Call.ID.UInt64())
+
+		resps, err := keeper.GetCallsHistory(ctx, types.NewCallsHistoryFilter(1, 10))
+		require.NoError(t, err)
+		require.Len(t, resps, 2)
+		require.True(t, resps[0].Archived)
+		require.False(t, resps[1].Archived)
+	}
+
+	// fail: archived call uniqueID reuse
+	{
+		require.Error(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique1", sdk.AccAddress("addr2")))
+	}
+
+	// check genesis export / import
+	{
+		state := types.GenesisState{}
+		cdc.MustUnmarshalJSON(keeper.ExportGenesis(ctx), &state)
+		require.Len(t, state.CallItems, 1)
+		require.Len(t, state.ArchivedCalls, 1)
+
+		newInput := NewTestInput(t)
+		newInput.target.InitGenesis(newInput.ctx, cdc.MustMarshalJSON(state))
+
+		archivedCall, err := newInput.target.GetArchivedCall(newInput.ctx, finishedCallID)
+		require.NoError(t, err)
+		require.Equal(t, "unique1", archivedCall.Call.UniqueID)
+		require.Equal(t, dnTypes.NewIDFromUint64(1).UInt64(), newInput.target.GetLastCallID(newInput.ctx).UInt64())
+
+		// history index is restored
+		resps, err := newInput.target.GetCallsHistory(newInput.ctx, types.NewCallsHistoryFilter(1, 10))
+		require.NoError(t, err)
+		require.Len(t, resps, 2)
+		require.EqualValues(t, finishedCallID.UInt64(), resps[0].Call.ID.UInt64())
+		require.True(t, resps[0].Archived)
+		require.EqualValues(t, activeCallID.UInt64(), resps[1].Call.ID.UInt64())
+		require.False(t, resps[1].Archived)
+	}
+}
+
+// Check zero-height squashing without archive.
+func TestMSKeeper_PrepareForZeroHeight_NoArchive(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	addr := sdk.AccAddress("addr1")
+	callID := dnTypes.NewIDFromUint64(0)
+
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "unique0", addr))
+	require.NoError(t, keeper.RevokeConfirmation(ctx, callID, addr))
+
+	require.NoError(t, keeper.PrepareForZeroHeight(ctx, NewEmptySquashOptions()))
+
+	call, err := keeper.GetCall(ctx, callID)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, call.Height)
+	require.EqualValues(t, 0, call.TerminalHeight)
+	require.Equal(t, types.CallReasonRevokedAll, call.TerminalReason)
+	require.Empty(t, keeper.getArchivedCalls(ctx))
+}
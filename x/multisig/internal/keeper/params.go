@@ -21,3 +21,26 @@ func (k Keeper) SetIntervalToExecute(ctx sdk.Context, value int64) {
 
 	k.paramStore.Set(ctx, types.ParamStoreKeyIntervalToExecute, value)
 }
+
+// GetThresholds returns call approval thresholds param.
+func (k Keeper) GetThresholds(ctx sdk.Context) (res types.CallThresholds) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	k.paramStore.GetIfExists(ctx, types.ParamStoreKeyThresholds, &res)
+
+	return
+}
+
+// SetThresholds updates call approval thresholds param.
+func (k Keeper) SetThresholds(ctx sdk.Context, value types.CallThresholds) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	k.paramStore.Set(ctx, types.ParamStoreKeyThresholds, value)
+}
+
+// GetParams returns keeper params.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	return types.NewParams(k.GetIntervalToExecute(ctx), k.GetThresholds(ctx))
+}
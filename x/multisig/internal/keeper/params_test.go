@@ -5,7 +5,10 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/multisig/internal/types"
 )
 
 // Test params set / get.
@@ -17,4 +20,13 @@ func TestMSKeeper_Params(t *testing.T) {
 
 	keeper.SetIntervalToExecute(ctx, 123456)
 	require.EqualValues(t, 123456, keeper.GetIntervalToExecute(ctx))
+
+	require.Empty(t, keeper.GetThresholds(ctx))
+	thresholds := types.CallThresholds{
+		{MsgRoute: "route", MsgType: "type", Share: sdk.NewDecWithPrec(67, 2)},
+	}
+	keeper.SetThresholds(ctx, thresholds)
+
+	params := keeper.GetParams(ctx)
+	require.True(t, params.Equal(types.NewParams(123456, thresholds)))
 }
@@ -22,13 +22,17 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetUnique(k, ctx, req)
 		case types.QueryLastId:
 			return queryGetLastID(k, ctx)
+		case types.QueryParams:
+			return queryGetParams(k, ctx)
+		case types.QueryHistory:
+			return queryGetHistory(k, ctx, req)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
 	}
 }
 
-// queryGetCalls handles getCalls query which returns call objects.
+// queryGetCalls handles getCalls query which returns call objects (active and pending execution).
 func queryGetCalls(k Keeper, ctx sdk.Context) ([]byte, error) {
 	resps := types.CallsResp{}
 
@@ -38,31 +42,41 @@ func queryGetCalls(k Keeper, ctx sdk.Context) ([]byte, error) {
 		start = 0
 	}
 
-	iterator := k.GetQueueIteratorStartEnd(ctx, start, ctx.BlockHeight())
-	defer iterator.Close()
+	appendCalls := func(iterator sdk.Iterator) error {
+		defer iterator.Close()
 
-	for ; iterator.Valid(); iterator.Next() {
-		bz := iterator.Value()
+		for ; iterator.Valid(); iterator.Next() {
+			bz := iterator.Value()
 
-		var callID dnTypes.ID
-		if err := types.ModuleCdc.UnmarshalBinaryLengthPrefixed(bz, &callID); err != nil {
-			return nil, sdkErrors.Wrapf(types.ErrInternal, "callID unmarshal: %v", err)
-		}
+			var callID dnTypes.ID
+			if err := types.ModuleCdc.UnmarshalBinaryLengthPrefixed(bz, &callID); err != nil {
+				return sdkErrors.Wrapf(types.ErrInternal, "callID unmarshal: %v", err)
+			}
 
-		call, err := k.GetCall(ctx, callID)
-		if err != nil {
-			return nil, err
-		}
+			call, err := k.GetCall(ctx, callID)
+			if err != nil {
+				return err
+			}
 
-		votes, err := k.GetVotes(ctx, callID)
-		if err != nil {
-			return nil, err
+			votes, err := k.GetVotes(ctx, callID)
+			if err != nil {
+				return err
+			}
+
+			resps = append(resps, types.CallResp{
+				Call:  call,
+				Votes: votes,
+			})
 		}
 
-		resps = append(resps, types.CallResp{
-			Call:  call,
-			Votes: votes,
-		})
+		return nil
+	}
+
+	if err := appendCalls(k.GetQueueIteratorStartEnd(ctx, start, ctx.BlockHeight())); err != nil {
+		return nil, err
+	}
+	if err := appendCalls(k.GetPendingQueueIterator(ctx)); err != nil {
+		return nil, err
 	}
 
 	bz, err := codec.MarshalJSONIndent(k.cdc, resps)
@@ -80,21 +94,11 @@ func queryGetCall(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, err
 		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
 	}
 
-	call, err := k.GetCall(ctx, params.CallID)
+	resp, err := getCallResp(k, ctx, params.CallID)
 	if err != nil {
 		return nil, err
 	}
 
-	votes, err := k.GetVotes(ctx, params.CallID)
-	if err != nil {
-		return nil, err
-	}
-
-	resp := types.CallResp{
-		Call:  call,
-		Votes: votes,
-	}
-
 	bz, err := codec.MarshalJSONIndent(k.cdc, resp)
 	if err != nil {
 		return nil, sdkErrors.Wrapf(types.ErrInternal, "callResp marshal: %v", err)
@@ -116,24 +120,34 @@ func queryGetUnique(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, e
 		return nil, err
 	}
 
-	call, err := k.GetCall(ctx, id)
+	resp, err := getCallResp(k, ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	votes, err := k.GetVotes(ctx, id)
+	bz, err := codec.MarshalJSONIndent(k.cdc, resp)
 	if err != nil {
-		return nil, err
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "CallResp marshal: %v", err)
 	}
 
-	resp := types.CallResp{
-		Call:  call,
-		Votes: votes,
+	return bz, nil
+}
+
+// queryGetHistory handles getHistory query which returns filtered calls history (including archived calls).
+func queryGetHistory(k Keeper, ctx sdk.Context, req abci.RequestQuery) ([]byte, error) {
+	var params types.CallsHistoryReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
 	}
 
-	bz, err := codec.MarshalJSONIndent(k.cdc, resp)
+	resps, err := k.GetCallsHistory(ctx, params)
 	if err != nil {
-		return nil, sdkErrors.Wrapf(types.ErrInternal, "CallResp marshal: %v", err)
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, resps)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "[]callsRes marshal: %v", err)
 	}
 
 	return bz, nil
@@ -150,3 +164,39 @@ func queryGetLastID(k Keeper, ctx sdk.Context) ([]byte, error) {
 
 	return bz, nil
 }
+
+// queryGetParams handles getParams query which returns module params (including call approval thresholds).
+func queryGetParams(k Keeper, ctx sdk.Context) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetParams(ctx))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "params marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// getCallResp returns call with votes (archived call is used if call is not found).
+func getCallResp(k Keeper, ctx sdk.Context, id dnTypes.ID) (types.CallResp, error) {
+	if archivedCall, err := k.GetArchivedCall(ctx, id); err == nil {
+		return types.CallResp{
+			Call:     archivedCall.Call,
+			Votes:    archivedCall.Votes,
+			Archived: true,
+		}, nil
+	}
+
+	call, err := k.GetCall(ctx, id)
+	if err != nil {
+		return types.CallResp{}, err
+	}
+
+	votes, err := k.GetVotes(ctx, id)
+	if err != nil {
+		return types.CallResp{}, err
+	}
+
+	return types.CallResp{
+		Call:  call,
+		Votes: votes,
+	}, nil
+}
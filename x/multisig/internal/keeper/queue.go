@@ -36,9 +36,44 @@ func (k Keeper) GetQueueIteratorTill(ctx sdk.Context, endHeight int64) sdk.Itera
 	return store.Iterator(types.QueuePrefix, sdk.PrefixEndBytes(types.GetPrefixQueueKey(endHeight)))
 }
 
+// RemoveCallFromPendingQueue removes call from the pending execution queue.
+func (k Keeper) RemoveCallFromPendingQueue(ctx sdk.Context, id dnTypes.ID, executionHeight int64) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingQueueKey(id, executionHeight))
+
+	ctx.EventManager().EmitEvent(types.NewCallRemovedEvent(id))
+}
+
+// GetPendingQueueIterator returns pending execution queue iterator.
+func (k Keeper) GetPendingQueueIterator(ctx sdk.Context) sdk.Iterator {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return sdk.KVStorePrefixIterator(store, types.PendingQueuePrefix)
+}
+
+// GetPendingQueueIteratorTill returns pending execution queue iterator within [:end] executionHeight range.
+func (k Keeper) GetPendingQueueIteratorTill(ctx sdk.Context, endHeight int64) sdk.Iterator {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Iterator(types.PendingQueuePrefix, sdk.PrefixEndBytes(types.GetPrefixPendingQueueKey(endHeight)))
+}
+
 // addCallToQueue add a new call to the queue.
 func (k Keeper) addCallToQueue(ctx sdk.Context, id dnTypes.ID, height int64) {
 	store := ctx.KVStore(k.storeKey)
 
 	store.Set(types.GetQueueKey(id, height), k.cdc.MustMarshalBinaryLengthPrefixed(id))
 }
+
+// addCallToPendingQueue adds an approved call to the pending execution queue.
+func (k Keeper) addCallToPendingQueue(ctx sdk.Context, id dnTypes.ID, executionHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetPendingQueueKey(id, executionHeight), k.cdc.MustMarshalBinaryLengthPrefixed(id))
+}
@@ -2,22 +2,74 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/multisig/internal/types"
+)
+
+type (
+	// Operations order:
+	//   1: archiveOp
+	SquashOptions struct {
+		// Finished calls archive operation
+		archiveOp archiveOperation
+	}
+
+	archiveOperation struct {
+		// Move finished calls with votes to the archive keeping their original block heights
+		Enabled bool
+	}
 )
 
+func (opts *SquashOptions) SetArchiveOperation(enabled bool) error {
+	op := archiveOperation{
+		Enabled: enabled,
+	}
+	opts.archiveOp = op
+
+	return nil
+}
+
+func NewEmptySquashOptions() SquashOptions {
+	return SquashOptions{
+		archiveOp: archiveOperation{},
+	}
+}
+
 // PrepareForZeroHeight squashes current context state to fit zero-height (used on genesis export).
-func (k Keeper) PrepareForZeroHeight(ctx sdk.Context) error {
+func (k Keeper) PrepareForZeroHeight(ctx sdk.Context, opts SquashOptions) error {
 	// reset call objects and calls queue entries
 	// queue modifications resets call confirmation timeout
 	calls := k.getCalls(ctx)
 	for _, call := range calls {
+		// archiveOp
+		if opts.archiveOp.Enabled && call.IsFinished() {
+			k.archiveCall(ctx, call)
+			continue
+		}
+
 		// add to the queue if call is not handled yet
 		if err := call.CanBeVoted(); err == nil {
 			k.RemoveCallFromQueue(ctx, call.ID, call.Height)
 			k.addCallToQueue(ctx, call.ID, 0)
 		}
 
+		// move to the pending queue keeping the remaining execution delay
+		if call.Pending {
+			store := ctx.KVStore(k.storeKey)
+			store.Delete(types.GetPendingQueueKey(call.ID, call.ExecutionHeight))
+
+			call.ExecutionHeight -= ctx.BlockHeight()
+			if call.ExecutionHeight < 1 {
+				call.ExecutionHeight = 1
+			}
+			k.addCallToPendingQueue(ctx, call.ID, call.ExecutionHeight)
+		}
+
 		// update call
 		call.Height = 0
+		if call.IsFinished() {
+			call.ExecutionHeight, call.TerminalHeight = 0, 0
+		}
 		k.StoreCall(ctx, call)
 	}
 
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/multisig/internal/types"
+)
+
+// GetCallThreshold returns call approval threshold for the call route / msg type if configured.
+// Batch call threshold is the strictest combination of its messages thresholds.
+func (k Keeper) GetCallThreshold(ctx sdk.Context, call types.Call) (types.CallThreshold, bool) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	threshold, found, _ := k.GetThresholds(ctx).GetForCall(call)
+
+	return threshold, found
+}
+
+// GetCallApproval returns call confirmations weight and vote weight required to approve the call.
+// Required weight depends on the call route / msg type threshold (majority of the total weight by default).
+// Batch call requires the max weight among its messages thresholds.
+func (k Keeper) GetCallApproval(ctx sdk.Context, call types.Call, poaKeeper types.PoaKeeper) (confirmedWeight, requiredWeight uint64, retErr error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	votes, err := k.GetVotes(ctx, call.ID)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	for _, vote := range votes {
+		confirmedWeight += uint64(poaKeeper.GetValidatorWeight(ctx, vote))
+	}
+
+	totalWeight := poaKeeper.GetValidatorsTotalWeight(ctx)
+	threshold, found, partial := k.GetThresholds(ctx).GetForCall(call)
+	if found {
+		requiredWeight = threshold.RequiredWeight(totalWeight)
+	}
+	if !found || partial {
+		if defaultWeight := types.DefaultRequiredWeight(totalWeight); defaultWeight > requiredWeight {
+			requiredWeight = defaultWeight
+		}
+	}
+
+	return
+}
+
+// addPendingCallRevocation increments pending call revocations counter and rejects (vetoes) the call once
+// the route threshold VetoRevocations is reached.
+func (k Keeper) addPendingCallRevocation(ctx sdk.Context, call types.Call) {
+	call.Revocations++
+
+	threshold, found := k.GetCallThreshold(ctx, call)
+	if found && threshold.VetoRevocations > 0 && call.Revocations >= threshold.VetoRevocations {
+		call.Pending = false
+		call.Rejected = true
+		call.Finish(types.CallReasonVetoed, ctx.BlockHeight())
+		k.RemoveCallFromPendingQueue(ctx, call.ID, call.ExecutionHeight)
+
+		ctx.EventManager().EmitEvent(types.NewCallStateChangedEvent(call.ID, types.AttributeValueVetoed))
+	}
+
+	k.StoreCall(ctx, call)
+}
@@ -0,0 +1,104 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/multisig/internal/types"
+)
+
+type MockPoaKeeper struct {
+	weights map[string]uint32
+}
+
+func (k MockPoaKeeper) GetValidatorWeight(ctx sdk.Context, address sdk.AccAddress) uint32 {
+	return k.weights[address.String()]
+}
+
+func (k MockPoaKeeper) GetValidatorsTotalWeight(ctx sdk.Context) uint64 {
+	total := uint64(0)
+	for _, w := range k.weights {
+		total += uint64(w)
+	}
+
+	return total
+}
+
+// Check weighted call approval with per route / msg type thresholds.
+func TestMSKeeper_GetCallApproval(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	addr1, addr2, addr3 := sdk.AccAddress("addr1"), sdk.AccAddress("addr2"), sdk.AccAddress("addr3")
+	poaKeeper := MockPoaKeeper{
+		weights: map[string]uint32{
+			addr1.String(): 4,
+			addr2.String(): 1,
+			addr3.String(): 1,
+		},
+	}
+
+	// create call
+	callID := dnTypes.NewIDFromUint64(0)
+	require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "uniqueID", addr2))
+	call, err := keeper.GetCall(ctx, callID)
+	require.NoError(t, err)
+
+	// check default threshold (majority)
+	{
+		confirmed, required, err := keeper.GetCallApproval(ctx, call, poaKeeper)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, confirmed)
+		require.EqualValues(t, 4, required)
+	}
+
+	// check route threshold
+	{
+		keeper.SetThresholds(ctx, types.CallThresholds{
+			{MsgRoute: MockMsgRouteOk, Share: sdk.NewDecWithPrec(3, 1)},
+		})
+
+		confirmed, required, err := keeper.GetCallApproval(ctx, call, poaKeeper)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, confirmed)
+		require.EqualValues(t, 2, required)
+	}
+
+	// check msg type threshold
+	{
+		keeper.SetThresholds(ctx, types.CallThresholds{
+			{MsgRoute: MockMsgRouteOk, Share: sdk.NewDecWithPrec(3, 1)},
+			{MsgRoute: MockMsgRouteOk, MsgType: MockMsgType, Share: sdk.OneDec()},
+		})
+
+		confirmed, required, err := keeper.GetCallApproval(ctx, call, poaKeeper)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, confirmed)
+		require.EqualValues(t, 6, required)
+	}
+
+	// check weighted confirmations
+	{
+		require.NoError(t, keeper.ConfirmCall(ctx, callID, addr1))
+
+		confirmed, _, err := keeper.GetCallApproval(ctx, call, poaKeeper)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, confirmed)
+	}
+
+	// check non-validator vote is not counted
+	{
+		require.NoError(t, keeper.ConfirmCall(ctx, callID, sdk.AccAddress("addr4")))
+
+		confirmed, _, err := keeper.GetCallApproval(ctx, call, poaKeeper)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, confirmed)
+	}
+}
@@ -126,7 +126,7 @@ func (k Keeper) revokeVote(ctx sdk.Context, call types.Call, address sdk.AccAddr
 		}
 	}()
 
-	if err := call.CanBeVoted(); err != nil {
+	if err := call.CanBeRevoked(); err != nil {
 		return err
 	}
 
@@ -138,25 +138,29 @@ func (k Keeper) revokeVote(ctx sdk.Context, call types.Call, address sdk.AccAddr
 
 	votes, _ := k.GetVotes(ctx, call.ID)
 
-	// remove votes if this is the last vote
+	// pending call revocation: remove vote and count it as a veto (call stays in the pending execution queue)
+	if call.Pending {
+		k.setVotes(ctx, call.ID, votes.Remove(address))
+		k.addPendingCallRevocation(ctx, call)
+
+		return nil
+	}
+
+	// remove votes and reject the call if this is the last vote
 	if len(votes) == 1 {
 		k.removeVotes(ctx, call.ID)
 		k.RemoveCallFromQueue(ctx, call.ID, call.Height)
 
+		call.Rejected = true
+		call.Finish(types.CallReasonRevokedAll, ctx.BlockHeight())
+		k.StoreCall(ctx, call)
+		ctx.EventManager().EmitEvent(types.NewCallStateChangedEvent(call.ID, types.AttributeValueRejected))
+
 		return nil
 	}
 
 	// remove vote from existing votes
-	voteIdx := -1
-	for i, vote := range votes {
-		if vote.Equals(address) {
-			voteIdx = i
-			break
-		}
-	}
-
-	votes = append(votes[:voteIdx], votes[voteIdx+1:]...)
-	k.setVotes(ctx, call.ID, votes)
+	k.setVotes(ctx, call.ID, votes.Remove(address))
 
 	return nil
 }
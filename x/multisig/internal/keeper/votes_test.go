@@ -296,3 +296,92 @@ func TestMSKeeper_HasVoteGetVotes(t *testing.T) {
 		require.Len(t, votes, 0)
 	}
 }
+
+// Check pending (time-locked) call confirmation and revocation (veto).
+func TestMSKeeper_PendingCall(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	callID := dnTypes.NewIDFromUint64(0)
+	addr1, addr2, addr3, addr4 := sdk.AccAddress("addr1"), sdk.AccAddress("addr2"), sdk.AccAddress("addr3"), sdk.AccAddress("addr4")
+
+	keeper.SetThresholds(ctx, types.CallThresholds{
+		{MsgRoute: MockMsgRouteOk, Share: sdk.OneDec(), ExecutionDelay: 10, VetoRevocations: 2},
+	})
+
+	// create call and move it to the pending queue
+	{
+		require.NoError(t, keeper.SubmitCall(ctx, NewMockMsMsg(true), "uniqueID", addr1))
+		require.NoError(t, keeper.ConfirmCall(ctx, callID, addr2))
+		require.NoError(t, keeper.ConfirmCall(ctx, callID, addr3))
+
+		call, err := keeper.GetCall(ctx, callID)
+		require.NoError(t, err)
+
+		threshold, found := keeper.GetCallThreshold(ctx, call)
+		require.True(t, found)
+
+		call = keeper.SetCallPending(ctx, call, threshold.ExecutionDelay)
+		require.True(t, call.Pending)
+		require.EqualValues(t, ctx.BlockHeight()+10, call.ExecutionHeight)
+		require.NoError(t, call.Valid(-1))
+	}
+
+	// check queues
+	{
+		activeIterator := keeper.GetQueueIteratorTill(ctx, ctx.BlockHeight())
+		require.False(t, activeIterator.Valid())
+		activeIterator.Close()
+
+		pendingIterator := keeper.GetPendingQueueIteratorTill(ctx, ctx.BlockHeight()+9)
+		require.False(t, pendingIterator.Valid())
+		pendingIterator.Close()
+
+		pendingIterator = keeper.GetPendingQueueIteratorTill(ctx, ctx.BlockHeight()+10)
+		require.True(t, pendingIterator.Valid())
+		pendingIterator.Close()
+	}
+
+	// fail: pending call not accepts confirmations
+	{
+		err := keeper.ConfirmCall(ctx, callID, addr4)
+		require.Error(t, err)
+		require.True(t, types.ErrVotePendingExecution.Is(err))
+	}
+
+	// ok: revoke (veto limit not reached)
+	{
+		require.NoError(t, keeper.RevokeConfirmation(ctx, callID, addr1))
+
+		call, err := keeper.GetCall(ctx, callID)
+		require.NoError(t, err)
+		require.True(t, call.Pending)
+		require.EqualValues(t, 1, call.Revocations)
+
+		votes, err := keeper.GetVotes(ctx, callID)
+		require.NoError(t, err)
+		require.Len(t, votes, 2)
+	}
+
+	// ok: revoke (call vetoed)
+	{
+		require.NoError(t, keeper.RevokeConfirmation(ctx, callID, addr2))
+
+		call, err := keeper.GetCall(ctx, callID)
+		require.NoError(t, err)
+		require.False(t, call.Pending)
+		require.True(t, call.Rejected)
+		require.EqualValues(t, 2, call.Revocations)
+
+		pendingIterator := keeper.GetPendingQueueIterator(ctx)
+		require.False(t, pendingIterator.Valid())
+		pendingIterator.Close()
+	}
+
+	// fail: vetoed call not accepts revocations
+	{
+		require.Error(t, keeper.RevokeConfirmation(ctx, callID, addr3))
+	}
+}
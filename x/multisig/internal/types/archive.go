@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ArchivedCall is a finished call with its votes moved to the archive on zero-height squashing.
+// Archived call keeps its original block heights.
+type ArchivedCall struct {
+	Call  Call  `json:"call" yaml:"call"`
+	Votes Votes `json:"votes" yaml:"votes"`
+}
+
+// Validate checks that ArchivedCall is valid (used for genesis ops).
+func (c ArchivedCall) Validate() error {
+	if err := c.Call.Valid(-1); err != nil {
+		return err
+	}
+	if !c.Call.IsFinished() {
+		return fmt.Errorf("call is not finished")
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,6 +11,42 @@ import (
 	"github.com/dfinance/dnode/x/core/msmodule"
 )
 
+// Max number of messages within a batch call (including the main call message).
+const MaxBatchMsgs = 10
+
+// Enum type to define the reason call reached its terminal state.
+type CallTerminalReason string
+
+const (
+	// Call messages executed
+	CallReasonExecuted CallTerminalReason = "executed"
+	// Call messages execution failed
+	CallReasonFailed CallTerminalReason = "failed"
+	// Call was not approved within IntervalToExecute
+	CallReasonExpired CallTerminalReason = "expired"
+	// All call confirmations were revoked
+	CallReasonRevokedAll CallTerminalReason = "revoked_all"
+	// Pending call was vetoed by revocations
+	CallReasonVetoed CallTerminalReason = "vetoed"
+)
+
+// IsValid validates enum (empty value is valid for non-terminal calls).
+func (r CallTerminalReason) IsValid() bool {
+	switch r {
+	case "", CallReasonExecuted, CallReasonFailed, CallReasonExpired, CallReasonRevokedAll, CallReasonVetoed:
+		return true
+	}
+
+	return false
+}
+
+// Call state values (non-terminal states and the legacy rejected state without reason).
+const (
+	CallStateActive   = "active"
+	CallStatePending  = "pending"
+	CallStateRejected = "rejected"
+)
+
 // Call contains multi signature message with some meta.
 type Call struct {
 	// Call ID
@@ -26,10 +63,24 @@ type Call struct {
 	Failed bool `json:"failed" yaml:"failed"`
 	// Call state: rejected
 	Rejected bool `json:"rejected" yaml:"rejected"`
+	// Call state: approved, pending execution (time-locked)
+	Pending bool `json:"pending" yaml:"pending"`
+	// BlockHeight the pending call would be executed at
+	ExecutionHeight int64 `json:"execution_height" yaml:"execution_height" example:"100"`
+	// Number of confirmations revoked while call is pending execution
+	Revocations uint32 `json:"revocations" yaml:"revocations" example:"0"`
 	// Call fail reason
 	Error string `json:"error" yaml:"error"`
+	// Call terminal state reason (executed / failed / expired / revoked_all / vetoed, empty - call is not finished)
+	TerminalReason CallTerminalReason `json:"terminal_reason" yaml:"terminal_reason" swaggertype:"string" example:"executed"`
+	// BlockHeight call reached its terminal state at
+	TerminalHeight int64 `json:"terminal_height" yaml:"terminal_height" example:"100"`
+	// Call messages execution results (in execution order)
+	Results CallMsgResults `json:"results" yaml:"results"`
 	// Message: data
 	Msg msmodule.MsMsg `json:"msg_data" yaml:"msg_data"`
+	// Batch call: messages executed atomically after the main message (in order)
+	BatchMsgs []msmodule.MsMsg `json:"batch_msgs_data" yaml:"batch_msgs_data"`
 	// Message: route
 	MsgRoute string `json:"msg_route" yaml:"msg_route" example:"oracle"`
 	// Message: type
@@ -55,9 +106,27 @@ func (c Call) Valid(curBlockHeight int64) error {
 	if c.Rejected && (c.Approved || c.Executed || c.Failed) {
 		return fmt.Errorf("rejected, but also approved/executed/failed")
 	}
+	if c.Pending && (c.Approved || c.Rejected) {
+		return fmt.Errorf("pending, but also approved/rejected")
+	}
+	if c.Pending && c.ExecutionHeight <= c.Height {
+		return fmt.Errorf("execution_height: must be GT height for pending call")
+	}
+	if !c.TerminalReason.IsValid() {
+		return fmt.Errorf("terminal_reason: unknown %q", c.TerminalReason)
+	}
+	if c.TerminalReason != "" && !(c.Approved || c.Rejected) {
+		return fmt.Errorf("terminal_reason: set, but not approved/rejected")
+	}
+	if c.TerminalHeight < 0 {
+		return fmt.Errorf("terminal_height: LT 0")
+	}
 	if c.Msg == nil {
 		return fmt.Errorf("msg: nil")
 	}
+	if err := validateBatchMsgs(c.BatchMsgs); err != nil {
+		return err
+	}
 	if c.MsgRoute == "" {
 		return fmt.Errorf("msg_route: empty")
 	}
@@ -74,8 +143,61 @@ func (c Call) Valid(curBlockHeight int64) error {
 	return nil
 }
 
-// CanBeVoted checks if call accepts votes (vote / revoke confirmation).
+// State returns call current state: active / pending or terminal state reason.
+func (c Call) State() string {
+	if c.TerminalReason != "" {
+		return string(c.TerminalReason)
+	}
+
+	switch {
+	case c.Executed:
+		return string(CallReasonExecuted)
+	case c.Failed:
+		return string(CallReasonFailed)
+	case c.Rejected:
+		return CallStateRejected
+	case c.Pending:
+		return CallStatePending
+	}
+
+	return CallStateActive
+}
+
+// IsFinished checks if call has reached its terminal state.
+func (c Call) IsFinished() bool {
+	return c.Approved || c.Rejected
+}
+
+// Finish sets call terminal state reason.
+func (c *Call) Finish(reason CallTerminalReason, blockHeight int64) {
+	c.TerminalReason = reason
+	c.TerminalHeight = blockHeight
+}
+
+// Msgs returns all call messages in the execution order.
+func (c Call) Msgs() []msmodule.MsMsg {
+	return append([]msmodule.MsMsg{c.Msg}, c.BatchMsgs...)
+}
+
+// IsBatch checks if call contains more than one message.
+func (c Call) IsBatch() bool {
+	return len(c.BatchMsgs) > 0
+}
+
+// CanBeVoted checks if call accepts votes (vote confirmation).
 func (c Call) CanBeVoted() error {
+	if err := c.CanBeRevoked(); err != nil {
+		return err
+	}
+	if c.Pending {
+		return sdkErrors.Wrap(ErrVotePendingExecution, c.ID.String())
+	}
+
+	return nil
+}
+
+// CanBeRevoked checks if call accepts vote revocations (pending call revocation counts as a veto).
+func (c Call) CanBeRevoked() error {
 	if c.Approved {
 		return sdkErrors.Wrap(ErrVoteAlreadyApproved, c.ID.String())
 	}
@@ -95,9 +217,16 @@ func (c Call) String() string {
 		"  Executed: %v\n"+
 		"  Failed:   %v\n"+
 		"  Rejected: %v\n"+
+		"  Pending:  %v\n"+
+		"  ExecutionHeight: %d\n"+
+		"  Revocations: %d\n"+
 		"  Error:    %s\n"+
+		"  TerminalReason: %s\n"+
+		"  TerminalHeight: %d\n"+
+		"  Results:  %s\n"+
 		"  MsgRoute: %s\n"+
 		"  MsgType:  %s\n"+
+		"  BatchMsgs: %d\n"+
 		"  Height:   %d",
 		c.ID.String(),
 		c.UniqueID,
@@ -106,28 +235,35 @@ func (c Call) String() string {
 		c.Executed,
 		c.Failed,
 		c.Rejected,
+		c.Pending,
+		c.ExecutionHeight,
+		c.Revocations,
 		c.Error,
+		c.TerminalReason,
+		c.TerminalHeight,
+		c.Results,
 		c.MsgRoute,
 		c.MsgType,
+		len(c.BatchMsgs),
 		c.Height,
 	)
 }
 
 // NewCall creates a new Call object.
-func NewCall(id dnTypes.ID, uniqueID string, msg msmodule.MsMsg, blockHeight int64, creatorAddr sdk.AccAddress) (Call, error) {
-	// check message
-	if msg == nil {
-		return Call{}, sdkErrors.Wrap(ErrWrongMsg, "nil")
+// Optional {batchMsgs} are executed atomically after the main {msg} (batch call).
+func NewCall(id dnTypes.ID, uniqueID string, msg msmodule.MsMsg, blockHeight int64, creatorAddr sdk.AccAddress, batchMsgs ...msmodule.MsMsg) (Call, error) {
+	// check messages
+	if err := checkCallMsg(msg); err != nil {
+		return Call{}, err
 	}
 
-	msgRoute := msg.Route()
-	if msgRoute == "" {
-		return Call{}, sdkErrors.Wrap(ErrWrongMsgRoute, "empty")
+	if len(batchMsgs)+1 > MaxBatchMsgs {
+		return Call{}, sdkErrors.Wrapf(ErrWrongMsg, "batch: messages count GT %d", MaxBatchMsgs)
 	}
-
-	msgType := msg.Type()
-	if msgType == "" {
-		return Call{}, sdkErrors.Wrap(ErrWrongMsgType, "empty")
+	for i, batchMsg := range batchMsgs {
+		if err := checkCallMsg(batchMsg); err != nil {
+			return Call{}, sdkErrors.Wrapf(err, "batch msg[%d]", i)
+		}
 	}
 
 	// check other inputs
@@ -144,17 +280,102 @@ func NewCall(id dnTypes.ID, uniqueID string, msg msmodule.MsMsg, blockHeight int
 	}
 
 	return Call{
-		ID:       id,
-		Creator:  creatorAddr,
-		UniqueID: uniqueID,
-		Approved: false,
-		Executed: false,
-		Rejected: false,
-		Failed:   false,
-		Msg:      msg,
-		Error:    "",
-		Height:   blockHeight,
-		MsgRoute: msg.Route(),
-		MsgType:  msg.Type(),
+		ID:        id,
+		Creator:   creatorAddr,
+		UniqueID:  uniqueID,
+		Approved:  false,
+		Executed:  false,
+		Rejected:  false,
+		Failed:    false,
+		Msg:       msg,
+		BatchMsgs: batchMsgs,
+		Error:     "",
+		Height:    blockHeight,
+		MsgRoute:  msg.Route(),
+		MsgType:   msg.Type(),
 	}, nil
 }
+
+// checkCallMsg checks call message route and type.
+func checkCallMsg(msg msmodule.MsMsg) error {
+	if msg == nil {
+		return sdkErrors.Wrap(ErrWrongMsg, "nil")
+	}
+
+	if msg.Route() == "" {
+		return sdkErrors.Wrap(ErrWrongMsgRoute, "empty")
+	}
+
+	if msg.Type() == "" {
+		return sdkErrors.Wrap(ErrWrongMsgType, "empty")
+	}
+
+	return nil
+}
+
+// validateBatchMsgs checks batch call messages (used for genesis ops).
+func validateBatchMsgs(batchMsgs []msmodule.MsMsg) error {
+	if len(batchMsgs)+1 > MaxBatchMsgs {
+		return fmt.Errorf("batch_msgs: length GT %d", MaxBatchMsgs-1)
+	}
+
+	for i, msg := range batchMsgs {
+		if msg == nil {
+			return fmt.Errorf("batch_msgs[%d]: nil", i)
+		}
+		if msg.Route() == "" {
+			return fmt.Errorf("batch_msgs[%d]: route: empty", i)
+		}
+		if msg.Type() == "" {
+			return fmt.Errorf("batch_msgs[%d]: type: empty", i)
+		}
+	}
+
+	return nil
+}
+
+// CallMsgResult contains call message execution result.
+type CallMsgResult struct {
+	// Message route
+	MsgRoute string `json:"msg_route" yaml:"msg_route" example:"oracle"`
+	// Message type
+	MsgType string `json:"msg_type" yaml:"msg_type" example:"add_asset"`
+	// Message handler finished without an error (batch call changes are committed only if all messages succeeded)
+	Succeeded bool `json:"succeeded" yaml:"succeeded"`
+	// Message handler error
+	Error string `json:"error" yaml:"error"`
+}
+
+func (r CallMsgResult) String() string {
+	if r.Succeeded {
+		return fmt.Sprintf("%s/%s: ok", r.MsgRoute, r.MsgType)
+	}
+
+	return fmt.Sprintf("%s/%s: %s", r.MsgRoute, r.MsgType, r.Error)
+}
+
+// NewCallMsgResult creates a new CallMsgResult object using message handler error.
+func NewCallMsgResult(msg msmodule.MsMsg, err error) CallMsgResult {
+	r := CallMsgResult{
+		MsgRoute:  msg.Route(),
+		MsgType:   msg.Type(),
+		Succeeded: err == nil,
+	}
+	if err != nil {
+		r.Error = err.Error()
+	}
+
+	return r
+}
+
+// CallMsgResults slice type.
+type CallMsgResults []CallMsgResult
+
+func (list CallMsgResults) String() string {
+	strs := make([]string, 0, len(list))
+	for _, r := range list {
+		strs = append(strs, r.String())
+	}
+
+	return "[" + strings.Join(strs, ", ") + "]"
+}
@@ -47,6 +47,23 @@ func TestMS_NewCall(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// ok: batch
+	{
+		addr := sdk.AccAddress("addr1")
+		call, err := NewCall(dnTypes.NewIDFromUint64(0), "unique", NewOkMockMsMsg(), 0, addr, NewMockMsMsg("route2", "type2", true))
+		require.NoError(t, err)
+		require.True(t, call.IsBatch())
+		require.Len(t, call.Msgs(), 2)
+		require.Equal(t, "route2", call.Msgs()[1].Route())
+	}
+
+	// fail: batch with invalid msg type
+	{
+		addr := sdk.AccAddress("addr1")
+		_, err := NewCall(dnTypes.NewIDFromUint64(0), "unique", NewOkMockMsMsg(), 0, addr, NewInvalidTypeMockMsMsg())
+		require.Error(t, err)
+	}
+
 	// fail: nil msg
 	{
 		addr := sdk.AccAddress("addr1")
@@ -137,6 +154,62 @@ func TestMS_Call_Valid(t *testing.T) {
 		}
 		require.Error(t, call.Valid(-1))
 	}
+	// fail: c.Pending && (c.Approved || c.Rejected)
+	{
+		call := Call{
+			ID:       dnTypes.NewZeroID(),
+			UniqueID: "unique",
+			Creator:  addr,
+			Rejected: true,
+			Pending:  true,
+		}
+		require.Error(t, call.Valid(-1))
+	}
+	// fail: c.Pending && c.ExecutionHeight <= c.Height
+	{
+		call := Call{
+			ID:              dnTypes.NewZeroID(),
+			UniqueID:        "unique",
+			Creator:         addr,
+			Pending:         true,
+			Height:          10,
+			ExecutionHeight: 10,
+		}
+		require.Error(t, call.Valid(-1))
+	}
+	// fail: unknown TerminalReason
+	{
+		call := Call{
+			ID:             dnTypes.NewZeroID(),
+			UniqueID:       "unique",
+			Creator:        addr,
+			Rejected:       true,
+			TerminalReason: "unknown",
+		}
+		require.Error(t, call.Valid(-1))
+	}
+	// fail: TerminalReason set for not finished call
+	{
+		call := Call{
+			ID:             dnTypes.NewZeroID(),
+			UniqueID:       "unique",
+			Creator:        addr,
+			TerminalReason: CallReasonExpired,
+		}
+		require.Error(t, call.Valid(-1))
+	}
+	// fail: TerminalHeight
+	{
+		call := Call{
+			ID:             dnTypes.NewZeroID(),
+			UniqueID:       "unique",
+			Creator:        addr,
+			Rejected:       true,
+			TerminalReason: CallReasonExpired,
+			TerminalHeight: -1,
+		}
+		require.Error(t, call.Valid(-1))
+	}
 	// fail: Msg nil
 	{
 		call := Call{
@@ -19,6 +19,7 @@ var (
 	ErrVoteAlreadyRejected  = sdkErrors.Register(ModuleName, 402, "call already rejected")
 	ErrVoteNoVotes          = sdkErrors.Register(ModuleName, 403, "no votes found for the call")
 	ErrVoteNotApproved      = sdkErrors.Register(ModuleName, 404, "call not approved by address")
+	ErrVotePendingExecution = sdkErrors.Register(ModuleName, 405, "call approved and pending execution")
 	// POA
 	ErrPoaNotValidator = sdkErrors.Register(ModuleName, 500, "address is not a validator")
 )
@@ -24,6 +24,8 @@ const (
 	AttributeValueRejected = "rejected"
 	AttributeValueFailed   = "failed"
 	AttributeValueExecuted = "executed"
+	AttributeValuePending  = "pending"
+	AttributeValueVetoed   = "vetoed"
 )
 
 // NewCallSubmittedEvent creates an Event on call submit (creation).
@@ -46,7 +48,7 @@ func NewCallRemovedEvent(callID dnTypes.ID) sdk.Event {
 	)
 }
 
-// NewCallStateChangedEvent creates an Event on call state change (approved, rejected, failed, executed).
+// NewCallStateChangedEvent creates an Event on call state change (approved, rejected, failed, executed, pending, vetoed).
 func NewCallStateChangedEvent(callID dnTypes.ID, state string) sdk.Event {
 	return sdk.NewEvent(
 		EventTypeUpdateCall,
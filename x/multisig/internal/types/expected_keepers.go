@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoaKeeper defines the expected PoA keeper providing validators vote weights (noalias).
+type PoaKeeper interface {
+	GetValidatorWeight(ctx sdk.Context, address sdk.AccAddress) uint32
+	GetValidatorsTotalWeight(ctx sdk.Context) uint64
+}
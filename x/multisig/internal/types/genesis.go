@@ -12,6 +12,10 @@ type GenesisState struct {
 	LastCallID *dnTypes.ID        `json:"last_call_id" yaml:"last_call_id"`
 	CallItems  []GenesisCallItem  `json:"call_items" yaml:"call_items"`
 	QueueItems []GenesisQueueItem `json:"queue_items" yaml:"queue_items"`
+	// Approved calls pending execution queue (BlockHeight is the call execution height)
+	PendingQueueItems []GenesisQueueItem `json:"pending_queue_items" yaml:"pending_queue_items"`
+	// Finished calls archived on zero-height squashing
+	ArchivedCalls []ArchivedCall `json:"archived_calls" yaml:"archived_calls"`
 }
 
 // GenesisCallItem stores calls info for genesisState.
@@ -34,6 +38,8 @@ func (s GenesisState) Validate(curBlockHeight int64) error {
 	}
 
 	callsSet := make(map[string]bool, len(s.CallItems))
+	pendingCallsSet := make(map[string]bool)
+	archivedCallsSet := make(map[string]bool, len(s.ArchivedCalls))
 	maxCallID := dnTypes.NewZeroID()
 	for i, item := range s.CallItems {
 		if callsSet[item.Call.ID.String()] {
@@ -45,11 +51,30 @@ func (s GenesisState) Validate(curBlockHeight int64) error {
 		}
 
 		callsSet[item.Call.ID.String()] = true
+		if item.Call.Pending {
+			pendingCallsSet[item.Call.ID.String()] = true
+		}
 		if item.Call.ID.GT(maxCallID) {
 			maxCallID = item.Call.ID
 		}
 	}
 
+	for i, archivedCall := range s.ArchivedCalls {
+		if callsSet[archivedCall.Call.ID.String()] {
+			return fmt.Errorf("archived_calls[%d]: call_id %q duplicated", i, archivedCall.Call.ID.String())
+		}
+
+		if err := archivedCall.Validate(); err != nil {
+			return fmt.Errorf("archived_calls[%d]: %w", i, err)
+		}
+
+		callsSet[archivedCall.Call.ID.String()] = true
+		archivedCallsSet[archivedCall.Call.ID.String()] = true
+		if archivedCall.Call.ID.GT(maxCallID) {
+			maxCallID = archivedCall.Call.ID
+		}
+	}
+
 	for i, item := range s.QueueItems {
 		if err := item.CallID.Valid(); err != nil {
 			return fmt.Errorf("queue_items[%d]: call_id: %w", i, err)
@@ -62,15 +87,29 @@ func (s GenesisState) Validate(curBlockHeight int64) error {
 			return fmt.Errorf("queue_items[%d]: block_height: GT current blockHeight", i)
 		}
 
-		if !callsSet[item.CallID.String()] {
+		if !callsSet[item.CallID.String()] || archivedCallsSet[item.CallID.String()] {
 			return fmt.Errorf("queue_items[%d]: call_id %q not found in the genesisState", i, item.CallID.String())
 		}
 	}
 
-	if s.LastCallID == nil && len(s.CallItems) != 0 {
+	for i, item := range s.PendingQueueItems {
+		if err := item.CallID.Valid(); err != nil {
+			return fmt.Errorf("pending_queue_items[%d]: call_id: %w", i, err)
+		}
+
+		if item.BlockHeight < 0 {
+			return fmt.Errorf("pending_queue_items[%d]: block_height: LT 0", i)
+		}
+
+		if !pendingCallsSet[item.CallID.String()] {
+			return fmt.Errorf("pending_queue_items[%d]: pending call_id %q not found in the genesisState", i, item.CallID.String())
+		}
+	}
+
+	if s.LastCallID == nil && len(callsSet) != 0 {
 		return fmt.Errorf("last_call_id: nil with existing calls")
 	}
-	if s.LastCallID != nil && len(s.CallItems) == 0 {
+	if s.LastCallID != nil && len(callsSet) == 0 {
 		return fmt.Errorf("last_call_id: not nil without existing calls")
 	}
 	if s.LastCallID != nil {
@@ -88,9 +127,11 @@ func (s GenesisState) Validate(curBlockHeight int64) error {
 // DefaultGenesisState returns default genesis state (validation is done on module init).
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Parameters: NewParams(DefIntervalToExecute),
-		LastCallID: nil,
-		CallItems:  make([]GenesisCallItem, 0),
-		QueueItems: make([]GenesisQueueItem, 0),
+		Parameters:        NewParams(DefIntervalToExecute, CallThresholds{}),
+		LastCallID:        nil,
+		CallItems:         make([]GenesisCallItem, 0),
+		QueueItems:        make([]GenesisQueueItem, 0),
+		PendingQueueItems: make([]GenesisQueueItem, 0),
+		ArchivedCalls:     make([]ArchivedCall, 0),
 	}
 }
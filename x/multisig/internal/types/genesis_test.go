@@ -183,6 +183,69 @@ func TestMS_Genesis_Validate(t *testing.T) {
 		}
 		require.Error(t, state.Validate(-1))
 	}
+	// fail: invalid archived call: not finished
+	{
+		lastID := dnTypes.NewZeroID()
+		call := okCall(0)
+		call.Approved, call.Executed = false, false
+		state := GenesisState{
+			Parameters: Params{
+				IntervalToExecute: MinIntervalToExecute,
+			},
+			ArchivedCalls: []ArchivedCall{
+				{
+					Call:  call,
+					Votes: Votes{},
+				},
+			},
+			LastCallID: &lastID,
+		}
+		require.Error(t, state.Validate(-1))
+	}
+	// fail: invalid archived call: duplicated id
+	{
+		lastID := dnTypes.NewZeroID()
+		state := GenesisState{
+			Parameters: Params{
+				IntervalToExecute: MinIntervalToExecute,
+			},
+			CallItems: []GenesisCallItem{
+				{
+					Call:  okCall(0),
+					Votes: Votes{},
+				},
+			},
+			ArchivedCalls: []ArchivedCall{
+				{
+					Call:  okCall(0),
+					Votes: Votes{},
+				},
+			},
+			LastCallID: &lastID,
+		}
+		require.Error(t, state.Validate(-1))
+	}
+	// ok: archived calls only
+	{
+		lastID := dnTypes.NewIDFromUint64(1)
+		state := GenesisState{
+			Parameters: Params{
+				IntervalToExecute: MinIntervalToExecute,
+			},
+			ArchivedCalls: []ArchivedCall{
+				{
+					Call:  okCall(0),
+					Votes: Votes{},
+				},
+				{
+					Call:  okCall(1),
+					Votes: Votes{},
+				},
+			},
+			LastCallID: &lastID,
+		}
+		require.NoError(t, state.Validate(-1))
+	}
 	// ok
 	{
 		lastID := dnTypes.NewIDFromUint64(1)
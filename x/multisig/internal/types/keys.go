@@ -14,6 +14,12 @@ var (
 	KeyDelimiter = []byte(":")
 	CallPrefix   = []byte("call")
 	QueuePrefix  = []byte("queue")
+	// Prefix for approved calls pending execution queue
+	PendingQueuePrefix = []byte("pendingQueue")
+	// Prefix for archived calls
+	ArchivePrefix = []byte("archive")
+	// Prefix for calls history index (live and archived calls ordered by ID / submit height)
+	HistoryIndexPrefix = []byte("historyIndex")
 	// Key for storing last call ID
 	LastCallIdKey = []byte("lastCallId")
 )
@@ -34,6 +40,39 @@ func GetCallKeyPrefix() []byte {
 	return append(CallPrefix, KeyDelimiter...)
 }
 
+// GetArchivedCallKey returns key for storing archived call objects.
+func GetArchivedCallKey(callID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			ArchivePrefix,
+			[]byte(callID.String()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetArchivedCallKeyPrefix returns key prefix for archived call objects iteration.
+func GetArchivedCallKeyPrefix() []byte {
+	return append(ArchivePrefix, KeyDelimiter...)
+}
+
+// GetHistoryIndexKey returns key for storing calls history index entries.
+// Big endian encoded callID keeps the calls submit order (IDs grow with block height).
+func GetHistoryIndexKey(callID dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			HistoryIndexPrefix,
+			sdk.Uint64ToBigEndian(callID.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetHistoryIndexKeyPrefix returns key prefix for calls history index iteration.
+func GetHistoryIndexKeyPrefix() []byte {
+	return append(HistoryIndexPrefix, KeyDelimiter...)
+}
+
 // GetUniqueIDKey returns key for storing callID by call's uniqueID.
 func GetUniqueIDKey(callUniqueID string) []byte {
 	return bytes.Join(
@@ -78,14 +117,46 @@ func GetPrefixQueueKey(blockHeight int64) []byte {
 	)
 }
 
+// GetPendingQueueKey return key for storing queue of approved callIDs pending execution.
+func GetPendingQueueKey(callID dnTypes.ID, executionHeight int64) []byte {
+	return bytes.Join(
+		[][]byte{
+			PendingQueuePrefix,
+			sdk.Uint64ToBigEndian(uint64(executionHeight)),
+			[]byte(callID.String()),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetPrefixPendingQueueKey returns queue of approved callIDs pending execution prefix key (used for iteration).
+func GetPrefixPendingQueueKey(executionHeight int64) []byte {
+	return bytes.Join(
+		[][]byte{
+			PendingQueuePrefix,
+			sdk.Uint64ToBigEndian(uint64(executionHeight)),
+		}, KeyDelimiter,
+	)
+}
+
 // MustParseQueueKey parses queue storage key.
 func MustParseQueueKey(key []byte) (callID dnTypes.ID, blockHeight int64) {
+	return mustParseQueueKey(key, QueuePrefix)
+}
+
+// MustParsePendingQueueKey parses pending execution queue storage key.
+func MustParsePendingQueueKey(key []byte) (callID dnTypes.ID, executionHeight int64) {
+	return mustParseQueueKey(key, PendingQueuePrefix)
+}
+
+// mustParseQueueKey parses queue storage key with {prefix}.
+func mustParseQueueKey(key, prefix []byte) (callID dnTypes.ID, blockHeight int64) {
 	values := bytes.Split(key, KeyDelimiter)
 	if len(values) != 3 {
 		panic(fmt.Errorf("key %q: invalid splitted length %d", string(key), len(values)))
 	}
 
-	if !bytes.Equal(values[0], QueuePrefix) {
+	if !bytes.Equal(values[0], prefix) {
 		panic(fmt.Errorf("key %q: value[0] %q: wrong prefix", string(key), string(values[0])))
 	}
 
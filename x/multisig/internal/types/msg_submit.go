@@ -11,6 +11,8 @@ import (
 type MsgSubmitCall struct {
 	// Call multi signature message
 	Msg msmodule.MsMsg `json:"msg" yaml:"msg"`
+	// Batch call multi signature messages executed atomically after the main message (optional)
+	BatchMsgs []msmodule.MsMsg `json:"batch_msgs,omitempty" yaml:"batch_msgs"`
 	// Call unique ID
 	UniqueID string `json:"unique_id" yaml:"unique_id"`
 	// Call creator address
@@ -37,10 +39,25 @@ func (msg MsgSubmitCall) ValidateBasic() error {
 		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "creator: empty")
 	}
 
+	if msg.Msg == nil {
+		return sdkErrors.Wrap(ErrWrongMsg, "nil")
+	}
 	if err := msg.Msg.ValidateBasic(); err != nil {
 		return sdkErrors.Wrap(ErrWrongMsg, err.Error())
 	}
 
+	if len(msg.BatchMsgs)+1 > MaxBatchMsgs {
+		return sdkErrors.Wrapf(ErrWrongMsg, "batch: messages count GT %d", MaxBatchMsgs)
+	}
+	for i, batchMsg := range msg.BatchMsgs {
+		if batchMsg == nil {
+			return sdkErrors.Wrapf(ErrWrongMsg, "batch msg[%d]: nil", i)
+		}
+		if err := batchMsg.ValidateBasic(); err != nil {
+			return sdkErrors.Wrapf(ErrWrongMsg, "batch msg[%d]: %v", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -55,10 +72,12 @@ func (msg MsgSubmitCall) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgSubmitCall creates a new MsgSubmitCall message.
-func NewMsgSubmitCall(msg msmodule.MsMsg, uniqueID string, creator sdk.AccAddress) MsgSubmitCall {
+// Optional {batchMsgs} turn the call into a batch call (all messages are executed atomically).
+func NewMsgSubmitCall(msg msmodule.MsMsg, uniqueID string, creator sdk.AccAddress, batchMsgs ...msmodule.MsMsg) MsgSubmitCall {
 	return MsgSubmitCall{
-		UniqueID: uniqueID,
-		Msg:      msg,
-		Creator:  creator,
+		UniqueID:  uniqueID,
+		Msg:       msg,
+		BatchMsgs: batchMsgs,
+		Creator:   creator,
 	}
 }
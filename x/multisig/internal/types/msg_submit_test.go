@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/core/msmodule"
 )
 
 // Check MsgSubmitCall ValidateBasic.
@@ -40,6 +42,28 @@ func TestMSMsg_SubmitCall_ValidateBasic(t *testing.T) {
 		target := NewMsgSubmitCall(msg, "unique", sdk.AccAddress{})
 		require.Error(t, target.ValidateBasic())
 	}
+
+	// ok: batch
+	{
+		target := NewMsgSubmitCall(NewOkMockMsMsg(), "unique", sdk.AccAddress("addr1"), NewOkMockMsMsg(), NewOkMockMsMsg())
+		require.NoError(t, target.ValidateBasic())
+	}
+
+	// fail: batch with invalid msg
+	{
+		target := NewMsgSubmitCall(NewOkMockMsMsg(), "unique", sdk.AccAddress("addr1"), NewOkMockMsMsg(), NewInvalidMockMsMsg())
+		require.Error(t, target.ValidateBasic())
+	}
+
+	// fail: batch too long
+	{
+		batchMsgs := make([]msmodule.MsMsg, 0, MaxBatchMsgs)
+		for i := 0; i < MaxBatchMsgs; i++ {
+			batchMsgs = append(batchMsgs, NewOkMockMsMsg())
+		}
+		target := NewMsgSubmitCall(NewOkMockMsMsg(), "unique", sdk.AccAddress("addr1"), batchMsgs...)
+		require.Error(t, target.ValidateBasic())
+	}
 }
 
 // Test MsgSubmitCall implements sdk.Msg interface.
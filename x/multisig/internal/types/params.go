@@ -16,11 +16,14 @@ const (
 // Parameter store key.
 var (
 	ParamStoreKeyIntervalToExecute = []byte("intervalToExecute")
+	ParamStoreKeyThresholds        = []byte("thresholds")
 )
 
 // Params defines genesis params.
 type Params struct {
 	IntervalToExecute int64 `json:"interval_to_execute" yaml:"interval_to_execute"`
+	// Per route / msg type call approval thresholds (calls without threshold require the majority of votes weight)
+	Thresholds CallThresholds `json:"thresholds" yaml:"thresholds"`
 }
 
 // Implements subspace.ParamSet interface.
@@ -29,11 +32,25 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 
 	return params.ParamSetPairs{
 		{Key: ParamStoreKeyIntervalToExecute, Value: &p.IntervalToExecute, ValidatorFn: nilValidator},
+		{Key: ParamStoreKeyThresholds, Value: &p.Thresholds, ValidatorFn: validateThresholdsParam},
 	}
 }
 
 // Equal checks params equality.
 func (p *Params) Equal(p2 Params) bool {
+	if len(p.Thresholds) != len(p2.Thresholds) {
+		return false
+	}
+	for i := range p.Thresholds {
+		t1, t2 := p.Thresholds[i], p2.Thresholds[i]
+		if t1.MsgRoute != t2.MsgRoute || t1.MsgType != t2.MsgType || !t1.Share.Equal(t2.Share) {
+			return false
+		}
+		if t1.ExecutionDelay != t2.ExecutionDelay || t1.VetoRevocations != t2.VetoRevocations {
+			return false
+		}
+	}
+
 	return p.IntervalToExecute == p2.IntervalToExecute
 }
 
@@ -43,20 +60,27 @@ func (p Params) Validate() error {
 		return fmt.Errorf("interval to execute calls should be GTE than %d", MinIntervalToExecute)
 	}
 
+	if err := p.Thresholds.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf("Params:\n"+
-		"IntervalToExecute: %d",
+		"IntervalToExecute: %d\n"+
+		"Thresholds: %s",
 		p.IntervalToExecute,
+		p.Thresholds,
 	)
 }
 
 // NewParams creates a new module Params.
-func NewParams(intervalToExecute int64) Params {
+func NewParams(intervalToExecute int64, thresholds CallThresholds) Params {
 	return Params{
 		IntervalToExecute: intervalToExecute,
+		Thresholds:        thresholds,
 	}
 }
 
@@ -64,3 +88,13 @@ func NewParams(intervalToExecute int64) Params {
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
+
+// validateThresholdsParam validates Thresholds param (used on param change proposals).
+func validateThresholdsParam(value interface{}) error {
+	thresholds, ok := value.(CallThresholds)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	return thresholds.Validate()
+}
@@ -22,4 +22,10 @@ func TestMS_Params_Valid(t *testing.T) {
 		params := Params{IntervalToExecute: MinIntervalToExecute - 1}
 		require.Error(t, params.Validate())
 	}
+
+	// fail: invalid thresholds
+	{
+		params := Params{IntervalToExecute: MinIntervalToExecute, Thresholds: CallThresholds{{MsgRoute: "route"}}}
+		require.Error(t, params.Validate())
+	}
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
@@ -12,6 +14,8 @@ const (
 	QueryCall         = "call"
 	QueryCallByUnique = "callByUnique"
 	QueryLastId       = "lastId"
+	QueryParams       = "params"
+	QueryHistory      = "history"
 )
 
 // Client request for call by call ID.
@@ -24,6 +28,58 @@ type CallByUniqueIdReq struct {
 	UniqueID string `json:"unique_id" yaml:"unique_id"`
 }
 
+// Client request for calls history (including archived calls) with filters and pagination.
+type CallsHistoryReq struct {
+	// Page number
+	Page sdk.Uint `json:"page" yaml:"page"`
+	// Items per page
+	Limit sdk.Uint `json:"limit" yaml:"limit"`
+	// Call creator filter
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	// Message route filter
+	MsgRoute string `json:"msg_route" yaml:"msg_route"`
+	// Message type filter
+	MsgType string `json:"msg_type" yaml:"msg_type"`
+	// Call state filter (active / pending / executed / failed / expired / revoked_all / vetoed / rejected)
+	State string `json:"state" yaml:"state"`
+	// Call submit BlockHeight range filter: start (0 - not limited)
+	StartHeight int64 `json:"start_height" yaml:"start_height"`
+	// Call submit BlockHeight range filter: end (0 - not limited)
+	EndHeight int64 `json:"end_height" yaml:"end_height"`
+}
+
+// NewCallsHistoryFilter returns CallsHistoryReq object with filled required fields page and limit.
+func NewCallsHistoryFilter(page, limit uint64) CallsHistoryReq {
+	return CallsHistoryReq{
+		Page:  sdk.NewUint(page),
+		Limit: sdk.NewUint(limit),
+	}
+}
+
+// Match checks if call matches request filters.
+func (r CallsHistoryReq) Match(call Call) bool {
+	if !r.Creator.Empty() && !r.Creator.Equals(call.Creator) {
+		return false
+	}
+	if r.MsgRoute != "" && r.MsgRoute != call.MsgRoute {
+		return false
+	}
+	if r.MsgType != "" && r.MsgType != call.MsgType {
+		return false
+	}
+	if r.State != "" && r.State != call.State() {
+		return false
+	}
+	if r.StartHeight > 0 && call.Height < r.StartHeight {
+		return false
+	}
+	if r.EndHeight > 0 && call.Height > r.EndHeight {
+		return false
+	}
+
+	return true
+}
+
 // Client response for last call ID.
 type LastCallIdResp struct {
 	LastID dnTypes.ID `json:"last_id" yaml:"last_id"`
@@ -39,6 +95,8 @@ type CallResp struct {
 	Call Call `json:"call" yaml:"call"`
 	// Voted accounts addresses
 	Votes Votes `json:"votes" yaml:"votes" swaggertype:"array,string"`
+	// Call is stored in the archive (block heights are not squashed)
+	Archived bool `json:"archived" yaml:"archived"`
 }
 
 func (r CallResp) String() string {
@@ -50,7 +108,7 @@ func (r CallResp) String() string {
 		}
 	}
 
-	return fmt.Sprintf("%s\nVotes: [%s]", r.Call.String(), strBuilder.String())
+	return fmt.Sprintf("%s\nVotes: [%s]\nArchived: %v", r.Call.String(), strBuilder.String(), r.Archived)
 }
 
 // Client response for multiple calls with votes.
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CallThreshold defines multisig call approval threshold for the specific route (and optionally msg type).
+type CallThreshold struct {
+	// Message route
+	MsgRoute string `json:"msg_route" yaml:"msg_route" example:"currencies"`
+	// Message type (optional, empty - any route msg type)
+	MsgType string `json:"msg_type" yaml:"msg_type" example:"issue_currency"`
+	// Share of the total validators vote weight required to approve a call (0; 1]
+	Share sdk.Dec `json:"share" yaml:"share" swaggertype:"string" example:"0.67"`
+	// Approved call execution delay (0 - call is executed at the approval block) [blocks]
+	ExecutionDelay uint32 `json:"execution_delay" yaml:"execution_delay" example:"100"`
+	// Number of revocations which veto an approved call pending execution (0 - veto disabled)
+	VetoRevocations uint32 `json:"veto_revocations" yaml:"veto_revocations" example:"2"`
+}
+
+// Validate checks CallThreshold.
+func (t CallThreshold) Validate() error {
+	if t.MsgRoute == "" {
+		return fmt.Errorf("msg_route: empty")
+	}
+	if t.Share.IsNil() || !t.Share.IsPositive() {
+		return fmt.Errorf("share: must be GT 0")
+	}
+	if t.Share.GT(sdk.OneDec()) {
+		return fmt.Errorf("share: must be LTE 1")
+	}
+	if t.VetoRevocations > 0 && t.ExecutionDelay == 0 {
+		return fmt.Errorf("veto_revocations: execution_delay must be set")
+	}
+
+	return nil
+}
+
+// RequiredWeight returns vote weight required to approve a call.
+func (t CallThreshold) RequiredWeight(totalWeight uint64) uint64 {
+	required := t.Share.MulInt64(int64(totalWeight)).Ceil().TruncateInt64()
+	if required < 1 {
+		return 1
+	}
+
+	return uint64(required)
+}
+
+func (t CallThreshold) String() string {
+	msgType := t.MsgType
+	if msgType == "" {
+		msgType = "*"
+	}
+
+	if t.ExecutionDelay == 0 {
+		return fmt.Sprintf("%s/%s: %s", t.MsgRoute, msgType, t.Share)
+	}
+
+	return fmt.Sprintf("%s/%s: %s (delay: %d, veto: %d)", t.MsgRoute, msgType, t.Share, t.ExecutionDelay, t.VetoRevocations)
+}
+
+// Slice of CallThreshold objects.
+type CallThresholds []CallThreshold
+
+// Validate checks CallThresholds.
+func (list CallThresholds) Validate() error {
+	set := make(map[string]bool, len(list))
+	for i, t := range list {
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("thresholds[%d]: %w", i, err)
+		}
+
+		key := t.MsgRoute + "/" + t.MsgType
+		if set[key] {
+			return fmt.Errorf("thresholds[%d]: %s: duplicated", i, t)
+		}
+		set[key] = true
+	}
+
+	return nil
+}
+
+// Get returns threshold for the msg route and type (msg type specific threshold has a priority over the route one).
+func (list CallThresholds) Get(msgRoute, msgType string) (CallThreshold, bool) {
+	routeIdx := -1
+	for i, t := range list {
+		if t.MsgRoute != msgRoute {
+			continue
+		}
+
+		if t.MsgType == msgType {
+			return t, true
+		}
+		if t.MsgType == "" {
+			routeIdx = i
+		}
+	}
+
+	if routeIdx >= 0 {
+		return list[routeIdx], true
+	}
+
+	return CallThreshold{}, false
+}
+
+// GetForCall returns the call approval threshold.
+// Batch call threshold is the strictest combination of its messages thresholds (max share and execution delay, min veto revocations).
+// {partial} is true if some call messages have no threshold (the default majority is required for those).
+func (list CallThresholds) GetForCall(call Call) (threshold CallThreshold, found, partial bool) {
+	for _, msg := range call.Msgs() {
+		t, ok := list.Get(msg.Route(), msg.Type())
+		if !ok {
+			partial = true
+			continue
+		}
+
+		if !found {
+			threshold, found = t, true
+			continue
+		}
+
+		if t.Share.GT(threshold.Share) {
+			threshold.Share = t.Share
+		}
+		if t.ExecutionDelay > threshold.ExecutionDelay {
+			threshold.ExecutionDelay = t.ExecutionDelay
+		}
+		if t.VetoRevocations > 0 && (threshold.VetoRevocations == 0 || t.VetoRevocations < threshold.VetoRevocations) {
+			threshold.VetoRevocations = t.VetoRevocations
+		}
+	}
+
+	return
+}
+
+func (list CallThresholds) String() string {
+	strs := make([]string, 0, len(list))
+	for _, t := range list {
+		strs = append(strs, t.String())
+	}
+
+	return "[" + strings.Join(strs, ", ") + "]"
+}
+
+// DefaultRequiredWeight returns vote weight required to approve a call without configured threshold (majority).
+func DefaultRequiredWeight(totalWeight uint64) uint64 {
+	return totalWeight/2 + 1
+}
@@ -0,0 +1,150 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+func TestMS_CallThresholds_Validate(t *testing.T) {
+	t.Parallel()
+
+	// ok
+	{
+		list := CallThresholds{
+			{MsgRoute: "route", Share: sdk.NewDecWithPrec(67, 2)},
+			{MsgRoute: "route", MsgType: "type", Share: sdk.OneDec()},
+		}
+		require.NoError(t, list.Validate())
+	}
+
+	// fail: empty route
+	{
+		list := CallThresholds{{Share: sdk.OneDec()}}
+		require.Error(t, list.Validate())
+	}
+
+	// fail: invalid share
+	{
+		require.Error(t, CallThresholds{{MsgRoute: "route"}}.Validate())
+		require.Error(t, CallThresholds{{MsgRoute: "route", Share: sdk.ZeroDec()}}.Validate())
+		require.Error(t, CallThresholds{{MsgRoute: "route", Share: sdk.NewDecWithPrec(101, 2)}}.Validate())
+	}
+
+	// fail: veto without execution delay
+	{
+		list := CallThresholds{{MsgRoute: "route", Share: sdk.OneDec(), VetoRevocations: 1}}
+		require.Error(t, list.Validate())
+
+		list[0].ExecutionDelay = 10
+		require.NoError(t, list.Validate())
+	}
+
+	// fail: duplicated
+	{
+		list := CallThresholds{
+			{MsgRoute: "route", MsgType: "type", Share: sdk.OneDec()},
+			{MsgRoute: "route", MsgType: "type", Share: sdk.NewDecWithPrec(5, 1)},
+		}
+		require.Error(t, list.Validate())
+	}
+}
+
+func TestMS_CallThresholds_Get(t *testing.T) {
+	t.Parallel()
+
+	list := CallThresholds{
+		{MsgRoute: "route1", MsgType: "type1", Share: sdk.OneDec()},
+		{MsgRoute: "route1", Share: sdk.NewDecWithPrec(5, 1)},
+		{MsgRoute: "route2", MsgType: "type1", Share: sdk.NewDecWithPrec(25, 2)},
+	}
+
+	// msg type threshold
+	{
+		threshold, found := list.Get("route1", "type1")
+		require.True(t, found)
+		require.True(t, threshold.Share.Equal(sdk.OneDec()))
+	}
+
+	// route threshold
+	{
+		threshold, found := list.Get("route1", "type2")
+		require.True(t, found)
+		require.True(t, threshold.Share.Equal(sdk.NewDecWithPrec(5, 1)))
+	}
+
+	// not found
+	{
+		_, found := list.Get("route2", "type2")
+		require.False(t, found)
+
+		_, found = list.Get("route3", "type1")
+		require.False(t, found)
+	}
+}
+
+func TestMS_CallThreshold_RequiredWeight(t *testing.T) {
+	t.Parallel()
+
+	threshold := CallThreshold{MsgRoute: "route", Share: sdk.NewDecWithPrec(67, 2)}
+	require.EqualValues(t, 1, threshold.RequiredWeight(0))
+	require.EqualValues(t, 1, threshold.RequiredWeight(1))
+	require.EqualValues(t, 3, threshold.RequiredWeight(3))
+	require.EqualValues(t, 7, threshold.RequiredWeight(10))
+
+	threshold.Share = sdk.OneDec()
+	require.EqualValues(t, 10, threshold.RequiredWeight(10))
+
+	require.EqualValues(t, 1, DefaultRequiredWeight(1))
+	require.EqualValues(t, 2, DefaultRequiredWeight(3))
+	require.EqualValues(t, 3, DefaultRequiredWeight(4))
+}
+
+func TestMS_CallThresholds_GetForCall(t *testing.T) {
+	t.Parallel()
+
+	list := CallThresholds{
+		{MsgRoute: "route1", Share: sdk.NewDecWithPrec(5, 1), ExecutionDelay: 10, VetoRevocations: 3},
+		{MsgRoute: "route2", Share: sdk.NewDecWithPrec(75, 2), ExecutionDelay: 5, VetoRevocations: 2},
+	}
+	addr := sdk.AccAddress("addr1")
+
+	// single msg call
+	{
+		call, err := NewCall(dnTypes.NewZeroID(), "unique", NewMockMsMsg("route1", "type", true), 0, addr)
+		require.NoError(t, err)
+
+		threshold, found, partial := list.GetForCall(call)
+		require.True(t, found)
+		require.False(t, partial)
+		require.True(t, threshold.Share.Equal(sdk.NewDecWithPrec(5, 1)))
+	}
+
+	// batch call: the strictest combination
+	{
+		call, err := NewCall(dnTypes.NewZeroID(), "unique", NewMockMsMsg("route1", "type", true), 0, addr, NewMockMsMsg("route2", "type", true))
+		require.NoError(t, err)
+
+		threshold, found, partial := list.GetForCall(call)
+		require.True(t, found)
+		require.False(t, partial)
+		require.True(t, threshold.Share.Equal(sdk.NewDecWithPrec(75, 2)))
+		require.EqualValues(t, 10, threshold.ExecutionDelay)
+		require.EqualValues(t, 2, threshold.VetoRevocations)
+	}
+
+	// batch call: partial
+	{
+		call, err := NewCall(dnTypes.NewZeroID(), "unique", NewMockMsMsg("route3", "type", true), 0, addr, NewMockMsMsg("route2", "type", true))
+		require.NoError(t, err)
+
+		_, found, partial := list.GetForCall(call)
+		require.True(t, found)
+		require.True(t, partial)
+	}
+}
@@ -4,3 +4,15 @@ import "github.com/cosmos/cosmos-sdk/types"
 
 // Votes is an addresses array of call voters.
 type Votes []types.AccAddress
+
+// Remove returns a new Votes object without {address} vote.
+func (v Votes) Remove(address types.AccAddress) Votes {
+	votes := make(Votes, 0, len(v))
+	for _, vote := range v {
+		if !vote.Equals(address) {
+			votes = append(votes, vote)
+		}
+	}
+
+	return votes
+}
@@ -16,6 +16,7 @@ func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
 	if err := k.SetCurrentPrices(ctx); err != nil {
 		panic(err.Error())
 	}
+	k.ProcessLiveness(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
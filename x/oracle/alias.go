@@ -25,6 +25,17 @@ type (
 	MsgAddAsset        = types.MsgAddAsset
 	MsgSetAsset        = types.MsgSetAsset
 	PostPriceParams    = types.PostPriceParams
+	PostedPrices       = types.PostedPrices
+	AggregationParams  = types.AggregationParams
+	PriceAggregation   = types.PriceAggregation
+	PriceHistoryParams = types.PriceHistoryParams
+	HistoricalPrice    = types.HistoricalPrice
+	HistoricalPrices   = types.HistoricalPrices
+	PriceTWAP          = types.PriceTWAP
+	LivenessParams     = types.LivenessParams
+	OracleLiveness     = types.OracleLiveness
+	OracleLivenesses   = types.OracleLivenesses
+	AssetLiveness      = types.AssetLiveness
 )
 
 const (
@@ -36,21 +47,39 @@ const (
 	QueryAssets    = types.QueryAssets
 	QueryRawPrices = types.QueryRawPrices
 	QueryPrice     = types.QueryPrice
+	//
+	QueryPriceAtHeight = types.QueryPriceAtHeight
+	QueryPriceHistory  = types.QueryPriceHistory
+	QueryPriceTWAP     = types.QueryPriceTWAP
+	QueryLiveness      = types.QueryLiveness
 	// Event types, attribute types and values
-	EventTypePrice = types.EventTypePrice
+	EventTypePrice             = types.EventTypePrice
+	EventTypePriceStale        = types.EventTypePriceStale
+	EventTypeOracleDeactivated = types.EventTypeOracleDeactivated
+	EventTypeOracleReactivated = types.EventTypeOracleReactivated
 	//
-	AttributeAssetCode  = types.AttributeAssetCode
-	AttributeAskPrice   = types.AttributeAskPrice
-	AttributeBidPrice   = types.AttributeBidPrice
-	AttributeReceivedAt = types.AttributeReceivedAt
+	AttributeAssetCode     = types.AttributeAssetCode
+	AttributeAskPrice      = types.AttributeAskPrice
+	AttributeBidPrice      = types.AttributeBidPrice
+	AttributeReceivedAt    = types.AttributeReceivedAt
+	AttributeStale         = types.AttributeStale
+	AttributeOracleAddress = types.AttributeOracleAddress
+	AttributeMissedBlocks  = types.AttributeMissedBlocks
 	//
 	PricePrecision = types.PricePrecision
+	//
+	PriceAggregationMedian         = types.PriceAggregationMedian
+	PriceAggregationTrimmedMean    = types.PriceAggregationTrimmedMean
+	PriceAggregationVolumeWeighted = types.PriceAggregationVolumeWeighted
+	PriceAggregationTWAP           = types.PriceAggregationTWAP
 )
 
 var (
 	// variable aliases
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
+	KeyPriceHistory      = types.KeyPriceHistory
+	KeyLiveness          = types.KeyLiveness
 	// functions aliases
 	RegisterCodec       = types.RegisterCodec
 	NewKeeper           = keeper.NewKeeper
@@ -71,4 +100,8 @@ var (
 	ErrExistingAsset = types.ErrExistingAsset
 	ErrInvalidAsset  = types.ErrInvalidAsset
 	ErrInvalidOracle = types.ErrInvalidOracle
+	//
+	ErrInvalidAggregation   = types.ErrInvalidAggregation
+	ErrPriceHistoryNotFound = types.ErrPriceHistoryNotFound
+	ErrOracleDeactivated    = types.ErrOracleDeactivated
 )
@@ -133,3 +133,169 @@ func GetCmdAssets(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdPriceAtHeight returns query command that returns the asset price actual at specific block height.
+func GetCmdPriceAtHeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-at [assetCode] [blockHeight]",
+		Short: "Get the asset price actual at specific block height (from the price history)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			assetCode, err := helpers.ParseAssetCodeParam("assetCode", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			blockHeight, err := helpers.ParseUint64Param("blockHeight", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d", queryRoute, types.QueryPriceAtHeight, assetCode, blockHeight), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.HistoricalPrice
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code symbol",
+		"block height [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdPriceHistory returns query command that returns the asset price history for blocks range.
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [assetCode] [startHeight] [endHeight]",
+		Short: "Get the asset price changes within block heights range (inclusive)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			assetCode, err := helpers.ParseAssetCodeParam("assetCode", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := helpers.ParseUint64Param("startHeight", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := helpers.ParseUint64Param("endHeight", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d/%d", queryRoute, types.QueryPriceHistory, assetCode, startHeight, endHeight), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.HistoricalPrices
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code symbol",
+		"range start block height [uint]",
+		"range end block height [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdPriceTWAP returns query command that returns the asset time-weighted average price for blocks range.
+func GetCmdPriceTWAP(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [assetCode] [startHeight] [endHeight]",
+		Short: "Get the asset time-weighted average price within block heights range (inclusive)",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			assetCode, err := helpers.ParseAssetCodeParam("assetCode", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := helpers.ParseUint64Param("startHeight", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := helpers.ParseUint64Param("endHeight", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d/%d", queryRoute, types.QueryPriceTWAP, assetCode, startHeight, endHeight), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.PriceTWAP
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code symbol",
+		"window start block height [uint]",
+		"window end block height [uint]",
+	})
+
+	return cmd
+}
+
+// GetCmdLiveness returns query command that returns the asset price freshness and its oracles liveness.
+func GetCmdLiveness(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness [assetCode]",
+		Short: "Get the asset last price update height and its oracles liveness (missed blocks, deactivation)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			assetCode, err := helpers.ParseAssetCodeParam("assetCode", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryLiveness, assetCode), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.AssetLiveness
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code symbol",
+	})
+
+	return cmd
+}
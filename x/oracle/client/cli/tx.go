@@ -7,11 +7,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/oracle/internal/types"
 )
 
+const (
+	flagVolume          = "volume"
+	flagAggregation     = "aggregation"
+	flagMinOracles      = "min-oracles"
+	flagMaxDeviationBps = "max-deviation-bps"
+	flagTrimPct         = "trim-pct"
+	flagTWAPBlocks      = "twap-blocks"
+)
+
 // GetCmdPostPrice returns tx command for posting price for a particular asset.
 func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -48,8 +58,14 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			volume, err := helpers.ParseSdkIntParam(flagVolume, viper.GetString(flagVolume), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
 			// prepare and send message
 			msg := types.NewMsgPostPrice(fromAddr, assetCode, askPrice, bidPrice, receivedAt)
+			msg.Volume = volume
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -63,6 +79,7 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		"bidPrice [int]",
 		"price received at UNIX timestamp in seconds [int]",
 	})
+	cmd.Flags().String(flagVolume, "0", "(optional) traded volume the price is based on [int]")
 
 	return cmd
 }
@@ -178,6 +195,7 @@ func GetCmdAddAsset(cdc *codec.Codec) *cobra.Command {
 
 			// prepare and send message
 			asset := types.NewAsset(assetCode, oracles, true)
+			asset.Aggregation = parseAggregationFlags()
 			if err := asset.ValidateBasic(); err != nil {
 				return err
 			}
@@ -191,6 +209,7 @@ func GetCmdAddAsset(cdc *codec.Codec) *cobra.Command {
 		"asset code symbol",
 		"comma separated list of oracle addresses",
 	})
+	addAggregationFlags(cmd)
 
 	return cmd
 }
@@ -226,6 +245,7 @@ func GetCmdSetAsset(cdc *codec.Codec) *cobra.Command {
 
 			// prepare and send message
 			asset := types.NewAsset(assetCode, oracles, true)
+			asset.Aggregation = parseAggregationFlags()
 			if err := asset.ValidateBasic(); err != nil {
 				return err
 			}
@@ -241,5 +261,27 @@ func GetCmdSetAsset(cdc *codec.Codec) *cobra.Command {
 		"comma separated list of oracle addresses",
 	})
 
+	addAggregationFlags(cmd)
+
 	return cmd
 }
+
+// addAggregationFlags adds asset price aggregation params flags.
+func addAggregationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAggregation, types.PriceAggregationMedian.String(), "(optional) price aggregation strategy (median / trimmed_mean / volume_weighted / twap)")
+	cmd.Flags().Uint32(flagMinOracles, 0, "(optional) min number of valid raw prices required to update the price (0 - disabled)")
+	cmd.Flags().Uint32(flagMaxDeviationBps, 0, "(optional) max raw price deviation from the median in basis points (0 - disabled)")
+	cmd.Flags().Uint32(flagTrimPct, 0, "(optional) percent of the lowest / highest raw prices dropped by trimmed_mean strategy")
+	cmd.Flags().Uint32(flagTWAPBlocks, 0, "(optional) number of blocks used by twap strategy")
+}
+
+// parseAggregationFlags builds asset price aggregation params from flags (validated by the asset).
+func parseAggregationFlags() types.AggregationParams {
+	return types.AggregationParams{
+		Strategy:        types.PriceAggregation(viper.GetString(flagAggregation)),
+		MinOracles:      viper.GetUint32(flagMinOracles),
+		MaxDeviationBps: viper.GetUint32(flagMaxDeviationBps),
+		TrimPct:         viper.GetUint32(flagTrimPct),
+		TWAPBlocks:      viper.GetUint32(flagTWAPBlocks),
+	}
+}
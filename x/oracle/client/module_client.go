@@ -21,6 +21,10 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 		cli.GetCmdCurrentPrice(types.ModuleName, cdc),
 		cli.GetCmdRawPrices(types.ModuleName, cdc),
 		cli.GetCmdAssets(types.ModuleName, cdc),
+		cli.GetCmdPriceAtHeight(types.ModuleName, cdc),
+		cli.GetCmdPriceHistory(types.ModuleName, cdc),
+		cli.GetCmdPriceTWAP(types.ModuleName, cdc),
+		cli.GetCmdLiveness(types.ModuleName, cdc),
 		cli.GetCmdAssetCodeHex(),
 	)...)
 
@@ -17,6 +17,8 @@ import (
 const (
 	assetCodeKey   = "assetCode"
 	blockHeightKey = "blockHeight"
+	startHeightKey = "startHeight"
+	endHeightKey   = "endHeight"
 )
 
 type PostPriceReq struct {
@@ -27,6 +29,8 @@ type PostPriceReq struct {
 	AskPrice string `json:"ask_price" example:"100"`
 	// BidPrice in sdk.Int format
 	BidPrice string `json:"bid_price" example:"99"`
+	// Traded volume in sdk.Int format (optional)
+	Volume string `json:"volume" example:"1000"`
 	// Timestamp price createdAt
 	ReceivedAt string `json:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
 }
@@ -37,6 +41,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 	r.HandleFunc(fmt.Sprintf("/%s/rawprices/{%s}/{%s}", storeName, assetCodeKey, blockHeightKey), getRawPricesHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/currentprice/{%s}", storeName, assetCodeKey), getCurrentPriceHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/assets", storeName), getAssetsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pricehistory/{%s}/{%s}", storeName, assetCodeKey, blockHeightKey), getPriceAtHeightHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pricehistory/{%s}/{%s}/{%s}", storeName, assetCodeKey, startHeightKey, endHeightKey), getPriceHistoryHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/twap/{%s}/{%s}/{%s}", storeName, assetCodeKey, startHeightKey, endHeightKey), getPriceTWAPHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/liveness/{%s}", storeName, assetCodeKey), getLivenessHandler(cliCtx, storeName)).Methods("GET")
 }
 
 // PostPrice godoc
@@ -95,8 +103,17 @@ func postPriceHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		volume := sdk.ZeroInt()
+		if req.Volume != "" {
+			if volume, err = helpers.ParseSdkIntParam("volume", req.Volume, helpers.ParamTypeRestRequest); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
 		// create the message
 		msg := types.NewMsgPostPrice(addr, assetCode, askPrice, bidPrice, receivedAt)
+		msg.Volume = volume
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -226,3 +243,173 @@ func getAssetsHandler(cliCtx context.CLIContext, storeName string) http.HandlerF
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// GetPriceAtHeight godoc
+// @Tags Oracle
+// @Summary Get historical Price
+// @Description Get Price actual at the block height by assetCode (from the price history)
+// @ID oracleGetPriceAtHeight
+// @Accept  json
+// @Produce json
+// @Param assetCode path string true "asset code"
+// @Param blockHeight path int true "block height"
+// @Success 200 {object} OracleRespGetPriceAtHeight
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/pricehistory/{assetCode}/{blockHeight} [get]
+func getPriceAtHeightHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		assetCode, err := helpers.ParseAssetCodeParam("assetCode", vars[assetCodeKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		blockHeight, err := helpers.ParseUint64Param(blockHeightKey, vars[blockHeightKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d", storeName, types.QueryPriceAtHeight, assetCode, blockHeight), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetPriceHistory godoc
+// @Tags Oracle
+// @Summary Get Price history
+// @Description Get Price changes within the block heights range (inclusive) by assetCode
+// @ID oracleGetPriceHistory
+// @Accept  json
+// @Produce json
+// @Param assetCode path string true "asset code"
+// @Param startHeight path int true "range start block height"
+// @Param endHeight path int true "range end block height"
+// @Success 200 {object} OracleRespGetPriceHistory
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/pricehistory/{assetCode}/{startHeight}/{endHeight} [get]
+func getPriceHistoryHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryPriceRangeHandler(w, r, cliCtx, storeName, types.QueryPriceHistory)
+	}
+}
+
+// GetPriceTWAP godoc
+// @Tags Oracle
+// @Summary Get Price TWAP
+// @Description Get time-weighted average Price within the block heights range (inclusive) by assetCode
+// @ID oracleGetPriceTWAP
+// @Accept  json
+// @Produce json
+// @Param assetCode path string true "asset code"
+// @Param startHeight path int true "window start block height"
+// @Param endHeight path int true "window end block height"
+// @Success 200 {object} OracleRespGetPriceTWAP
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/twap/{assetCode}/{startHeight}/{endHeight} [get]
+func getPriceTWAPHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryPriceRangeHandler(w, r, cliCtx, storeName, types.QueryPriceTWAP)
+	}
+}
+
+// GetLiveness godoc
+// @Tags Oracle
+// @Summary Get asset liveness
+// @Description Get asset last price update height and its oracles liveness (missed blocks, deactivation) by assetCode
+// @ID oracleGetLiveness
+// @Accept  json
+// @Produce json
+// @Param assetCode path string true "asset code"
+// @Success 200 {object} OracleRespGetLiveness
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 404 {object} rest.ErrorResponse "Returned if requested data wasn't found"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /oracle/liveness/{assetCode} [get]
+func getLivenessHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs and prepare request
+		vars := mux.Vars(r)
+
+		assetCode, err := helpers.ParseAssetCodeParam("assetCode", vars[assetCodeKey], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QueryLiveness, assetCode), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryPriceRangeHandler parses {assetCode}/{startHeight}/{endHeight} path args and sends the price history range query.
+func queryPriceRangeHandler(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, storeName, queryPath string) {
+	// parse inputs and prepare request
+	vars := mux.Vars(r)
+
+	assetCode, err := helpers.ParseAssetCodeParam("assetCode", vars[assetCodeKey], helpers.ParamTypeRestPath)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	startHeight, err := helpers.ParseUint64Param(startHeightKey, vars[startHeightKey], helpers.ParamTypeRestPath)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	endHeight, err := helpers.ParseUint64Param(endHeightKey, vars[endHeightKey], helpers.ParamTypeRestPath)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	// send request and process response
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s/%d/%d", storeName, queryPath, assetCode, startHeight, endHeight), nil)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+
+	rest.PostProcessResponse(w, cliCtx, res)
+}
@@ -20,4 +20,24 @@ type (
 		Height int64        `json:"height"`
 		Result types.Assets `json:"result"`
 	}
+
+	OracleRespGetPriceAtHeight struct {
+		Height int64                 `json:"height"`
+		Result types.HistoricalPrice `json:"result"`
+	}
+
+	OracleRespGetPriceHistory struct {
+		Height int64                  `json:"height"`
+		Result types.HistoricalPrices `json:"result"`
+	}
+
+	OracleRespGetPriceTWAP struct {
+		Height int64           `json:"height"`
+		Result types.PriceTWAP `json:"result"`
+	}
+
+	OracleRespGetLiveness struct {
+		Height int64               `json:"height"`
+		Result types.AssetLiveness `json:"result"`
+	}
 )
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// aggregateRawPrices combines current block raw prices into the asset price using the asset aggregation params.
+// Outliers are dropped before the aggregation, ok is false if the number of valid raw prices is below the quorum.
+func (k Keeper) aggregateRawPrices(ctx sdk.Context, asset types.Asset) (askPrice, bidPrice sdk.Int, receivedAt time.Time, ok bool) {
+	params := asset.Aggregation
+
+	rawPrices, ok := k.getValidRawPrices(ctx, asset, ctx.BlockHeight())
+	if !ok {
+		return
+	}
+
+	switch params.Strategy {
+	case types.PriceAggregationTrimmedMean:
+		askPrice, bidPrice = rawPrices.TrimmedMean(params.TrimPct)
+		receivedAt = rawPrices.LastReceivedAt()
+	case types.PriceAggregationVolumeWeighted:
+		askPrice, bidPrice, ok = rawPrices.VolumeWeighted()
+		receivedAt = rawPrices.LastReceivedAt()
+	case types.PriceAggregationTWAP:
+		askPrice, bidPrice = k.getTWAPPrice(ctx, asset)
+		receivedAt = rawPrices.LastReceivedAt()
+	default:
+		askPrice, bidPrice, receivedAt = k.getMedianPrice(ctx, rawPrices)
+	}
+
+	return
+}
+
+// getValidRawPrices returns blockHeight raw prices without outliers, ok is false if the quorum is not reached.
+func (k Keeper) getValidRawPrices(ctx sdk.Context, asset types.Asset, blockHeight int64) (types.PostedPrices, bool) {
+	params := asset.Aggregation
+
+	rawPrices := types.PostedPrices(k.GetRawPrices(ctx, asset.AssetCode, blockHeight))
+	rawPrices = rawPrices.FilterByDeviation(params.MaxDeviationBps)
+	if len(rawPrices) == 0 || len(rawPrices) < int(params.MinOracles) {
+		return nil, false
+	}
+
+	return rawPrices, true
+}
+
+// getMedianPrice returns median ask / bid prices.
+// ReceivedAt is taken from the median price (or it is the current block time for an even number of prices).
+func (k Keeper) getMedianPrice(ctx sdk.Context, rawPrices types.PostedPrices) (askPrice, bidPrice sdk.Int, receivedAt time.Time) {
+	askPrice, bidPrice = rawPrices.Median()
+
+	l := len(rawPrices)
+	switch {
+	case l == 1:
+		receivedAt = rawPrices[0].ReceivedAt
+	case l%2 == 0:
+		receivedAt = ctx.BlockTime().UTC()
+	default:
+		askRawPrices := make(types.PostedPrices, l)
+		copy(askRawPrices, rawPrices)
+		sort.Slice(askRawPrices, func(i, j int) bool {
+			return askRawPrices[i].AskPrice.LT(askRawPrices[j].AskPrice)
+		})
+		receivedAt = askRawPrices[l/2].ReceivedAt
+	}
+
+	return
+}
+
+// getTWAPPrice returns mean of per block median prices over the last asset TWAPBlocks blocks.
+// Blocks without valid raw prices (quorum not reached) are skipped.
+// Contract: current block has valid raw prices.
+func (k Keeper) getTWAPPrice(ctx sdk.Context, asset types.Asset) (askPrice, bidPrice sdk.Int) {
+	askSum, bidSum, blocksCnt := sdk.ZeroInt(), sdk.ZeroInt(), int64(0)
+
+	endHeight := ctx.BlockHeight()
+	startHeight := endHeight - int64(asset.Aggregation.TWAPBlocks) + 1
+	if startHeight < 0 {
+		startHeight = 0
+	}
+
+	for height := startHeight; height <= endHeight; height++ {
+		rawPrices, ok := k.getValidRawPrices(ctx, asset, height)
+		if !ok {
+			continue
+		}
+
+		blockAskPrice, blockBidPrice := rawPrices.Median()
+		askSum, bidSum = askSum.Add(blockAskPrice), bidSum.Add(blockBidPrice)
+		blocksCnt++
+	}
+
+	return askSum.QuoRaw(blocksCnt), bidSum.QuoRaw(blocksCnt)
+}
@@ -0,0 +1,138 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// setAggregationParams overwrites the std asset aggregation params.
+func (input *TestInput) setAggregationParams(aggParams types.AggregationParams) {
+	params := input.keeper.GetParams(input.ctx)
+	params.Assets[0].Aggregation = aggParams
+	input.keeper.SetParams(input.ctx, params)
+}
+
+// postPrices sets raw prices (bidPrice = askPrice - 1) for the current block using different oracles.
+func (input *TestInput) postPrices(t *testing.T, ctx sdk.Context, askPrices []int64, volumes []int64) {
+	for i, askPrice := range askPrices {
+		volume := sdk.ZeroInt()
+		if volumes != nil {
+			volume = sdk.NewInt(volumes[i])
+		}
+
+		_, err := input.keeper.SetPrice(ctx, input.addresses[i], input.stdAssetCode, sdk.NewInt(askPrice), sdk.NewInt(askPrice-1), volume, ctx.BlockTime())
+		require.NoError(t, err)
+	}
+}
+
+// Check SetCurrentPrices quorum and outliers filter.
+func TestOracleKeeper_Aggregation_Filters(t *testing.T) {
+	t.Parallel()
+
+	// quorum not reached
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{MinOracles: 3})
+		input.postPrices(t, input.ctx, []int64{100, 110}, nil)
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		require.Empty(t, input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode).AssetCode)
+	}
+
+	// quorum reached
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{MinOracles: 3})
+		input.postPrices(t, input.ctx, []int64{100, 110, 120}, nil)
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		price := input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(110)))
+		require.True(t, price.BidPrice.Equal(sdk.NewInt(109)))
+	}
+
+	// outlier dropped (quorum is not reached after filtering)
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{MinOracles: 4, MaxDeviationBps: 1000})
+		input.postPrices(t, input.ctx, []int64{100, 102, 104, 200}, nil)
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		require.Empty(t, input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode).AssetCode)
+	}
+
+	// outlier dropped
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{MinOracles: 3, MaxDeviationBps: 1000})
+		input.postPrices(t, input.ctx, []int64{100, 102, 104, 200}, nil)
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		price := input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(102)))
+		require.True(t, price.BidPrice.Equal(sdk.NewInt(101)))
+	}
+}
+
+// Check SetCurrentPrices with different aggregation strategies.
+func TestOracleKeeper_Aggregation_Strategies(t *testing.T) {
+	t.Parallel()
+
+	// trimmed mean
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{Strategy: types.PriceAggregationTrimmedMean, TrimPct: 25})
+		input.postPrices(t, input.ctx, []int64{10, 100, 110, 1000}, nil)
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		price := input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(105)))
+		require.True(t, price.BidPrice.Equal(sdk.NewInt(104)))
+	}
+
+	// volume weighted
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{Strategy: types.PriceAggregationVolumeWeighted})
+		input.postPrices(t, input.ctx, []int64{101, 201, 1000}, []int64{1, 3, 0})
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		price := input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(176)))
+		require.True(t, price.BidPrice.Equal(sdk.NewInt(175)))
+	}
+
+	// volume weighted (no volumes)
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{Strategy: types.PriceAggregationVolumeWeighted})
+		input.postPrices(t, input.ctx, []int64{100, 200}, nil)
+
+		require.NoError(t, input.keeper.SetCurrentPrices(input.ctx))
+		require.Empty(t, input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode).AssetCode)
+	}
+
+	// twap
+	{
+		input := NewTestInput(t)
+		input.setAggregationParams(types.AggregationParams{Strategy: types.PriceAggregationTWAP, TWAPBlocks: 3})
+
+		blockPrices := [][]int64{{1000}, {100, 120}, nil, {300}}
+		for i, prices := range blockPrices {
+			ctx := input.ctx.WithBlockHeight(int64(i + 1))
+			input.postPrices(t, ctx, prices, nil)
+			require.NoError(t, input.keeper.SetCurrentPrices(ctx))
+		}
+
+		// blocks [2; 4] are used, block 3 has no prices
+		price := input.keeper.GetCurrentPrice(input.ctx, input.stdAssetCode)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(205)))
+		require.True(t, price.BidPrice.Equal(sdk.NewInt(204)))
+	}
+}
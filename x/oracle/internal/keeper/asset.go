@@ -46,6 +46,7 @@ func (k Keeper) SetAsset(ctx sdk.Context, nominee string, asset types.Asset) err
 		params := k.GetParams(ctx)
 		params.Assets = updateAssets
 		k.SetParams(ctx, params)
+		k.resetOraclesLiveness(ctx, asset.AssetCode)
 		return nil
 	}
 
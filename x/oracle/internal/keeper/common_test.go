@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -53,6 +54,11 @@ type TestInput struct {
 }
 
 // NewTestInput returned mocked object for testing
+// deleteParam removes the oracle param from the params store (emulates a chain upgraded without the param).
+func (input *TestInput) deleteParam(key []byte) {
+	prefix.NewStore(input.ctx.KVStore(input.keyParams), []byte(types.DefaultParamspace+"/")).Delete(key)
+}
+
 func NewTestInput(t *testing.T) TestInput {
 	input := TestInput{
 		cdc:        codec.New(),
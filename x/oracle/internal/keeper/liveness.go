@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// GetOracleLiveness returns oracle liveness for specific assetCode (new object is returned if not tracked yet).
+func (k Keeper) GetOracleLiveness(ctx sdk.Context, assetCode dnTypes.AssetCode, address sdk.AccAddress) types.OracleLiveness {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOracleLivenessKey(assetCode, address))
+	if bz == nil {
+		return types.NewOracleLiveness(assetCode, address)
+	}
+
+	liveness := types.OracleLiveness{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &liveness)
+
+	return liveness
+}
+
+// GetAssetLiveness returns asset price freshness and its oracles liveness.
+func (k Keeper) GetAssetLiveness(ctx sdk.Context, assetCode dnTypes.AssetCode) types.AssetLiveness {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOracleLivenessPrefix(assetCode))
+	defer iterator.Close()
+
+	oracles := types.OracleLivenesses{}
+	for ; iterator.Valid(); iterator.Next() {
+		liveness := types.OracleLiveness{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &liveness)
+		oracles = append(oracles, liveness)
+	}
+
+	lastPriceHeight, _ := k.getLastPriceHeight(ctx, assetCode)
+
+	return types.AssetLiveness{
+		AssetCode:       assetCode,
+		LastPriceHeight: lastPriceHeight,
+		Oracles:         oracles,
+	}
+}
+
+// ProcessLiveness updates oracles missed blocks counters and asset prices stale state.
+// Oracles missed more than MaxMissedBlocks are deactivated, prices not updated for StalePriceBlocks are marked as stale.
+func (k Keeper) ProcessLiveness(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	params := k.GetLivenessParams(ctx)
+	for _, asset := range k.GetAssetParams(ctx) {
+		k.processOraclesLiveness(ctx, asset, params.MaxMissedBlocks)
+		k.processPriceLiveness(ctx, asset.AssetCode, params.StalePriceBlocks)
+	}
+}
+
+// processOraclesLiveness updates asset oracles liveness using the current block raw prices.
+func (k Keeper) processOraclesLiveness(ctx sdk.Context, asset types.Asset, maxMissedBlocks uint32) {
+	posted := make(map[string]bool)
+	for _, rawPrice := range k.GetRawPrices(ctx, asset.AssetCode, ctx.BlockHeight()) {
+		posted[rawPrice.OracleAddress.String()] = true
+	}
+
+	for _, oracle := range asset.Oracles {
+		liveness := k.GetOracleLiveness(ctx, asset.AssetCode, oracle.Address)
+		if liveness.Deactivated {
+			continue
+		}
+
+		if posted[oracle.Address.String()] {
+			liveness.LastPostedHeight = ctx.BlockHeight()
+			liveness.MissedBlocks = 0
+		} else {
+			liveness.MissedBlocks++
+			if maxMissedBlocks > 0 && liveness.MissedBlocks > maxMissedBlocks {
+				liveness.Deactivated = true
+				ctx.EventManager().EmitEvent(types.NewOracleDeactivatedEvent(liveness))
+			}
+		}
+
+		k.setOracleLiveness(ctx, liveness)
+	}
+}
+
+// processPriceLiveness updates asset current price stale state.
+func (k Keeper) processPriceLiveness(ctx sdk.Context, assetCode dnTypes.AssetCode, stalePriceBlocks uint32) {
+	price := k.GetCurrentPrice(ctx, assetCode)
+	if price.AssetCode == "" {
+		return
+	}
+
+	// price set by genesis: start tracking from the current block
+	lastPriceHeight, found := k.getLastPriceHeight(ctx, assetCode)
+	if !found {
+		k.setLastPriceHeight(ctx, assetCode, ctx.BlockHeight())
+		return
+	}
+
+	stale := stalePriceBlocks > 0 && ctx.BlockHeight()-lastPriceHeight >= int64(stalePriceBlocks)
+	if stale == price.Stale {
+		return
+	}
+
+	price.Stale = stale
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCurrentPriceKey(assetCode), k.cdc.MustMarshalBinaryBare(price))
+
+	ctx.EventManager().EmitEvent(types.NewPriceStaleEvent(assetCode, stale))
+}
+
+// resetOraclesLiveness removes asset oracles liveness (reactivating deactivated ones).
+func (k Keeper) resetOraclesLiveness(ctx sdk.Context, assetCode dnTypes.AssetCode) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetOracleLivenessPrefix(assetCode))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		liveness := types.OracleLiveness{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &liveness)
+		if liveness.Deactivated {
+			ctx.EventManager().EmitEvent(types.NewOracleReactivatedEvent(liveness))
+		}
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// setOracleLiveness sets oracle liveness to the storage.
+func (k Keeper) setOracleLiveness(ctx sdk.Context, liveness types.OracleLiveness) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOracleLivenessKey(liveness.AssetCode, liveness.OracleAddress), k.cdc.MustMarshalBinaryBare(liveness))
+}
+
+// getLastPriceHeight returns the last valid raw prices aggregation block height for the asset.
+func (k Keeper) getLastPriceHeight(ctx sdk.Context, assetCode dnTypes.AssetCode) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastPriceHeightKey(assetCode))
+	if bz == nil {
+		return 0, false
+	}
+
+	var height int64
+	k.cdc.MustUnmarshalBinaryBare(bz, &height)
+
+	return height, true
+}
+
+// setLastPriceHeight sets the last valid raw prices aggregation block height for the asset.
+func (k Keeper) setLastPriceHeight(ctx sdk.Context, assetCode dnTypes.AssetCode, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastPriceHeightKey(assetCode), k.cdc.MustMarshalBinaryBare(height))
+}
@@ -0,0 +1,146 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// Check oracles deactivation and asset price stale state tracking.
+func TestOracleKeeper_Liveness(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, assetCode := input.keeper, input.stdAssetCode
+	oracle1, oracle2 := input.addresses[0], input.addresses[1]
+
+	params := keeper.GetParams(input.ctx)
+	params.Liveness = types.LivenessParams{MaxMissedBlocks: 2, StalePriceBlocks: 3}
+	keeper.SetParams(input.ctx, params)
+	require.NoError(t, keeper.SetOracles(input.ctx, input.stdNominee, assetCode, types.Oracles{types.NewOracle(oracle1), types.NewOracle(oracle2)}))
+
+	// endBlock emulates the module EndBlocker for the blockHeight
+	endBlock := func(blockHeight int64, postOracles ...sdk.AccAddress) sdk.Context {
+		ctx := input.ctx.WithBlockHeight(blockHeight).WithEventManager(sdk.NewEventManager())
+		for _, oracle := range postOracles {
+			_, err := keeper.SetPrice(ctx, oracle, assetCode, sdk.NewInt(100), sdk.NewInt(99), sdk.ZeroInt(), ctx.BlockTime())
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, keeper.SetCurrentPrices(ctx))
+		keeper.ProcessLiveness(ctx)
+
+		return ctx
+	}
+
+	countEvents := func(ctx sdk.Context, eventType string) int {
+		cnt := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == eventType {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	// block 1: oracle1 posted
+	{
+		endBlock(1, oracle1)
+
+		liveness1 := keeper.GetOracleLiveness(input.ctx, assetCode, oracle1)
+		require.EqualValues(t, 1, liveness1.LastPostedHeight)
+		require.EqualValues(t, 0, liveness1.MissedBlocks)
+
+		liveness2 := keeper.GetOracleLiveness(input.ctx, assetCode, oracle2)
+		require.EqualValues(t, 0, liveness2.LastPostedHeight)
+		require.EqualValues(t, 1, liveness2.MissedBlocks)
+	}
+
+	// block 2, 3: oracle2 deactivated
+	{
+		endBlock(2)
+		ctx := endBlock(3)
+		require.Equal(t, 1, countEvents(ctx, types.EventTypeOracleDeactivated))
+
+		require.False(t, keeper.GetOracleLiveness(input.ctx, assetCode, oracle1).Deactivated)
+		require.True(t, keeper.GetOracleLiveness(input.ctx, assetCode, oracle2).Deactivated)
+		require.False(t, keeper.GetCurrentPrice(input.ctx, assetCode).Stale)
+
+		err := keeper.ValidatePostPrice(input.ctx, types.NewMsgPostPrice(oracle2, assetCode, sdk.NewInt(100), sdk.NewInt(99), input.ctx.BlockTime()))
+		require.Error(t, err)
+		require.True(t, types.ErrOracleDeactivated.Is(err))
+	}
+
+	// block 4: oracle1 deactivated, price is stale
+	{
+		ctx := endBlock(4)
+		require.Equal(t, 1, countEvents(ctx, types.EventTypeOracleDeactivated))
+		require.Equal(t, 1, countEvents(ctx, types.EventTypePriceStale))
+
+		require.True(t, keeper.GetOracleLiveness(input.ctx, assetCode, oracle1).Deactivated)
+		require.True(t, keeper.GetCurrentPrice(input.ctx, assetCode).Stale)
+
+		liveness := keeper.GetAssetLiveness(input.ctx, assetCode)
+		require.EqualValues(t, 1, liveness.LastPriceHeight)
+		require.Len(t, liveness.Oracles, 2)
+	}
+
+	// oracles reactivated by the nominee
+	{
+		ctx := input.ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, keeper.SetOracles(ctx, input.stdNominee, assetCode, types.Oracles{types.NewOracle(oracle1), types.NewOracle(oracle2)}))
+		require.Equal(t, 2, countEvents(ctx, types.EventTypeOracleReactivated))
+
+		require.Empty(t, keeper.GetAssetLiveness(input.ctx, assetCode).Oracles)
+		require.NoError(t, keeper.ValidatePostPrice(input.ctx, types.NewMsgPostPrice(oracle2, assetCode, sdk.NewInt(100), sdk.NewInt(99), input.ctx.BlockTime())))
+	}
+
+	// block 5: the same price posted, price is fresh
+	{
+		ctx := endBlock(5, oracle2)
+		require.Equal(t, 1, countEvents(ctx, types.EventTypePriceStale))
+		require.False(t, keeper.GetCurrentPrice(input.ctx, assetCode).Stale)
+	}
+}
+
+// Check oracles deactivation and asset price stale marking are disabled if params are not set.
+func TestOracleKeeper_Liveness_ParamsNotSet(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, assetCode := input.keeper, input.stdAssetCode
+	oracle1 := input.addresses[0]
+
+	require.NoError(t, keeper.SetOracles(input.ctx, input.stdNominee, assetCode, types.Oracles{types.NewOracle(oracle1)}))
+	input.deleteParam(types.KeyLiveness)
+	require.Equal(t, types.LivenessParams{}, keeper.GetLivenessParams(input.ctx))
+
+	// endBlock emulates the module EndBlocker for the blockHeight
+	endBlock := func(blockHeight int64, postOracles ...sdk.AccAddress) {
+		ctx := input.ctx.WithBlockHeight(blockHeight).WithEventManager(sdk.NewEventManager())
+		for _, oracle := range postOracles {
+			_, err := keeper.SetPrice(ctx, oracle, assetCode, sdk.NewInt(100), sdk.NewInt(99), sdk.ZeroInt(), ctx.BlockTime())
+			require.NoError(t, err)
+		}
+
+		require.NotPanics(t, func() {
+			require.NoError(t, keeper.SetCurrentPrices(ctx))
+			keeper.ProcessLiveness(ctx)
+		})
+	}
+
+	endBlock(1, oracle1)
+	for blockHeight := int64(2); blockHeight < 100; blockHeight++ {
+		endBlock(blockHeight)
+	}
+
+	liveness := keeper.GetOracleLiveness(input.ctx, assetCode, oracle1)
+	require.False(t, liveness.Deactivated)
+	require.EqualValues(t, 98, liveness.MissedBlocks)
+	require.False(t, keeper.GetCurrentPrice(input.ctx, assetCode).Stale)
+}
@@ -95,6 +95,7 @@ func (k Keeper) SetOracles(ctx sdk.Context, nominee string, assetCode dnTypes.As
 		params := k.GetParams(ctx)
 		params.Assets = updateAssets
 		k.SetParams(ctx, params)
+		k.resetOraclesLiveness(ctx, assetCode)
 		return nil
 	}
 
@@ -10,7 +10,7 @@ import (
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	k.modulePerms.AutoCheck(types.PermRead)
 
-	return types.NewParams(k.GetAssetParams(ctx), k.GetNomineeParams(ctx), k.GetPostPriceParams(ctx))
+	return types.NewParams(k.GetAssetParams(ctx), k.GetNomineeParams(ctx), k.GetPostPriceParams(ctx), k.GetPriceHistoryParams(ctx), k.GetLivenessParams(ctx))
 }
 
 // SetParams updates params in the store.
@@ -49,3 +49,25 @@ func (k Keeper) GetPostPriceParams(ctx sdk.Context) types.PostPriceParams {
 
 	return params
 }
+
+// GetPriceHistoryParams get price history params from store.
+// Zero params are returned if not set (price history is disabled).
+func (k Keeper) GetPriceHistoryParams(ctx sdk.Context) types.PriceHistoryParams {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	params := types.PriceHistoryParams{}
+	k.paramstore.GetIfExists(ctx, types.KeyPriceHistory, &params)
+
+	return params
+}
+
+// GetLivenessParams get liveness params from store.
+// Zero params are returned if not set (oracles deactivation and prices stale marking are disabled).
+func (k Keeper) GetLivenessParams(ctx sdk.Context) types.LivenessParams {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	params := types.LivenessParams{}
+	k.paramstore.GetIfExists(ctx, types.KeyLiveness, &params)
+
+	return params
+}
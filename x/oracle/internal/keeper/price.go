@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -55,9 +54,11 @@ func (k Keeper) addCurrentPrice(ctx sdk.Context, currentPrice types.CurrentPrice
 
 	bz := k.cdc.MustMarshalBinaryBare(currentPrice)
 	store.Set(types.GetCurrentPriceKey(currentPrice.AssetCode), bz)
+
+	k.addPriceHistoryItem(ctx, currentPrice)
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs and cleans up previous inputs.
+// SetCurrentPrices updates the price of an asset aggregating valid oracle inputs (algorithm depends on asset params).
 func (k Keeper) SetCurrentPrices(ctx sdk.Context) error {
 	k.modulePerms.AutoCheck(types.PermWrite)
 
@@ -66,77 +67,31 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context) error {
 	updatesCnt := 0
 	for _, v := range assets {
 		assetCode := v.AssetCode
-		rawPrices := k.GetRawPrices(ctx, assetCode, ctx.BlockHeight())
-
-		var (
-			medianAskPrice   sdk.Int
-			medianBidPrice   sdk.Int
-			medianReceivedAt time.Time
-		)
-
-		l := len(rawPrices)
-
-		// TODO make threshold for acceptance (ie. require 51% of oracles to have posted valid prices
-		if l == 0 {
-			// Error if there are no valid prices in the raw oracle
-			//return types.ErrNoValidPrice(k.codespace)
-			medianAskPrice, medianBidPrice = sdk.ZeroInt(), sdk.ZeroInt()
-		} else if l == 1 {
-			// Return immediately if there's only one price
-			medianAskPrice, medianBidPrice = rawPrices[0].AskPrice, rawPrices[0].BidPrice
-			medianReceivedAt = rawPrices[0].ReceivedAt
-		} else {
-			bidRawPrices := make([]types.PostedPrice, len(rawPrices))
-			copy(bidRawPrices, rawPrices)
-			askRawPrices := rawPrices
-
-			// sort the prices by ask and bid
-			sort.Slice(askRawPrices, func(i, j int) bool {
-				return askRawPrices[i].AskPrice.LT(askRawPrices[j].AskPrice)
-			})
-			sort.Slice(bidRawPrices, func(i, j int) bool {
-				return bidRawPrices[i].BidPrice.LT(bidRawPrices[j].BidPrice)
-			})
-			// If there's an even number of prices
-			if l%2 == 0 {
-				// TODO make sure this is safe.
-				// Since it's a price and not a balance, division with precision loss is OK.
-				a1 := askRawPrices[l/2-1].AskPrice
-				a2 := askRawPrices[l/2].AskPrice
-				sumA := a1.Add(a2)
-
-				b1 := bidRawPrices[l/2-1].BidPrice
-				b2 := bidRawPrices[l/2].BidPrice
-				sumB := b1.Add(b2)
-
-				divsor := sdk.NewInt(2)
-				medianAskPrice = sumA.Quo(divsor)
-				medianBidPrice = sumB.Quo(divsor)
-				medianReceivedAt = ctx.BlockTime().UTC()
-			} else {
-				// integer division, so we'll get an integer back, rounded down
-				medianAskPrice, medianBidPrice = askRawPrices[l/2].AskPrice, bidRawPrices[l/2].BidPrice
-				medianReceivedAt = rawPrices[l/2].ReceivedAt
-			}
+
+		askPrice, bidPrice, receivedAt, ok := k.aggregateRawPrices(ctx, v)
+		if !ok {
+			continue
 		}
 
-		// check if there is no rawPrices or medianPrice is invalid
-		if medianAskPrice.IsZero() || medianBidPrice.IsZero() {
+		// check if aggregated price is invalid
+		if askPrice.IsZero() || bidPrice.IsZero() {
 			continue
 		}
+		k.setLastPriceHeight(ctx, assetCode, ctx.BlockHeight())
 
 		// check new price for the asset appeared, no need to update after every block
+		// (stale flag for the unchanged price is reset by the ProcessLiveness)
 		oldPrice := k.GetCurrentPrice(ctx, assetCode)
-		if oldPrice.AssetCode != "" && oldPrice.AskPrice.Equal(medianAskPrice) && oldPrice.BidPrice.Equal(medianBidPrice) {
+		if oldPrice.AssetCode != "" && oldPrice.AskPrice.Equal(askPrice) && oldPrice.BidPrice.Equal(bidPrice) {
 			continue
 		}
 
 		// set the new price for the asset
 		newPrice := types.CurrentPrice{
 			AssetCode:  assetCode,
-			AskPrice:   medianAskPrice,
-			BidPrice:   medianBidPrice,
-			ReceivedAt: medianReceivedAt,
+			AskPrice:   askPrice,
+			BidPrice:   bidPrice,
+			ReceivedAt: receivedAt,
 		}
 
 		k.addCurrentPrice(ctx, newPrice)
@@ -153,6 +108,9 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context) error {
 		// emit event
 		updatesCnt++
 		ctx.EventManager().EmitEvent(types.NewPriceEvent(newPrice))
+		if oldPrice.Stale {
+			ctx.EventManager().EmitEvent(types.NewPriceStaleEvent(assetCode, false))
+		}
 	}
 
 	if updatesCnt > 0 {
@@ -182,6 +140,7 @@ func (k Keeper) SetPrice(
 	assetCode dnTypes.AssetCode,
 	askPrice sdk.Int,
 	bidPrice sdk.Int,
+	volume sdk.Int,
 	receivedAt time.Time) (types.PostedPrice, error) {
 
 	k.modulePerms.AutoCheck(types.PermWrite)
@@ -209,12 +168,12 @@ func (k Keeper) SetPrice(
 		prices[index] = types.PostedPrice{
 			AssetCode: assetCode, OracleAddress: oracle,
 			AskPrice: askPrice, BidPrice: bidPrice,
-			ReceivedAt: receivedAt}
+			Volume: volume, ReceivedAt: receivedAt}
 	} else {
 		prices = append(prices, types.PostedPrice{
 			AssetCode: assetCode, OracleAddress: oracle,
 			AskPrice: askPrice, BidPrice: bidPrice,
-			ReceivedAt: receivedAt})
+			Volume: volume, ReceivedAt: receivedAt})
 		index = len(prices) - 1
 	}
 
@@ -236,6 +195,9 @@ func (k Keeper) ValidatePostPrice(ctx sdk.Context, msg types.MsgPostPrice) error
 	if err != nil {
 		return sdkErrors.Wrap(types.ErrInvalidOracle, msg.From.String())
 	}
+	if k.GetOracleLiveness(ctx, msg.AssetCode, msg.From).Deactivated {
+		return sdkErrors.Wrap(types.ErrOracleDeactivated, msg.From.String())
+	}
 
 	return nil
 }
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// GetPriceAtHeight returns the CurrentPrice which was actual at the specified blockHeight.
+func (k Keeper) GetPriceAtHeight(ctx sdk.Context, assetCode dnTypes.AssetCode, blockHeight int64) (types.HistoricalPrice, bool) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(types.GetPriceHistoryPrefix(assetCode), types.GetPriceHistoryKey(assetCode, blockHeight+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.HistoricalPrice{}, false
+	}
+
+	price := types.HistoricalPrice{}
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+
+	return price, true
+}
+
+// GetPriceHistory returns CurrentPrice history items set within the [startHeight, endHeight] blocks range.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, assetCode dnTypes.AssetCode, startHeight, endHeight int64) types.HistoricalPrices {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetPriceHistoryKey(assetCode, startHeight), types.GetPriceHistoryKey(assetCode, endHeight+1))
+	defer iterator.Close()
+
+	prices := types.HistoricalPrices{}
+	for ; iterator.Valid(); iterator.Next() {
+		price := types.HistoricalPrice{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		prices = append(prices, price)
+	}
+
+	return prices
+}
+
+// GetPriceTWAP returns time-weighted average prices over the [startHeight, endHeight] blocks range.
+// Price history must cover the startHeight, otherwise ErrPriceHistoryNotFound is returned.
+func (k Keeper) GetPriceTWAP(ctx sdk.Context, assetCode dnTypes.AssetCode, startHeight, endHeight int64) (types.PriceTWAP, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if startHeight > endHeight {
+		return types.PriceTWAP{}, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "startHeight %d: must be LTE endHeight %d", startHeight, endHeight)
+	}
+
+	startPrice, found := k.GetPriceAtHeight(ctx, assetCode, startHeight)
+	if !found {
+		return types.PriceTWAP{}, sdkErrors.Wrapf(types.ErrPriceHistoryNotFound, "asset %s at height %d", assetCode, startHeight)
+	}
+
+	prices := append(types.HistoricalPrices{startPrice}, k.GetPriceHistory(ctx, assetCode, startHeight+1, endHeight)...)
+	askPrice, bidPrice := prices.TWAP(startHeight, endHeight)
+
+	return types.PriceTWAP{
+		AssetCode:   assetCode,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		AskPrice:    askPrice,
+		BidPrice:    bidPrice,
+	}, nil
+}
+
+// addPriceHistoryItem adds currentPrice to the price history and prunes items outside of the retention window.
+// The latest item outside of the window is kept, so the price stays defined for the whole window.
+func (k Keeper) addPriceHistoryItem(ctx sdk.Context, currentPrice types.CurrentPrice) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	params := k.GetPriceHistoryParams(ctx)
+	if params.RetentionBlocks == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	assetCode, blockHeight := currentPrice.AssetCode, ctx.BlockHeight()
+
+	bz := k.cdc.MustMarshalBinaryBare(types.NewHistoricalPrice(currentPrice, blockHeight))
+	store.Set(types.GetPriceHistoryKey(assetCode, blockHeight), bz)
+
+	// prune
+	pruneHeight := blockHeight - int64(params.RetentionBlocks)
+	if pruneHeight <= 0 {
+		return
+	}
+
+	iterator := store.ReverseIterator(types.GetPriceHistoryPrefix(assetCode), types.GetPriceHistoryKey(assetCode, pruneHeight+1))
+	defer iterator.Close()
+
+	// skip the latest item outside of the window
+	if iterator.Valid() {
+		iterator.Next()
+	}
+
+	var pruneKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruneKeys = append(pruneKeys, iterator.Key())
+	}
+
+	for _, key := range pruneKeys {
+		store.Delete(key)
+	}
+}
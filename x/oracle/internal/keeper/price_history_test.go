@@ -0,0 +1,143 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/oracle/internal/types"
+)
+
+// setPriceHistoryParams overwrites the price history params.
+func (input *TestInput) setPriceHistoryParams(historyParams types.PriceHistoryParams) {
+	params := input.keeper.GetParams(input.ctx)
+	params.PriceHistory = historyParams
+	input.keeper.SetParams(input.ctx, params)
+}
+
+// setCurrentPriceAtHeight posts a single raw price at blockHeight and updates the current price.
+func (input *TestInput) setCurrentPriceAtHeight(t *testing.T, blockHeight, askPrice int64) {
+	ctx := input.ctx.WithBlockHeight(blockHeight)
+	input.postPrices(t, ctx, []int64{askPrice}, nil)
+	require.NoError(t, input.keeper.SetCurrentPrices(ctx))
+}
+
+// Check price history storing and queries.
+func TestOracleKeeper_PriceHistory(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	input.setPriceHistoryParams(types.PriceHistoryParams{RetentionBlocks: 100})
+	assetCode := input.stdAssetCode
+
+	input.setCurrentPriceAtHeight(t, 10, 100)
+	input.setCurrentPriceAtHeight(t, 15, 200)
+	input.setCurrentPriceAtHeight(t, 18, 400)
+	// price not changed: no new history item
+	input.setCurrentPriceAtHeight(t, 19, 400)
+
+	// price at height
+	{
+		_, found := input.keeper.GetPriceAtHeight(input.ctx, assetCode, 9)
+		require.False(t, found)
+
+		price, found := input.keeper.GetPriceAtHeight(input.ctx, assetCode, 10)
+		require.True(t, found)
+		require.EqualValues(t, 10, price.BlockHeight)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(100)))
+
+		price, found = input.keeper.GetPriceAtHeight(input.ctx, assetCode, 17)
+		require.True(t, found)
+		require.EqualValues(t, 15, price.BlockHeight)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(200)))
+
+		price, found = input.keeper.GetPriceAtHeight(input.ctx, assetCode, 1000)
+		require.True(t, found)
+		require.EqualValues(t, 18, price.BlockHeight)
+	}
+
+	// price history range
+	{
+		prices := input.keeper.GetPriceHistory(input.ctx, assetCode, 0, 100)
+		require.Len(t, prices, 3)
+
+		prices = input.keeper.GetPriceHistory(input.ctx, assetCode, 11, 15)
+		require.Len(t, prices, 1)
+		require.EqualValues(t, 15, prices[0].BlockHeight)
+
+		prices = input.keeper.GetPriceHistory(input.ctx, assetCode, 11, 14)
+		require.Empty(t, prices)
+	}
+
+	// twap
+	{
+		_, err := input.keeper.GetPriceTWAP(input.ctx, assetCode, 5, 19)
+		require.Error(t, err)
+		require.True(t, types.ErrPriceHistoryNotFound.Is(err))
+
+		_, err = input.keeper.GetPriceTWAP(input.ctx, assetCode, 19, 10)
+		require.Error(t, err)
+
+		twap, err := input.keeper.GetPriceTWAP(input.ctx, assetCode, 10, 19)
+		require.NoError(t, err)
+		require.True(t, twap.AskPrice.Equal(sdk.NewInt(190)), twap.AskPrice.String())
+		require.True(t, twap.BidPrice.Equal(sdk.NewInt(189)), twap.BidPrice.String())
+	}
+}
+
+// Check price history pruning.
+func TestOracleKeeper_PriceHistory_Pruning(t *testing.T) {
+	t.Parallel()
+
+	// history disabled
+	{
+		input := NewTestInput(t)
+		input.setPriceHistoryParams(types.PriceHistoryParams{RetentionBlocks: 0})
+		input.setCurrentPriceAtHeight(t, 10, 100)
+
+		require.Empty(t, input.keeper.GetPriceHistory(input.ctx, input.stdAssetCode, 0, 100))
+	}
+
+	// the latest item outside of the window is kept
+	{
+		input := NewTestInput(t)
+		input.setPriceHistoryParams(types.PriceHistoryParams{RetentionBlocks: 10})
+		input.setCurrentPriceAtHeight(t, 1, 100)
+		input.setCurrentPriceAtHeight(t, 2, 200)
+		input.setCurrentPriceAtHeight(t, 5, 300)
+		input.setCurrentPriceAtHeight(t, 10, 400)
+		input.setCurrentPriceAtHeight(t, 16, 500)
+
+		prices := input.keeper.GetPriceHistory(input.ctx, input.stdAssetCode, 0, 100)
+		require.Len(t, prices, 3)
+		require.EqualValues(t, 5, prices[0].BlockHeight)
+		require.EqualValues(t, 10, prices[1].BlockHeight)
+		require.EqualValues(t, 16, prices[2].BlockHeight)
+
+		price, found := input.keeper.GetPriceAtHeight(input.ctx, input.stdAssetCode, 6)
+		require.True(t, found)
+		require.True(t, price.AskPrice.Equal(sdk.NewInt(300)))
+	}
+}
+
+// Check price history is disabled if params are not set.
+func TestOracleKeeper_PriceHistory_ParamsNotSet(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	input.deleteParam(types.KeyPriceHistory)
+	assetCode := input.stdAssetCode
+
+	require.Equal(t, types.PriceHistoryParams{}, input.keeper.GetPriceHistoryParams(input.ctx))
+
+	require.NotPanics(t, func() {
+		input.setCurrentPriceAtHeight(t, 10, 100)
+	})
+	require.True(t, input.keeper.GetCurrentPrice(input.ctx, assetCode).AskPrice.Equal(sdk.NewInt(100)))
+
+	_, found := input.keeper.GetPriceAtHeight(input.ctx, assetCode, 10)
+	require.False(t, found)
+}
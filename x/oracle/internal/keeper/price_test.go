@@ -68,6 +68,7 @@ func TestOracleKeeper_SetPrice(t *testing.T) {
 			ctx, input.addresses[0], input.stdAssetCode,
 			sdk.NewInt(33000001),
 			sdk.NewInt(33000000),
+			sdk.ZeroInt(),
 			header.Time)
 		require.NoError(t, err)
 
@@ -84,6 +85,7 @@ func TestOracleKeeper_SetPrice(t *testing.T) {
 			ctx, input.addresses[1], input.stdAssetCode,
 			sdk.NewInt(35000005),
 			sdk.NewInt(35000000),
+			sdk.ZeroInt(),
 			header.Time)
 		require.NoError(t, err)
 
@@ -99,6 +101,7 @@ func TestOracleKeeper_SetPrice(t *testing.T) {
 			ctx, input.addresses[0], input.stdAssetCode,
 			sdk.NewInt(37000007),
 			sdk.NewInt(37000000),
+			sdk.ZeroInt(),
 			header.Time)
 		require.NoError(t, err)
 
@@ -123,6 +126,7 @@ func TestOracleKeeper_GetRawPrice(t *testing.T) {
 			ctx, input.addresses[0], input.stdAssetCode,
 			sdk.NewInt(33000033),
 			sdk.NewInt(33000000),
+			sdk.ZeroInt(),
 			header.Time)
 		require.NoError(t, err)
 
@@ -147,16 +151,19 @@ func TestOracleKeeper_CurrentPrice(t *testing.T) {
 		ctx, input.addresses[0], input.stdAssetCode,
 		sdk.NewInt(33000001),
 		sdk.NewInt(33000000),
+		sdk.ZeroInt(),
 		header.Time)
 	_, _ = keeper.SetPrice(
 		ctx, input.addresses[1], input.stdAssetCode,
 		sdk.NewInt(35000005),
 		sdk.NewInt(35000000),
+		sdk.ZeroInt(),
 		header.Time)
 	_, _ = keeper.SetPrice(
 		ctx, input.addresses[2], input.stdAssetCode,
 		sdk.NewInt(34000004),
 		sdk.NewInt(34000000),
+		sdk.ZeroInt(),
 		header.Time)
 	// Set current price
 	err := keeper.SetCurrentPrices(ctx)
@@ -171,21 +178,25 @@ func TestOracleKeeper_CurrentPrice(t *testing.T) {
 		ctx, input.addresses[0], input.stdAssetCode,
 		sdk.NewInt(33000003),
 		sdk.NewInt(33000000),
+		sdk.ZeroInt(),
 		header.Time)
 	_, _ = keeper.SetPrice(
 		ctx, input.addresses[1], input.stdAssetCode,
 		sdk.NewInt(35000005),
 		sdk.NewInt(35000000),
+		sdk.ZeroInt(),
 		header.Time)
 	_, _ = keeper.SetPrice(
 		ctx, input.addresses[2], input.stdAssetCode,
 		sdk.NewInt(34000004),
 		sdk.NewInt(34000000),
+		sdk.ZeroInt(),
 		header.Time)
 	_, _ = keeper.SetPrice(
 		ctx, input.addresses[3], input.stdAssetCode,
 		sdk.NewInt(36000006),
 		sdk.NewInt(36000000),
+		sdk.ZeroInt(),
 		header.Time)
 
 	// Checking SetCurrentPrices method
@@ -22,6 +22,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryRawPrices(ctx, path[1:], req, keeper)
 		case types.QueryAssets:
 			return queryAssets(ctx, req, keeper)
+		case types.QueryPriceAtHeight:
+			return queryPriceAtHeight(ctx, path[1:], req, keeper)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, path[1:], req, keeper)
+		case types.QueryPriceTWAP:
+			return queryPriceTWAP(ctx, path[1:], req, keeper)
+		case types.QueryLiveness:
+			return queryLiveness(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "unknown oracle query endpoint")
 		}
@@ -54,6 +62,7 @@ func queryCurrentPrice(ctx sdk.Context, path []string, req abci.RequestQuery, ke
 		AssetCode:  currentPrice.AssetCode,
 		Price:      currentPrice.AskPrice,
 		ReceivedAt: currentPrice.ReceivedAt,
+		Stale:      currentPrice.Stale,
 	}
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, out)
@@ -92,3 +101,135 @@ func queryAssets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 	return bz, nil
 }
+
+// queryPriceAtHeight handles priceAtHeight query. Takes an [assetCode] and [blockHeight], then returns the HistoricalPrice actual at that height.
+func queryPriceAtHeight(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if len(path) != 2 {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "assetCode and blockHeight path args expected")
+	}
+
+	assetCode, err := parseHistoryAssetCode(ctx, path[0], keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeight, err := parseHistoryBlockHeight("blockHeight", path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	price, found := keeper.GetPriceAtHeight(ctx, assetCode, blockHeight)
+	if !found {
+		return nil, sdkErrors.Wrapf(types.ErrPriceHistoryNotFound, "asset %s at height %d", assetCode, blockHeight)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, price)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "historicalPrice marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// queryPriceHistory handles priceHistory query. Takes an [assetCode], [startHeight] and [endHeight], then returns HistoricalPrices set within that range.
+func queryPriceHistory(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	assetCode, startHeight, endHeight, err := parseHistoryRangeArgs(ctx, path, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := keeper.GetPriceHistory(ctx, assetCode, startHeight, endHeight)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, prices)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "historicalPrices marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// queryPriceTWAP handles priceTWAP query. Takes an [assetCode], [startHeight] and [endHeight], then returns PriceTWAP for that range.
+func queryPriceTWAP(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	assetCode, startHeight, endHeight, err := parseHistoryRangeArgs(ctx, path, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	twap, err := keeper.GetPriceTWAP(ctx, assetCode, startHeight, endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, twap)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "priceTWAP marshal: %v", err)
+	}
+
+	return bz, nil
+}
+
+// parseHistoryRangeArgs parses and validates [assetCode], [startHeight] and [endHeight] path args.
+func parseHistoryRangeArgs(ctx sdk.Context, path []string, keeper Keeper) (assetCode dnTypes.AssetCode, startHeight, endHeight int64, retErr error) {
+	if len(path) != 3 {
+		retErr = sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "assetCode, startHeight and endHeight path args expected")
+		return
+	}
+
+	if assetCode, retErr = parseHistoryAssetCode(ctx, path[0], keeper); retErr != nil {
+		return
+	}
+	if startHeight, retErr = parseHistoryBlockHeight("startHeight", path[1]); retErr != nil {
+		return
+	}
+	if endHeight, retErr = parseHistoryBlockHeight("endHeight", path[2]); retErr != nil {
+		return
+	}
+
+	if startHeight > endHeight {
+		retErr = sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "startHeight %d: must be LTE endHeight %d", startHeight, endHeight)
+	}
+
+	return
+}
+
+// parseHistoryAssetCode parses assetCode path arg and checks the asset exists.
+func parseHistoryAssetCode(ctx sdk.Context, arg string, keeper Keeper) (dnTypes.AssetCode, error) {
+	assetCode := dnTypes.AssetCode(arg)
+	if _, found := keeper.GetAsset(ctx, assetCode); !found {
+		return "", sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "asset not found")
+	}
+
+	return assetCode, nil
+}
+
+// parseHistoryBlockHeight parses block height path arg.
+func parseHistoryBlockHeight(argName, arg string) (int64, error) {
+	blockHeight, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "invalid %s: %v", argName, err)
+	}
+	if blockHeight < 0 {
+		return 0, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "invalid %s: negative", argName)
+	}
+
+	return blockHeight, nil
+}
+
+// queryLiveness handles liveness query. Takes an [assetCode] and returns AssetLiveness for that asset.
+func queryLiveness(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if len(path) != 1 {
+		return nil, sdkErrors.Wrap(sdkErrors.ErrUnknownRequest, "assetCode path arg expected")
+	}
+
+	assetCode, err := parseHistoryAssetCode(ctx, path[0], keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	liveness := keeper.GetAssetLiveness(ctx, assetCode)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, liveness)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "assetLiveness marshal: %v", err)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,217 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// Max TWAP window size [blocks].
+	AggregationMaxTWAPBlocks = 1000
+	// Max trimmed part of raw prices (from each side) [%].
+	AggregationMaxTrimPct = 49
+	// Basis points in 100%.
+	AggregationBpsBase = 10000
+)
+
+// Enum type to define asset price aggregation strategy.
+type PriceAggregation string
+
+const (
+	// Median of raw prices (default).
+	PriceAggregationMedian PriceAggregation = "median"
+	// Mean of raw prices with the lowest / highest prices trimmed.
+	PriceAggregationTrimmedMean PriceAggregation = "trimmed_mean"
+	// Mean of raw prices weighted by posted volumes.
+	PriceAggregationVolumeWeighted PriceAggregation = "volume_weighted"
+	// Mean of per block median prices over the last N blocks.
+	PriceAggregationTWAP PriceAggregation = "twap"
+)
+
+// IsValid validates enum (empty value is the default median strategy).
+func (a PriceAggregation) IsValid() bool {
+	switch a {
+	case "", PriceAggregationMedian, PriceAggregationTrimmedMean, PriceAggregationVolumeWeighted, PriceAggregationTWAP:
+		return true
+	}
+
+	return false
+}
+
+// String returns string enum representation.
+func (a PriceAggregation) String() string {
+	if a == "" {
+		return string(PriceAggregationMedian)
+	}
+
+	return string(a)
+}
+
+// AggregationParams defines the way raw prices are combined into the asset current price.
+type AggregationParams struct {
+	// Aggregation strategy (median / trimmed_mean / volume_weighted / twap, empty - median)
+	Strategy PriceAggregation `json:"strategy" yaml:"strategy" swaggertype:"string" example:"median"`
+	// Min number of valid raw prices (after outliers filtering) required to update the price (0 - disabled)
+	MinOracles uint32 `json:"min_oracles" yaml:"min_oracles"`
+	// Max raw price deviation from the raw prices median [basis points] (0 - disabled)
+	MaxDeviationBps uint32 `json:"max_deviation_bps" yaml:"max_deviation_bps"`
+	// Part of the lowest / highest raw prices dropped by the trimmed_mean strategy [%]
+	TrimPct uint32 `json:"trim_pct" yaml:"trim_pct"`
+	// Window size for the twap strategy [blocks]
+	TWAPBlocks uint32 `json:"twap_blocks" yaml:"twap_blocks"`
+}
+
+// Validate checks that AggregationParams are valid.
+func (p AggregationParams) Validate() error {
+	if !p.Strategy.IsValid() {
+		return fmt.Errorf("strategy %q: unknown", p.Strategy)
+	}
+
+	if p.TrimPct > AggregationMaxTrimPct {
+		return fmt.Errorf("trim_pct: must be LTE %d", AggregationMaxTrimPct)
+	}
+	if p.Strategy == PriceAggregationTrimmedMean && p.TrimPct == 0 {
+		return fmt.Errorf("trim_pct: must be GT 0 for %s strategy", p.Strategy)
+	}
+
+	if p.TWAPBlocks > AggregationMaxTWAPBlocks {
+		return fmt.Errorf("twap_blocks: must be LTE %d", AggregationMaxTWAPBlocks)
+	}
+	if p.Strategy == PriceAggregationTWAP && p.TWAPBlocks == 0 {
+		return fmt.Errorf("twap_blocks: must be GT 0 for %s strategy", p.Strategy)
+	}
+
+	return nil
+}
+
+func (p AggregationParams) String() string {
+	return fmt.Sprintf("Aggregation:\n"+
+		"    Strategy: %s\n"+
+		"    MinOracles: %d\n"+
+		"    MaxDeviationBps: %d\n"+
+		"    TrimPct: %d\n"+
+		"    TWAPBlocks: %d",
+		p.Strategy, p.MinOracles, p.MaxDeviationBps, p.TrimPct, p.TWAPBlocks,
+	)
+}
+
+// PostedPrices slice type.
+type PostedPrices []PostedPrice
+
+// FilterByDeviation drops raw prices which ask / bid prices deviate from the median more than maxDeviationBps.
+// Zero maxDeviationBps disables the filter.
+func (list PostedPrices) FilterByDeviation(maxDeviationBps uint32) PostedPrices {
+	if maxDeviationBps == 0 || len(list) == 0 {
+		return list
+	}
+
+	medianAsk, medianBid := list.Median()
+	isOutlier := func(price, median sdk.Int) bool {
+		diff := price.Sub(median)
+		if diff.IsNegative() {
+			diff = diff.Neg()
+		}
+
+		return diff.MulRaw(AggregationBpsBase).GT(median.MulRaw(int64(maxDeviationBps)))
+	}
+
+	filtered := make(PostedPrices, 0, len(list))
+	for _, p := range list {
+		if isOutlier(p.AskPrice, medianAsk) || isOutlier(p.BidPrice, medianBid) {
+			continue
+		}
+		filtered = append(filtered, p)
+	}
+
+	return filtered
+}
+
+// Median returns median ask and bid prices (even number of prices median is an average of two middle prices).
+// Contract: list is not empty.
+func (list PostedPrices) Median() (askPrice, bidPrice sdk.Int) {
+	askPrices, bidPrices := list.sortedPrices()
+
+	l := len(list)
+	if l%2 == 0 {
+		// since it's a price and not a balance, division with precision loss is OK
+		askPrice = askPrices[l/2-1].Add(askPrices[l/2]).QuoRaw(2)
+		bidPrice = bidPrices[l/2-1].Add(bidPrices[l/2]).QuoRaw(2)
+		return
+	}
+
+	return askPrices[l/2], bidPrices[l/2]
+}
+
+// TrimmedMean returns mean ask and bid prices dropping trimPct of the lowest / highest prices.
+// Contract: list is not empty, trimPct is LT 50.
+func (list PostedPrices) TrimmedMean(trimPct uint32) (askPrice, bidPrice sdk.Int) {
+	askPrices, bidPrices := list.sortedPrices()
+
+	trimCnt := len(list) * int(trimPct) / 100
+	mean := func(prices []sdk.Int) sdk.Int {
+		prices = prices[trimCnt : len(prices)-trimCnt]
+
+		sum := sdk.ZeroInt()
+		for _, p := range prices {
+			sum = sum.Add(p)
+		}
+
+		return sum.QuoRaw(int64(len(prices)))
+	}
+
+	return mean(askPrices), mean(bidPrices)
+}
+
+// VolumeWeighted returns ask and bid prices weighted by posted volumes.
+// Prices without volume are skipped, ok is false if there are no prices with volume.
+func (list PostedPrices) VolumeWeighted() (askPrice, bidPrice sdk.Int, ok bool) {
+	askSum, bidSum, volumeSum := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+	for _, p := range list {
+		if p.Volume.IsNil() || !p.Volume.IsPositive() {
+			continue
+		}
+
+		askSum = askSum.Add(p.AskPrice.Mul(p.Volume))
+		bidSum = bidSum.Add(p.BidPrice.Mul(p.Volume))
+		volumeSum = volumeSum.Add(p.Volume)
+	}
+
+	if volumeSum.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), false
+	}
+
+	return askSum.Quo(volumeSum), bidSum.Quo(volumeSum), true
+}
+
+// LastReceivedAt returns the latest raw price receivedAt timestamp.
+func (list PostedPrices) LastReceivedAt() time.Time {
+	var receivedAt time.Time
+	for _, p := range list {
+		if p.ReceivedAt.After(receivedAt) {
+			receivedAt = p.ReceivedAt
+		}
+	}
+
+	return receivedAt
+}
+
+// sortedPrices returns sorted (ASC) ask and bid prices.
+func (list PostedPrices) sortedPrices() (askPrices, bidPrices []sdk.Int) {
+	askPrices, bidPrices = make([]sdk.Int, 0, len(list)), make([]sdk.Int, 0, len(list))
+	for _, p := range list {
+		askPrices = append(askPrices, p.AskPrice)
+		bidPrices = append(bidPrices, p.BidPrice)
+	}
+
+	sort.Slice(askPrices, func(i, j int) bool {
+		return askPrices[i].LT(askPrices[j])
+	})
+	sort.Slice(bidPrices, func(i, j int) bool {
+		return bidPrices[i].LT(bidPrices[j])
+	})
+
+	return
+}
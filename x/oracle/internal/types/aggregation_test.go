@@ -0,0 +1,97 @@
+//go:build unit
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// Check AggregationParams validation.
+func TestOracleTypes_AggregationParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	// ok: default
+	require.NoError(t, AggregationParams{}.Validate())
+
+	// fail: unknown strategy
+	require.Error(t, AggregationParams{Strategy: "mode"}.Validate())
+
+	// trimmed mean
+	require.Error(t, AggregationParams{Strategy: PriceAggregationTrimmedMean}.Validate())
+	require.Error(t, AggregationParams{Strategy: PriceAggregationTrimmedMean, TrimPct: 50}.Validate())
+	require.NoError(t, AggregationParams{Strategy: PriceAggregationTrimmedMean, TrimPct: 10}.Validate())
+
+	// twap
+	require.Error(t, AggregationParams{Strategy: PriceAggregationTWAP}.Validate())
+	require.Error(t, AggregationParams{Strategy: PriceAggregationTWAP, TWAPBlocks: AggregationMaxTWAPBlocks + 1}.Validate())
+	require.NoError(t, AggregationParams{Strategy: PriceAggregationTWAP, TWAPBlocks: 10}.Validate())
+
+	// asset quorum
+	{
+		oracles := Oracles{Oracle{Address: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())}}
+
+		a := NewAsset("dn_eth", oracles, true)
+		a.Aggregation.MinOracles = 2
+		require.Error(t, a.ValidateBasic())
+
+		a.Aggregation.MinOracles = 1
+		require.NoError(t, a.ValidateBasic())
+	}
+}
+
+// Check PostedPrices aggregation helpers.
+func TestOracleTypes_PostedPrices(t *testing.T) {
+	t.Parallel()
+
+	newPrices := func(askPrices ...int64) PostedPrices {
+		list := make(PostedPrices, 0, len(askPrices))
+		for _, p := range askPrices {
+			list = append(list, PostedPrice{AskPrice: sdk.NewInt(p), BidPrice: sdk.NewInt(p - 1), Volume: sdk.ZeroInt()})
+		}
+
+		return list
+	}
+
+	// median
+	{
+		ask, bid := newPrices(30, 10, 20).Median()
+		require.True(t, ask.Equal(sdk.NewInt(20)))
+		require.True(t, bid.Equal(sdk.NewInt(19)))
+
+		ask, _ = newPrices(30, 10, 20, 40).Median()
+		require.True(t, ask.Equal(sdk.NewInt(25)))
+	}
+
+	// deviation filter
+	{
+		require.Len(t, newPrices(100, 105, 200).FilterByDeviation(0), 3)
+		require.Len(t, newPrices(100, 105, 200).FilterByDeviation(500), 2)
+		require.Len(t, newPrices(100, 105, 200).FilterByDeviation(10000), 3)
+	}
+
+	// trimmed mean
+	{
+		ask, _ := newPrices(1, 10, 20, 30, 1000).TrimmedMean(20)
+		require.True(t, ask.Equal(sdk.NewInt(20)))
+
+		ask, _ = newPrices(1, 10, 20, 30, 1000).TrimmedMean(10)
+		require.True(t, ask.Equal(sdk.NewInt(212)))
+	}
+
+	// volume weighted
+	{
+		list := newPrices(10, 20)
+		_, _, ok := list.VolumeWeighted()
+		require.False(t, ok)
+
+		list[0].Volume, list[1].Volume = sdk.NewInt(3), sdk.NewInt(1)
+		ask, _, ok := list.VolumeWeighted()
+		require.True(t, ok)
+		require.True(t, ask.Equal(sdk.NewInt(12)))
+	}
+}
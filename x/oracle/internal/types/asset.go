@@ -17,14 +17,17 @@ type Asset struct {
 	Oracles Oracles `json:"oracles" yaml:"oracles"`
 	// Not used ATM
 	Active bool `json:"active" yaml:"active"`
+	// Raw prices aggregation params
+	Aggregation AggregationParams `json:"aggregation" yaml:"aggregation"`
 }
 
 func (a Asset) String() string {
 	return fmt.Sprintf("Asset:\n"+
 		"  AssetCode: %s\n"+
 		"  Oracles: %s\n"+
-		"  Active: %v",
-		a.AssetCode, a.Oracles, a.Active)
+		"  Active: %v\n"+
+		"  %s",
+		a.AssetCode, a.Oracles, a.Active, a.Aggregation)
 }
 
 // ValidateBasic does a simple validation check that doesn't require access to any other information.
@@ -37,6 +40,14 @@ func (a Asset) ValidateBasic() error {
 		return sdkErrors.Wrap(ErrInternal, "invalid TokenRecord: missing Oracles")
 	}
 
+	if err := a.Aggregation.Validate(); err != nil {
+		return sdkErrors.Wrapf(ErrInvalidAggregation, "%v", err)
+	}
+
+	if int(a.Aggregation.MinOracles) > len(a.Oracles) {
+		return sdkErrors.Wrapf(ErrInvalidAggregation, "min_oracles: must be LTE oracles count (%d)", len(a.Oracles))
+	}
+
 	return nil
 }
 
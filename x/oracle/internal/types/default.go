@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"strconv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/dfinance/dnode/helpers/types"
 )
 
@@ -19,6 +21,9 @@ var (
 	ModuleKey       = []byte(ModuleName)
 	RawPriceKey     = []byte("raw")
 	CurrentPriceKey = []byte("currentprice")
+	PriceHistoryKey = []byte("pricehistory")
+	LivenessKey     = []byte("liveness")
+	LastPriceKey    = []byte("lastprice")
 )
 
 // GetRawPricesKey Get a key to store PostedPrices for specific assetCode and blockHeight.
@@ -55,3 +60,56 @@ func GetCurrentPriceKey(assetCode types.AssetCode) []byte {
 		KeyDelimiter,
 	)
 }
+
+// GetPriceHistoryPrefix Get a prefix for store HistoricalPrice items for specific assetCode.
+func GetPriceHistoryPrefix(assetCode types.AssetCode) []byte {
+	return append(
+		bytes.Join(
+			[][]byte{
+				ModuleKey,
+				PriceHistoryKey,
+				[]byte(assetCode),
+			},
+			KeyDelimiter,
+		),
+		KeyDelimiter...,
+	)
+}
+
+// GetPriceHistoryKey Get a key to store HistoricalPrice for specific assetCode and blockHeight.
+// Height is BigEndian encoded to keep items sorted by height.
+func GetPriceHistoryKey(assetCode types.AssetCode, blockHeight int64) []byte {
+	return append(GetPriceHistoryPrefix(assetCode), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
+
+// GetOracleLivenessPrefix Get a prefix for store OracleLiveness items for specific assetCode.
+func GetOracleLivenessPrefix(assetCode types.AssetCode) []byte {
+	return append(
+		bytes.Join(
+			[][]byte{
+				ModuleKey,
+				LivenessKey,
+				[]byte(assetCode),
+			},
+			KeyDelimiter,
+		),
+		KeyDelimiter...,
+	)
+}
+
+// GetOracleLivenessKey Get a key to store OracleLiveness for specific assetCode and oracle.
+func GetOracleLivenessKey(assetCode types.AssetCode, oracleAddress sdk.AccAddress) []byte {
+	return append(GetOracleLivenessPrefix(assetCode), oracleAddress.Bytes()...)
+}
+
+// GetLastPriceHeightKey Get a key to store the last valid raw prices aggregation block height for specific assetCode.
+func GetLastPriceHeightKey(assetCode types.AssetCode) []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleKey,
+			LastPriceKey,
+			[]byte(assetCode),
+		},
+		KeyDelimiter,
+	)
+}
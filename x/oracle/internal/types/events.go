@@ -4,16 +4,24 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 const (
-	EventTypeAddAsset = ModuleName + ".add_asset"
-	EventTypePrice    = ModuleName + ".price"
+	EventTypeAddAsset          = ModuleName + ".add_asset"
+	EventTypePrice             = ModuleName + ".price"
+	EventTypePriceStale        = ModuleName + ".price_stale"
+	EventTypeOracleDeactivated = ModuleName + ".oracle_deactivated"
+	EventTypeOracleReactivated = ModuleName + ".oracle_reactivated"
 	//
-	AttributeAssetCode  = "asset_code"
-	AttributeAskPrice   = "ask_price"
-	AttributeBidPrice   = "bid_price"
-	AttributeReceivedAt = "received_at"
+	AttributeAssetCode     = "asset_code"
+	AttributeAskPrice      = "ask_price"
+	AttributeBidPrice      = "bid_price"
+	AttributeReceivedAt    = "received_at"
+	AttributeStale         = "stale"
+	AttributeOracleAddress = "oracle_address"
+	AttributeMissedBlocks  = "missed_blocks"
 )
 
 // NewAssetAddedEvent creates an Event on asset creation.
@@ -32,3 +40,28 @@ func NewPriceEvent(price CurrentPrice) sdk.Event {
 		sdk.NewAttribute(AttributeReceivedAt, strconv.FormatInt(price.ReceivedAt.Unix(), 10)),
 	)
 }
+
+// NewPriceStaleEvent creates an Event on asset price stale state change.
+func NewPriceStaleEvent(assetCode dnTypes.AssetCode, stale bool) sdk.Event {
+	return sdk.NewEvent(EventTypePriceStale,
+		sdk.NewAttribute(AttributeAssetCode, assetCode.String()),
+		sdk.NewAttribute(AttributeStale, strconv.FormatBool(stale)),
+	)
+}
+
+// NewOracleDeactivatedEvent creates an Event on oracle deactivation due to missed blocks.
+func NewOracleDeactivatedEvent(liveness OracleLiveness) sdk.Event {
+	return sdk.NewEvent(EventTypeOracleDeactivated,
+		sdk.NewAttribute(AttributeAssetCode, liveness.AssetCode.String()),
+		sdk.NewAttribute(AttributeOracleAddress, liveness.OracleAddress.String()),
+		sdk.NewAttribute(AttributeMissedBlocks, strconv.FormatUint(uint64(liveness.MissedBlocks), 10)),
+	)
+}
+
+// NewOracleReactivatedEvent creates an Event on deactivated oracle liveness reset.
+func NewOracleReactivatedEvent(liveness OracleLiveness) sdk.Event {
+	return sdk.NewEvent(EventTypeOracleReactivated,
+		sdk.NewAttribute(AttributeAssetCode, liveness.AssetCode.String()),
+		sdk.NewAttribute(AttributeOracleAddress, liveness.OracleAddress.String()),
+	)
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// LivenessParams Oracles and asset prices liveness tracking configuration params.
+type LivenessParams struct {
+	// Number of consecutive blocks an oracle can miss before it is deactivated (0 - disabled) [blocks]
+	MaxMissedBlocks uint32 `json:"max_missed_blocks" yaml:"max_missed_blocks"`
+	// Number of blocks without valid raw prices after which the asset price is marked as stale (0 - disabled) [blocks]
+	StalePriceBlocks uint32 `json:"stale_price_blocks" yaml:"stale_price_blocks"`
+}
+
+func (p LivenessParams) String() string {
+	return fmt.Sprintf("Liveness params:\n"+
+		"  MaxMissedBlocks: %d\n"+
+		"  StalePriceBlocks: %d",
+		p.MaxMissedBlocks, p.StalePriceBlocks,
+	)
+}
+
+// OracleLiveness contains posting activity meta for the particular asset oracle.
+type OracleLiveness struct {
+	// Asset code
+	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code" example:"btc_xfi"`
+	// Oracle address
+	OracleAddress sdk.AccAddress `json:"oracle_address" yaml:"oracle_address" swaggertype:"string" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
+	// Block height of the last raw price posted by the oracle (0 - never posted)
+	LastPostedHeight int64 `json:"last_posted_height" yaml:"last_posted_height" example:"100"`
+	// Number of consecutive blocks the oracle hasn't posted a raw price
+	MissedBlocks uint32 `json:"missed_blocks" yaml:"missed_blocks" example:"10"`
+	// Oracle is deactivated due to missed blocks (its raw prices are rejected)
+	Deactivated bool `json:"deactivated" yaml:"deactivated"`
+}
+
+// NewOracleLiveness creates a new OracleLiveness object.
+func NewOracleLiveness(assetCode dnTypes.AssetCode, oracleAddress sdk.AccAddress) OracleLiveness {
+	return OracleLiveness{
+		AssetCode:     assetCode,
+		OracleAddress: oracleAddress,
+	}
+}
+
+// OracleLiveness slice type.
+type OracleLivenesses []OracleLiveness
+
+// Strings returns multi-line text object representation.
+func (list OracleLivenesses) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"OL.AssetCode",
+		"OL.OracleAddress",
+		"OL.LastPostedHeight",
+		"OL.MissedBlocks",
+		"OL.Deactivated",
+	})
+
+	for _, l := range list {
+		t.Append([]string{
+			l.AssetCode.String(),
+			l.OracleAddress.String(),
+			strconv.FormatInt(l.LastPostedHeight, 10),
+			strconv.FormatUint(uint64(l.MissedBlocks), 10),
+			strconv.FormatBool(l.Deactivated),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
+
+// AssetLiveness contains asset price freshness meta.
+type AssetLiveness struct {
+	// Asset code
+	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code" example:"btc_xfi"`
+	// Block height of the last valid raw prices aggregation
+	LastPriceHeight int64 `json:"last_price_height" yaml:"last_price_height" example:"100"`
+	// Asset oracles liveness
+	Oracles OracleLivenesses `json:"oracles" yaml:"oracles"`
+}
+
+func (l AssetLiveness) String() string {
+	b := strings.Builder{}
+	b.WriteString("AssetLiveness:\n")
+	b.WriteString(fmt.Sprintf("  AssetCode:       %s\n", l.AssetCode))
+	b.WriteString(fmt.Sprintf("  LastPriceHeight: %d\n", l.LastPriceHeight))
+	b.WriteString("Oracles:\n")
+	b.WriteString(l.Oracles.String())
+
+	return b.String()
+}
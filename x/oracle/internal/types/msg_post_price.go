@@ -20,6 +20,8 @@ type MsgPostPrice struct {
 	AskPrice sdk.Int `json:"ask_price" yaml:"ask_price"`
 	// BidPrice
 	BidPrice sdk.Int `json:"bid_price" yaml:"bid_price"`
+	// Traded volume the price is based on (optional)
+	Volume sdk.Int `json:"volume" yaml:"volume"`
 	// ReceivedAt time in UNIX timestamp format [seconds]
 	ReceivedAt time.Time `json:"received_at" yaml:"received_at"`
 }
@@ -50,6 +52,9 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	if msg.BidPrice.BigInt().BitLen() > PriceBytesLimit*8 {
 		return sdkErrors.Wrapf(ErrInternal, "out of %d bytes limit for BidPrice", PriceBytesLimit)
 	}
+	if !msg.Volume.IsNil() && msg.Volume.IsNegative() {
+		return sdkErrors.Wrap(ErrInternal, "invalid (negative) volume")
+	}
 	return nil
 }
 
@@ -70,6 +75,7 @@ func NewMsgPostPrice(from sdk.AccAddress, assetCode dnTypes.AssetCode, askPrice,
 		AssetCode:  assetCode,
 		AskPrice:   askPrice,
 		BidPrice:   bidPrice,
+		Volume:     sdk.ZeroInt(),
 		ReceivedAt: receivedAt,
 	}
 }
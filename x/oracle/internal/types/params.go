@@ -8,9 +8,11 @@ import (
 )
 
 var (
-	KeyAssets    = []byte("oracleassets")
-	KeyNominees  = []byte("oraclenominees")
-	KeyPostPrice = []byte("oraclepostprice")
+	KeyAssets       = []byte("oracleassets")
+	KeyNominees     = []byte("oraclenominees")
+	KeyPostPrice    = []byte("oraclepostprice")
+	KeyPriceHistory = []byte("oraclepricehistory")
+	KeyLiveness     = []byte("oracleliveness")
 )
 
 // Params defines keeper params.
@@ -21,6 +23,10 @@ type Params struct {
 	Nominees []string `json:"nominees" yaml:"nominees"`
 	// PostPrice params
 	PostPrice PostPriceParams `json:"post_price" yaml:"post_price"`
+	// PriceHistory params
+	PriceHistory PriceHistoryParams `json:"price_history" yaml:"price_history"`
+	// Liveness params
+	Liveness LivenessParams `json:"liveness" yaml:"liveness"`
 }
 
 // Implements subspace.ParamSet interface.
@@ -33,6 +39,8 @@ func (p Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyAssets, Value: &p.Assets, ValidatorFn: nilPairValidatorFunc},
 		{Key: KeyNominees, Value: &p.Nominees, ValidatorFn: nilPairValidatorFunc},
 		{Key: KeyPostPrice, Value: &p.PostPrice, ValidatorFn: nilPairValidatorFunc},
+		{Key: KeyPriceHistory, Value: &p.PriceHistory, ValidatorFn: nilPairValidatorFunc},
+		{Key: KeyLiveness, Value: &p.Liveness, ValidatorFn: nilPairValidatorFunc},
 	}
 }
 
@@ -42,6 +50,9 @@ func (p Params) Validate() error {
 		if err := asset.AssetCode.Validate(); err != nil {
 			return fmt.Errorf("invalid asset %q: %w", asset.String(), err)
 		}
+		if err := asset.Aggregation.Validate(); err != nil {
+			return fmt.Errorf("invalid asset %q aggregation: %w", asset.AssetCode, err)
+		}
 	}
 
 	for i, nominee := range p.Nominees {
@@ -63,25 +74,40 @@ func (p Params) String() string {
 	for i, n := range p.Nominees {
 		out.WriteString(fmt.Sprintf("Nominee [%d]: %s\n", i, n))
 	}
-	out.WriteString(p.PostPrice.String())
+	out.WriteString(p.PostPrice.String() + "\n")
+	out.WriteString(p.PriceHistory.String() + "\n")
+	out.WriteString(p.Liveness.String())
 
 	return strings.TrimSpace(out.String())
 }
 
 // NewParams creates a new AssetParams object.
-func NewParams(assets []Asset, nominees []string, postPrice PostPriceParams) Params {
+func NewParams(assets []Asset, nominees []string, postPrice PostPriceParams, priceHistory PriceHistoryParams, liveness LivenessParams) Params {
 	return Params{
-		Assets:    assets,
-		Nominees:  nominees,
-		PostPrice: postPrice,
+		Assets:       assets,
+		Nominees:     nominees,
+		PostPrice:    postPrice,
+		PriceHistory: priceHistory,
+		Liveness:     liveness,
 	}
 }
 
 // DefaultParams default params for oracle.
 func DefaultParams() Params {
-	return NewParams(Assets{}, []string{}, PostPriceParams{
-		ReceivedAtDiffInS: 60 * 60,
-	})
+	return NewParams(
+		Assets{},
+		[]string{},
+		PostPriceParams{
+			ReceivedAtDiffInS: 60 * 60,
+		},
+		PriceHistoryParams{
+			RetentionBlocks: 14400,
+		},
+		LivenessParams{
+			MaxMissedBlocks:  1000,
+			StalePriceBlocks: 100,
+		},
+	)
 }
 
 // ParamKeyTable Key declaration for parameters.
@@ -101,3 +127,16 @@ func (p PostPriceParams) String() string {
 		p.ReceivedAtDiffInS,
 	)
 }
+
+// PriceHistoryParams CurrentPrice history configuration params.
+type PriceHistoryParams struct {
+	// Number of blocks CurrentPrice history items are kept for (0 - history is disabled) [blocks]
+	RetentionBlocks uint32 `json:"retention_blocks" yaml:"retention_blocks"`
+}
+
+func (p PriceHistoryParams) String() string {
+	return fmt.Sprintf("PriceHistory params:\n"+
+		"  RetentionBlocks: %d",
+		p.RetentionBlocks,
+	)
+}
@@ -26,6 +26,8 @@ type CurrentPrice struct {
 	BidPrice sdk.Int `json:"bid_price" yaml:"bid_price" swaggertype:"string" example:"1000"`
 	// UNIX Timestamp price createdAt [sec]
 	ReceivedAt time.Time `json:"received_at" yaml:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Price is stale (no valid raw prices for the asset for a while)
+	Stale bool `json:"stale" yaml:"stale"`
 }
 
 // GetReversedAssetCurrentPrice returns CurrentPrice for reverted
@@ -42,6 +44,7 @@ func (cp CurrentPrice) GetReversedAssetCurrentPrice() CurrentPrice {
 		AskPrice:   reverseInt(cp.BidPrice),
 		BidPrice:   reverseInt(cp.AskPrice),
 		ReceivedAt: cp.ReceivedAt,
+		Stale:      cp.Stale,
 	}
 }
 
@@ -53,6 +56,8 @@ type CurrentAssetPrice struct {
 	Price sdk.Int `json:"price" yaml:"price" swaggertype:"string" example:"1000"`
 	// UNIX Timestamp price createdAt [sec]
 	ReceivedAt time.Time `json:"received_at" yaml:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Price is stale (no valid raw prices for the asset for a while)
+	Stale bool `json:"stale" yaml:"stale"`
 }
 
 // Valid checks that CurrentPrice is valid (used for genesis ops).
@@ -83,8 +88,9 @@ func (cp CurrentPrice) String() string {
 		"AssetCode: %s\n"+
 		"AskPrice: %s\n"+
 		"BidPrice: %s\n"+
-		"ReceivedAt: %s",
-		cp.AssetCode, cp.AskPrice, cp.BidPrice, cp.ReceivedAt,
+		"ReceivedAt: %s\n"+
+		"Stale: %v",
+		cp.AssetCode, cp.AskPrice, cp.BidPrice, cp.ReceivedAt, cp.Stale,
 	)
 }
 
@@ -100,6 +106,8 @@ type PostedPrice struct {
 	AskPrice sdk.Int `json:"ask_price" yaml:"ask_price" swaggertype:"string" example:"1000"`
 	// BidPrice
 	BidPrice sdk.Int `json:"bid_price" yaml:"bid_price" swaggertype:"string" example:"1000"`
+	// Traded volume the price is based on (optional, used by volume_weighted aggregation)
+	Volume sdk.Int `json:"volume" yaml:"volume" swaggertype:"string" example:"100"`
 	// UNIX Timestamp price receivedAt [sec]
 	ReceivedAt time.Time `json:"received_at" yaml:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
 }
@@ -108,11 +116,12 @@ type PostedPrice struct {
 func (pp PostedPrice) String() string {
 	return strings.TrimSpace(
 		fmt.Sprintf(
-			"AssetCode: %s\nOracleAddress: %s\nAskPrice: %s\nBidPrice: %s\nReceivedAt: %s",
+			"AssetCode: %s\nOracleAddress: %s\nAskPrice: %s\nBidPrice: %s\nVolume: %s\nReceivedAt: %s",
 			pp.AssetCode,
 			pp.OracleAddress,
 			pp.AskPrice,
 			pp.BidPrice,
+			pp.Volume,
 			pp.ReceivedAt,
 		),
 	)
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// HistoricalPrice is a CurrentPrice stored to the history at the block it was set.
+type HistoricalPrice struct {
+	// Asset code
+	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code" example:"btc_xfi"`
+	// AskPrice
+	AskPrice sdk.Int `json:"ask_price" yaml:"ask_price" swaggertype:"string" example:"1000"`
+	// BidPrice
+	BidPrice sdk.Int `json:"bid_price" yaml:"bid_price" swaggertype:"string" example:"1000"`
+	// UNIX Timestamp price createdAt [sec]
+	ReceivedAt time.Time `json:"received_at" yaml:"received_at" format:"RFC 3339" example:"2020-03-27T13:45:15.293426Z"`
+	// Block height the price was set at
+	BlockHeight int64 `json:"block_height" yaml:"block_height" example:"100"`
+}
+
+func (p HistoricalPrice) String() string {
+	return fmt.Sprintf("HistoricalPrice:\n"+
+		"AssetCode: %s\n"+
+		"AskPrice: %s\n"+
+		"BidPrice: %s\n"+
+		"ReceivedAt: %s\n"+
+		"BlockHeight: %d",
+		p.AssetCode, p.AskPrice, p.BidPrice, p.ReceivedAt, p.BlockHeight,
+	)
+}
+
+// NewHistoricalPrice creates a new HistoricalPrice object.
+func NewHistoricalPrice(price CurrentPrice, blockHeight int64) HistoricalPrice {
+	return HistoricalPrice{
+		AssetCode:   price.AssetCode,
+		AskPrice:    price.AskPrice,
+		BidPrice:    price.BidPrice,
+		ReceivedAt:  price.ReceivedAt,
+		BlockHeight: blockHeight,
+	}
+}
+
+// HistoricalPrice slice type.
+type HistoricalPrices []HistoricalPrice
+
+// Strings returns multi-line text object representation.
+func (list HistoricalPrices) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"HP.BlockHeight",
+		"HP.AskPrice",
+		"HP.BidPrice",
+		"HP.ReceivedAt",
+	})
+
+	for _, p := range list {
+		t.Append([]string{
+			strconv.FormatInt(p.BlockHeight, 10),
+			p.AskPrice.String(),
+			p.BidPrice.String(),
+			p.ReceivedAt.String(),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
+
+// TWAP returns ask and bid prices averaged over the [startHeight, endHeight] blocks range.
+// Every price is weighted by the number of blocks it was the current price within the range.
+// Contract: list is sorted by BlockHeight (ASC), first item BlockHeight is LTE startHeight, startHeight is LTE endHeight.
+func (list HistoricalPrices) TWAP(startHeight, endHeight int64) (askPrice, bidPrice sdk.Int) {
+	askSum, bidSum := sdk.ZeroInt(), sdk.ZeroInt()
+	for i, p := range list {
+		fromHeight := p.BlockHeight
+		if fromHeight < startHeight {
+			fromHeight = startHeight
+		}
+
+		toHeight := endHeight + 1
+		if i < len(list)-1 && list[i+1].BlockHeight < toHeight {
+			toHeight = list[i+1].BlockHeight
+		}
+
+		if toHeight <= fromHeight {
+			continue
+		}
+
+		blocks := toHeight - fromHeight
+		askSum, bidSum = askSum.Add(p.AskPrice.MulRaw(blocks)), bidSum.Add(p.BidPrice.MulRaw(blocks))
+	}
+
+	// since it's a price and not a balance, division with precision loss is OK
+	totalBlocks := endHeight - startHeight + 1
+
+	return askSum.QuoRaw(totalBlocks), bidSum.QuoRaw(totalBlocks)
+}
+
+// PriceTWAP contains time-weighted average prices for the particular asset over the blocks range.
+type PriceTWAP struct {
+	// Asset code
+	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code" example:"btc_xfi"`
+	// Window start block height (inclusive)
+	StartHeight int64 `json:"start_height" yaml:"start_height" example:"100"`
+	// Window end block height (inclusive)
+	EndHeight int64 `json:"end_height" yaml:"end_height" example:"200"`
+	// Time-weighted average AskPrice
+	AskPrice sdk.Int `json:"ask_price" yaml:"ask_price" swaggertype:"string" example:"1000"`
+	// Time-weighted average BidPrice
+	BidPrice sdk.Int `json:"bid_price" yaml:"bid_price" swaggertype:"string" example:"1000"`
+}
+
+func (p PriceTWAP) String() string {
+	return fmt.Sprintf("PriceTWAP:\n"+
+		"AssetCode: %s\n"+
+		"StartHeight: %d\n"+
+		"EndHeight: %d\n"+
+		"AskPrice: %s\n"+
+		"BidPrice: %s",
+		p.AssetCode, p.StartHeight, p.EndHeight, p.AskPrice, p.BidPrice,
+	)
+}
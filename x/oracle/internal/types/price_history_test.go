@@ -0,0 +1,51 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Check HistoricalPrices TWAP calculation.
+func TestOracleTypes_HistoricalPrices_TWAP(t *testing.T) {
+	t.Parallel()
+
+	newPrice := func(blockHeight, askPrice int64) HistoricalPrice {
+		return HistoricalPrice{
+			AskPrice:    sdk.NewInt(askPrice),
+			BidPrice:    sdk.NewInt(askPrice - 10),
+			BlockHeight: blockHeight,
+		}
+	}
+
+	// single price
+	{
+		askPrice, bidPrice := HistoricalPrices{newPrice(5, 100)}.TWAP(10, 20)
+		require.True(t, askPrice.Equal(sdk.NewInt(100)), askPrice.String())
+		require.True(t, bidPrice.Equal(sdk.NewInt(90)), bidPrice.String())
+	}
+
+	// single block window
+	{
+		askPrice, _ := HistoricalPrices{newPrice(5, 100), newPrice(10, 200)}.TWAP(10, 10)
+		require.True(t, askPrice.Equal(sdk.NewInt(200)), askPrice.String())
+	}
+
+	// weighted: 100 for [10, 14], 200 for [15, 17], 400 for [18, 19]
+	{
+		prices := HistoricalPrices{newPrice(1, 100), newPrice(15, 200), newPrice(18, 400)}
+		askPrice, bidPrice := prices.TWAP(10, 19)
+		require.True(t, askPrice.Equal(sdk.NewInt(190)), askPrice.String())
+		require.True(t, bidPrice.Equal(sdk.NewInt(180)), bidPrice.String())
+	}
+
+	// prices after the window end are ignored
+	{
+		prices := HistoricalPrices{newPrice(10, 100), newPrice(12, 200), newPrice(30, 1000)}
+		askPrice, _ := prices.TWAP(10, 13)
+		require.True(t, askPrice.Equal(sdk.NewInt(150)), askPrice.String())
+	}
+}
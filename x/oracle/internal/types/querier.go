@@ -8,6 +8,12 @@ const (
 	QueryPrice     = "price"
 	QueryRawPrices = "rawprices"
 	QueryAssets    = "assets"
+	// Price history queries
+	QueryPriceAtHeight = "priceatheight"
+	QueryPriceHistory  = "pricehistory"
+	QueryPriceTWAP     = "twap"
+	// Liveness queries
+	QueryLiveness = "liveness"
 )
 
 // Client response for rawPrices request.
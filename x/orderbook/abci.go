@@ -9,6 +9,7 @@ import (
 )
 
 // EndBlocker iterates over Orders module orders, processes them and returns back to the Order module.
+// Immediate orders left unfilled after the matching are revoked.
 func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
 	iterator := k.GetOrderIterator(ctx)
 	defer iterator.Close()
@@ -25,13 +26,15 @@ func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
 
 	resultCnt := 0
 	for _, result := range matcherPool.Process() {
-		k.ProcessOrderFills(ctx, result.OrderFills)
-		k.SetHistoryItem(ctx, NewHistoryItem(ctx, result))
+		executedFills := k.ProcessOrderFills(ctx, result.OrderFills)
+		k.SetHistoryItem(ctx, NewHistoryItem(ctx, result, executedFills))
 
 		resultCnt++
 		ctx.EventManager().EmitEvent(NewClearanceEvent(result))
 	}
 
+	k.RevokeImmediateOrders(ctx)
+
 	if resultCnt > 0 {
 		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
 	}
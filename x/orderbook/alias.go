@@ -10,11 +10,39 @@ type (
 	GenesisState = types.GenesisState
 	HistoryItem  = types.HistoryItem
 	HistoryItems = types.HistoryItems
+	//
+	Candle         = types.Candle
+	Candles        = types.Candles
+	CandleInterval = types.CandleInterval
+	CandlesReq     = types.CandlesReq
+	//
+	Depth       = types.Depth
+	DepthLevel  = types.DepthLevel
+	DepthLevels = types.DepthLevels
+	DepthReq    = types.DepthReq
+	//
+	SimulatedOrder      = types.SimulatedOrder
+	SimulatedOrders     = types.SimulatedOrders
+	SimulatedFill       = types.SimulatedFill
+	SimulatedFills      = types.SimulatedFills
+	SDPoint             = types.SDPoint
+	SDPoints            = types.SDPoints
+	ClearanceSimulation = types.ClearanceSimulation
+	SimulateReq         = types.SimulateReq
 )
 
 const (
 	ModuleName = types.ModuleName
 	StoreKey   = types.StoreKey
+	//
+	CandleInterval1m = types.CandleInterval1m
+	CandleInterval5m = types.CandleInterval5m
+	CandleInterval1h = types.CandleInterval1h
+	CandleInterval1d = types.CandleInterval1d
+	//
+	QueryCandles  = types.QueryCandles
+	QueryDepth    = types.QueryDepth
+	QuerySimulate = types.QuerySimulate
 	// Event types, attribute types and values
 	EventTypeClearance = types.EventTypeClearance
 	//
@@ -31,8 +59,18 @@ var (
 	RegisterCodec     = types.RegisterCodec
 	NewHistoryItem    = types.NewHistoryItem
 	NewClearanceEvent = types.NewClearanceEvent
+	NewCandles        = types.NewCandles
 	NewKeeper         = keeper.NewKeeper
+	NewQuerier        = keeper.NewQuerier
 	NewMatcherPool    = keeper.NewMatcherPool
+	//
+	NewSimulatedOrderFromString = types.NewSimulatedOrderFromString
 	// perms requests
-	RequestOrdersPerms = types.RequestOrdersPerms
+	RequestOrdersPerms  = types.RequestOrdersPerms
+	RequestMarketsPerms = types.RequestMarketsPerms
+	// error aliases
+	ErrWrongHistoryItem    = types.ErrWrongHistoryItem
+	ErrWrongCandleInterval = types.ErrWrongCandleInterval
+	ErrSimulationFailed    = types.ErrSimulationFailed
+	ErrWrongMarketID       = types.ErrWrongMarketID
 )
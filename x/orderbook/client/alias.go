@@ -6,6 +6,8 @@ const (
 	// Permissions
 	PermHistoryRead  = types.PermHistoryRead
 	PermHistoryWrite = types.PermHistoryWrite
+	PermRead         = types.PermRead
 	PermOrdersRead   = types.PermOrdersRead
 	PermExecFill     = types.PermExecFill
+	PermOrdersRevoke = types.PermOrdersRevoke
 )
@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+const (
+	flagStartTime  = "start-time"
+	flagEndTime    = "end-time"
+	flagPriceStep  = "price-step"
+	flagDepthLimit = "depth-limit"
+	flagOrders     = "orders"
+)
+
+// GetCmdCandles returns query command that lists market OHLCV candles with pagination.
+func GetCmdCandles(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "candles [market_id] [interval]",
+		Short:   "Lists market OHLCV candles built from the clearance history",
+		Example: "candles 0 1h --start-time=1600000000 --page=1 --limit=10",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("market_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			interval := types.CandleInterval(args[1])
+			if !interval.IsValid() {
+				return helpers.BuildError("interval", args[1], helpers.ParamTypeCliArg, "unsupported value")
+			}
+
+			var startTime, endTime int64
+			if v := viper.GetString(flagStartTime); v != "" {
+				if startTime, err = helpers.ParseInt64Param(flagStartTime, v, helpers.ParamTypeCliFlag); err != nil {
+					return err
+				}
+			}
+			if v := viper.GetString(flagEndTime); v != "" {
+				if endTime, err = helpers.ParseInt64Param(flagEndTime, v, helpers.ParamTypeCliFlag); err != nil {
+					return err
+				}
+			}
+
+			pageStr, limitStr := viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit)
+			page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.CandlesReq{
+				MarketID:  marketID,
+				Interval:  interval,
+				StartTime: startTime,
+				EndTime:   endTime,
+				Page:      page,
+				Limit:     limit,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCandles), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Candles
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+		"candle interval [1m / 5m / 1h / 1d]",
+	})
+	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagStartTime, "", "(optional) start UNIX timestamp [s] filter (inclusive)")
+	cmd.Flags().String(flagEndTime, "", "(optional) end UNIX timestamp [s] filter (exclusive)")
+
+	return cmd
+}
+
+// GetCmdDepth returns query command that returns market order book depth.
+func GetCmdDepth(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "depth [market_id]",
+		Short:   "Get market order book depth built from the active orders",
+		Example: "depth 0 --price-step=100 --depth-limit=20",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("market_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			priceStep, err := helpers.ParseSdkUintParam(flagPriceStep, viper.GetString(flagPriceStep), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			limit, err := helpers.ParseUint64Param(flagDepthLimit, viper.GetString(flagDepthLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.DepthReq{
+				MarketID:  marketID,
+				PriceStep: priceStep,
+				Limit:     limit,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDepth), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Depth
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+	})
+	cmd.Flags().String(flagPriceStep, "0", "(optional) price level bucket size (0 - no bucketing)")
+	cmd.Flags().String(flagDepthLimit, "0", "(optional) max number of levels per side (0 - no limit)")
+
+	return cmd
+}
+
+// GetCmdSimulate returns query command that simulates market clearance for the active and hypothetical orders.
+func GetCmdSimulate(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate [market_id]",
+		Short:   "Simulate market clearance for the active orders and optional hypothetical orders",
+		Example: "simulate 0 --orders=bid:100:10,ask:90:5:fok",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			marketID, err := helpers.ParseDnIDParam("market_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			simOrders := types.SimulatedOrders{}
+			for _, orderStr := range viper.GetStringSlice(flagOrders) {
+				order, err := types.NewSimulatedOrderFromString(orderStr)
+				if err != nil {
+					return helpers.BuildError(flagOrders, orderStr, helpers.ParamTypeCliFlag, err.Error())
+				}
+				simOrders = append(simOrders, order)
+			}
+
+			// prepare request
+			req := types.SimulateReq{
+				MarketID: marketID,
+				Orders:   simOrders,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySimulate), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ClearanceSimulation
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"market ID [uint]",
+	})
+	cmd.Flags().StringSlice(flagOrders, []string{}, "(optional) comma separated hypothetical orders in {direction}:{price}:{quantity}[:{order_type}] format")
+
+	return cmd
+}
@@ -0,0 +1,26 @@
+package client
+
+import (
+	sdkClient "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/dfinance/dnode/x/orderbook/client/cli"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// GetQueryCmd returns module query commands.
+func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Querying commands for the orderbook module",
+	}
+
+	queryCmd.AddCommand(sdkClient.GetCommands(
+		cli.GetCmdCandles(types.ModuleName, cdc),
+		cli.GetCmdDepth(types.ModuleName, cdc),
+		cli.GetCmdSimulate(types.ModuleName, cdc),
+	)...)
+
+	return queryCmd
+}
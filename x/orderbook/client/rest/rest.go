@@ -0,0 +1,236 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+const (
+	MarketID       = "marketID"
+	CandleInterval = "interval"
+	StartTime      = "startTime"
+	EndTime        = "endTime"
+	PriceStep      = "priceStep"
+	DepthLimit     = "limit"
+	SimOrders      = "orders"
+)
+
+// RegisterRoutes adds endpoint to REST router.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(fmt.Sprintf("/%s/candles/{%s}/{%s}", types.ModuleName, MarketID, CandleInterval), getCandlesWithParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/depth/{%s}", types.ModuleName, MarketID), getDepthWithParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/simulate/{%s}", types.ModuleName, MarketID), getSimulationWithParams(cliCtx)).Methods("GET")
+}
+
+// GetCandlesWithParams godoc
+// @Tags OrderBook
+// @Summary Get market candles
+// @Description Get array of market OHLCV Candle objects built from the clearance history with pagination and time filters
+// @ID orderbookGetCandlesWithParams
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Param interval path string true "candle interval (1m / 5m / 1h / 1d)"
+// @Param startTime query int false "start UNIX timestamp [s] filter (inclusive)"
+// @Param endTime query int false "end UNIX timestamp [s] filter (exclusive)"
+// @Param page query int false "page number (first page: 1)"
+// @Param limit query int false "items per page (default: 100)"
+// @Success 200 {object} OrderBookRespGetCandles
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/candles/{marketID}/{interval} [get]
+func getCandlesWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		marketID, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		interval := types.CandleInterval(vars[CandleInterval])
+		if !interval.IsValid() {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, helpers.BuildError(CandleInterval, vars[CandleInterval], helpers.ParamTypeRestPath, "unsupported value").Error())
+			return
+		}
+
+		var startTime, endTime int64
+		if v := r.URL.Query().Get(StartTime); v != "" {
+			if startTime, err = helpers.ParseInt64Param(StartTime, v, helpers.ParamTypeRestQuery); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if v := r.URL.Query().Get(EndTime); v != "" {
+			if endTime, err = helpers.ParseInt64Param(EndTime, v, helpers.ParamTypeRestQuery); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		pageStr := r.URL.Query().Get("page")
+		limitStr := r.URL.Query().Get("limit")
+		page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.CandlesReq{
+			MarketID:  marketID,
+			Interval:  interval,
+			StartTime: startTime,
+			EndTime:   endTime,
+			Page:      page,
+			Limit:     limit,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryCandles), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetDepthWithParams godoc
+// @Tags OrderBook
+// @Summary Get market depth
+// @Description Get market order book depth (bid / ask price levels) built from the active orders
+// @ID orderbookGetDepthWithParams
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Param priceStep query string false "price level bucket size (0 - no bucketing)"
+// @Param limit query int false "max number of levels per side (0 - no limit)"
+// @Success 200 {object} OrderBookRespGetDepth
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/depth/{marketID} [get]
+func getDepthWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		marketID, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		priceStep := sdk.ZeroUint()
+		if v := r.URL.Query().Get(PriceStep); v != "" {
+			if priceStep, err = helpers.ParseSdkUintParam(PriceStep, v, helpers.ParamTypeRestQuery); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		limit := uint64(0)
+		if v := r.URL.Query().Get(DepthLimit); v != "" {
+			if limit, err = helpers.ParseUint64Param(DepthLimit, v, helpers.ParamTypeRestQuery); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		// prepare request
+		req := types.DepthReq{
+			MarketID:  marketID,
+			PriceStep: priceStep,
+			Limit:     limit,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDepth), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetSimulationWithParams godoc
+// @Tags OrderBook
+// @Summary Simulate market clearance
+// @Description Get projected market clearance (clearance state, order fills and supply-demand curves) for the active and hypothetical orders
+// @ID orderbookGetSimulationWithParams
+// @Accept  json
+// @Produce json
+// @Param marketID path string true "marketID"
+// @Param orders query string false "comma separated hypothetical orders in {direction}:{price}:{quantity}[:{order_type}] format"
+// @Success 200 {object} OrderBookRespGetSimulation
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orderbook/simulate/{marketID} [get]
+func getSimulationWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		marketID, err := helpers.ParseDnIDParam(MarketID, vars[MarketID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		simOrders := types.SimulatedOrders{}
+		if v := r.URL.Query().Get(SimOrders); v != "" {
+			for _, orderStr := range strings.Split(v, ",") {
+				order, err := types.NewSimulatedOrderFromString(orderStr)
+				if err != nil {
+					rest.WriteErrorResponse(w, http.StatusBadRequest, helpers.BuildError(SimOrders, orderStr, helpers.ParamTypeRestQuery, err.Error()).Error())
+					return
+				}
+				simOrders = append(simOrders, order)
+			}
+		}
+
+		// prepare request
+		req := types.SimulateReq{
+			MarketID: marketID,
+			Orders:   simOrders,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QuerySimulate), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
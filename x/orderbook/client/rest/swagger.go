@@ -0,0 +1,23 @@
+package rest
+
+import (
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+//nolint:deadcode,unused
+type (
+	OrderBookRespGetCandles struct {
+		Height int64         `json:"height"`
+		Result types.Candles `json:"result"`
+	}
+
+	OrderBookRespGetDepth struct {
+		Height int64       `json:"height"`
+		Result types.Depth `json:"result"`
+	}
+
+	OrderBookRespGetSimulation struct {
+		Height int64                     `json:"height"`
+		Result types.ClearanceSimulation `json:"result"`
+	}
+)
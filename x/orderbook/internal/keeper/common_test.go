@@ -19,11 +19,13 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/dfinance/dnode/helpers/perms"
 	"github.com/dfinance/dnode/helpers/tests"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/oracle"
 	"github.com/dfinance/dnode/x/orderbook/internal/types"
 	"github.com/dfinance/dnode/x/orders"
 	"github.com/dfinance/dnode/x/vm"
@@ -41,6 +43,7 @@ type TestInput struct {
 	keyMarkets *sdk.KVStoreKey
 	keyOrders  *sdk.KVStoreKey
 	keyOB      *sdk.KVStoreKey
+	keyOracle  *sdk.KVStoreKey
 	keyVMS     *sdk.KVStoreKey
 	tKeyParams *sdk.TransientStoreKey
 	//
@@ -56,6 +59,7 @@ type TestInput struct {
 	supplyKeeper  supply.Keeper
 	ccsKeeper     ccstorage.Keeper
 	marketKeeper  markets.Keeper
+	oracleKeeper  oracle.Keeper
 	orderKeeper   orders.Keeper
 	paramsKeeper  params.Keeper
 	keeper        Keeper
@@ -73,6 +77,7 @@ func NewTestInput(t *testing.T) TestInput {
 		keyMarkets: sdk.NewKVStoreKey(markets.StoreKey),
 		keyOrders:  sdk.NewKVStoreKey(orders.StoreKey),
 		keyOB:      sdk.NewKVStoreKey(types.StoreKey),
+		keyOracle:  sdk.NewKVStoreKey(oracle.StoreKey),
 		keyVMS:     sdk.NewKVStoreKey(vm.StoreKey),
 		tKeyParams: sdk.NewTransientStoreKey(params.TStoreKey),
 		//
@@ -91,6 +96,7 @@ func NewTestInput(t *testing.T) TestInput {
 	auth.RegisterCodec(input.cdc)
 	bank.RegisterCodec(input.cdc)
 	supply.RegisterCodec(input.cdc)
+	oracle.RegisterCodec(input.cdc)
 	orders.RegisterCodec(input.cdc)
 	types.RegisterCodec(input.cdc)
 
@@ -105,9 +111,16 @@ func NewTestInput(t *testing.T) TestInput {
 	mstore.MountStoreWithDB(input.keyMarkets, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyOrders, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyOB, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyOracle, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.tKeyParams, sdk.StoreTypeTransient, db)
 	require.NoError(t, mstore.LoadLatestVersion(), "in-memory DB init")
 
+	// create custom markets module permission requester as some test do need to create markets
+	marketsRequester := func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName, modulePerms = types.ModuleName, markets.AvailablePermissions
+		return
+	}
+
 	// create target and dependant keepers
 	input.vmStorage = tests.NewVMStorage(input.keyVMS)
 	input.paramsKeeper = params.NewKeeper(input.cdc, input.keyParams, input.tKeyParams)
@@ -125,6 +138,14 @@ func NewTestInput(t *testing.T) TestInput {
 		input.keyMarkets,
 		input.ccsKeeper,
 		orders.RequestMarketsPerms(),
+		marketsRequester,
+	)
+	input.oracleKeeper = oracle.NewKeeper(
+		input.cdc,
+		input.keyOracle,
+		input.paramsKeeper.Subspace(oracle.DefaultParamspace),
+		input.vmStorage,
+		orders.RequestOraclePerms(),
 	)
 	input.orderKeeper = orders.NewKeeper(
 		input.cdc,
@@ -132,9 +153,10 @@ func NewTestInput(t *testing.T) TestInput {
 		input.bankKeeper,
 		input.supplyKeeper,
 		input.marketKeeper,
+		input.oracleKeeper,
 		types.RequestOrdersPerms(),
 	)
-	input.keeper = NewKeeper(input.cdc, input.keyOB, input.orderKeeper)
+	input.keeper = NewKeeper(input.cdc, input.keyOB, input.orderKeeper, input.marketKeeper)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// GetDepth builds market bid / ask depth levels from the active orders.
+// Levels are grouped by priceStep if it is not zero (bid prices are rounded down, ask prices are rounded up).
+// Number of levels per side is limited if limit is not zero.
+func (k Keeper) GetDepth(ctx sdk.Context, marketID dnTypes.ID, priceStep sdk.Uint, limit uint64) (types.Depth, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	iterator := k.GetOrderIterator(ctx)
+	defer iterator.Close()
+
+	bidOrders, askOrders := orders.Orders{}, orders.Orders{}
+	for ; iterator.Valid(); iterator.Next() {
+		order := orders.Order{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &order); err != nil {
+			return types.Depth{}, fmt.Errorf("order unmarshal: %w", err)
+		}
+
+		if !order.Market.ID.Equal(marketID) {
+			continue
+		}
+
+		switch order.Direction {
+		case orders.BidDirection:
+			bidOrders = append(bidOrders, order)
+		case orders.AskDirection:
+			askOrders = append(askOrders, order)
+		}
+	}
+
+	sort.Sort(ByPriceAscIDDesc(bidOrders))
+	sort.Sort(ByPriceAscIDAsc(askOrders))
+
+	return types.Depth{
+		MarketID: marketID,
+		Bids:     newBidDepthLevels(NewBidOrderAggregates(bidOrders), priceStep, limit),
+		Asks:     newAskDepthLevels(NewAskOrderAggregates(askOrders), priceStep, limit),
+	}, nil
+}
+
+// newBidDepthLevels converts bid aggregates (price sorted ASC, quantity accumulated from the highest price)
+// to depth levels (price sorted DESC).
+func newBidDepthLevels(aggs OrderAggregates, priceStep sdk.Uint, limit uint64) types.DepthLevels {
+	levels := make(types.DepthLevels, 0, len(aggs))
+	for i := len(aggs) - 1; i >= 0; i-- {
+		quantity := aggs[i].Quantity
+		if i < len(aggs)-1 {
+			quantity = quantity.Sub(aggs[i+1].Quantity)
+		}
+
+		price := aggs[i].Price
+		if !priceStep.IsZero() {
+			price = price.Quo(priceStep).Mul(priceStep)
+		}
+
+		levels = appendDepthLevel(levels, price, quantity, aggs[i].Quantity)
+	}
+
+	return limitDepthLevels(levels, limit)
+}
+
+// newAskDepthLevels converts ask aggregates (price sorted ASC, quantity accumulated from the lowest price)
+// to depth levels (price sorted ASC).
+func newAskDepthLevels(aggs OrderAggregates, priceStep sdk.Uint, limit uint64) types.DepthLevels {
+	levels := make(types.DepthLevels, 0, len(aggs))
+	for i := 0; i < len(aggs); i++ {
+		quantity := aggs[i].Quantity
+		if i > 0 {
+			quantity = quantity.Sub(aggs[i-1].Quantity)
+		}
+
+		price := aggs[i].Price
+		if !priceStep.IsZero() {
+			rounded := price.Quo(priceStep).Mul(priceStep)
+			if !rounded.Equal(price) {
+				rounded = rounded.Add(priceStep)
+			}
+			price = rounded
+		}
+
+		levels = appendDepthLevel(levels, price, quantity, aggs[i].Quantity)
+	}
+
+	return limitDepthLevels(levels, limit)
+}
+
+// appendDepthLevel appends a new level or merges it with the last one if prices are equal.
+func appendDepthLevel(levels types.DepthLevels, price, quantity, cumulativeQuantity sdk.Uint) types.DepthLevels {
+	lastIdx := len(levels) - 1
+	if lastIdx >= 0 && levels[lastIdx].Price.Equal(price) {
+		levels[lastIdx].Quantity = levels[lastIdx].Quantity.Add(quantity)
+		levels[lastIdx].CumulativeQuantity = cumulativeQuantity
+
+		return levels
+	}
+
+	return append(levels, types.DepthLevel{
+		Price:              price,
+		Quantity:           quantity,
+		CumulativeQuantity: cumulativeQuantity,
+	})
+}
+
+// limitDepthLevels cuts levels by limit (zero limit disables the cut).
+func limitDepthLevels(levels types.DepthLevels, limit uint64) types.DepthLevels {
+	if limit > 0 && uint64(len(levels)) > limit {
+		return levels[:limit]
+	}
+
+	return levels
+}
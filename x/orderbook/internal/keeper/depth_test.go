@@ -0,0 +1,133 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+func CompareDepthLevels(t *testing.T, expected, received types.DepthLevels) {
+	require.Len(t, received, len(expected))
+	for i := range received {
+		require.True(t, expected[i].Price.Equal(received[i].Price), "%d: Price (expected / received): %s / %s", i, expected[i].Price, received[i].Price)
+		require.True(t, expected[i].Quantity.Equal(received[i].Quantity), "%d: Quantity (expected / received): %s / %s", i, expected[i].Quantity, received[i].Quantity)
+		require.True(t, expected[i].CumulativeQuantity.Equal(received[i].CumulativeQuantity), "%d: CumulativeQuantity (expected / received): %s / %s", i, expected[i].CumulativeQuantity, received[i].CumulativeQuantity)
+	}
+}
+
+func TestOBKeeper_Depth_Bid(t *testing.T) {
+	t.Parallel()
+
+	aggs := NewBidOrderAggregates(orders.Orders{
+		orders.Order{Price: sdk.NewUint(10), Quantity: sdk.NewUint(50)},
+		orders.Order{Price: sdk.NewUint(15), Quantity: sdk.NewUint(25)},
+		orders.Order{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
+		orders.Order{Price: sdk.NewUint(50), Quantity: sdk.NewUint(50)},
+		orders.Order{Price: sdk.NewUint(55), Quantity: sdk.NewUint(10)},
+	})
+
+	// no bucketing, no limit
+	{
+		levels := newBidDepthLevels(aggs, sdk.ZeroUint(), 0)
+		CompareDepthLevels(t, types.DepthLevels{
+			{Price: sdk.NewUint(55), Quantity: sdk.NewUint(10), CumulativeQuantity: sdk.NewUint(10)},
+			{Price: sdk.NewUint(50), Quantity: sdk.NewUint(150), CumulativeQuantity: sdk.NewUint(160)},
+			{Price: sdk.NewUint(15), Quantity: sdk.NewUint(25), CumulativeQuantity: sdk.NewUint(185)},
+			{Price: sdk.NewUint(10), Quantity: sdk.NewUint(50), CumulativeQuantity: sdk.NewUint(235)},
+		}, levels)
+	}
+
+	// bucketing (prices rounded down)
+	{
+		levels := newBidDepthLevels(aggs, sdk.NewUint(10), 0)
+		CompareDepthLevels(t, types.DepthLevels{
+			{Price: sdk.NewUint(50), Quantity: sdk.NewUint(160), CumulativeQuantity: sdk.NewUint(160)},
+			{Price: sdk.NewUint(10), Quantity: sdk.NewUint(75), CumulativeQuantity: sdk.NewUint(235)},
+		}, levels)
+	}
+
+	// limit
+	{
+		levels := newBidDepthLevels(aggs, sdk.ZeroUint(), 2)
+		CompareDepthLevels(t, types.DepthLevels{
+			{Price: sdk.NewUint(55), Quantity: sdk.NewUint(10), CumulativeQuantity: sdk.NewUint(10)},
+			{Price: sdk.NewUint(50), Quantity: sdk.NewUint(150), CumulativeQuantity: sdk.NewUint(160)},
+		}, levels)
+	}
+}
+
+func TestOBKeeper_Depth_Ask(t *testing.T) {
+	t.Parallel()
+
+	aggs := NewAskOrderAggregates(orders.Orders{
+		orders.Order{Price: sdk.NewUint(10), Quantity: sdk.NewUint(50)},
+		orders.Order{Price: sdk.NewUint(15), Quantity: sdk.NewUint(25)},
+		orders.Order{Price: sdk.NewUint(50), Quantity: sdk.NewUint(100)},
+		orders.Order{Price: sdk.NewUint(50), Quantity: sdk.NewUint(50)},
+		orders.Order{Price: sdk.NewUint(55), Quantity: sdk.NewUint(10)},
+	})
+
+	// no bucketing, no limit
+	{
+		levels := newAskDepthLevels(aggs, sdk.ZeroUint(), 0)
+		CompareDepthLevels(t, types.DepthLevels{
+			{Price: sdk.NewUint(10), Quantity: sdk.NewUint(50), CumulativeQuantity: sdk.NewUint(50)},
+			{Price: sdk.NewUint(15), Quantity: sdk.NewUint(25), CumulativeQuantity: sdk.NewUint(75)},
+			{Price: sdk.NewUint(50), Quantity: sdk.NewUint(150), CumulativeQuantity: sdk.NewUint(225)},
+			{Price: sdk.NewUint(55), Quantity: sdk.NewUint(10), CumulativeQuantity: sdk.NewUint(235)},
+		}, levels)
+	}
+
+	// bucketing (prices rounded up)
+	{
+		levels := newAskDepthLevels(aggs, sdk.NewUint(10), 0)
+		CompareDepthLevels(t, types.DepthLevels{
+			{Price: sdk.NewUint(10), Quantity: sdk.NewUint(50), CumulativeQuantity: sdk.NewUint(50)},
+			{Price: sdk.NewUint(20), Quantity: sdk.NewUint(25), CumulativeQuantity: sdk.NewUint(75)},
+			{Price: sdk.NewUint(50), Quantity: sdk.NewUint(150), CumulativeQuantity: sdk.NewUint(225)},
+			{Price: sdk.NewUint(60), Quantity: sdk.NewUint(10), CumulativeQuantity: sdk.NewUint(235)},
+		}, levels)
+	}
+
+	// limit
+	{
+		levels := newAskDepthLevels(aggs, sdk.NewUint(100), 1)
+		CompareDepthLevels(t, types.DepthLevels{
+			{Price: sdk.NewUint(100), Quantity: sdk.NewUint(235), CumulativeQuantity: sdk.NewUint(235)},
+		}, levels)
+	}
+}
+
+func TestOBKeeper_Depth_QueryDefaults(t *testing.T) {
+	input := NewTestInput(t)
+	querier := NewQuerier(input.keeper)
+
+	// put an active order directly to the orders store
+	order := orders.Order{
+		ID:        dnTypes.NewIDFromUint64(0),
+		Market:    markets.MarketExtended{ID: dnTypes.NewIDFromUint64(0)},
+		Direction: orders.BidDirection,
+		Price:     sdk.NewUint(10),
+		Quantity:  sdk.NewUint(50),
+	}
+	input.ctx.KVStore(input.keyOrders).Set([]byte("order:0"), input.cdc.MustMarshalBinaryLengthPrefixed(order))
+
+	// price_step and limit are omitted
+	res, err := querier(input.ctx, []string{types.QueryDepth}, abci.RequestQuery{Data: []byte(`{"market_id":"0"}`)})
+	require.NoError(t, err)
+
+	var depth types.Depth
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &depth))
+	require.Len(t, depth.Bids, 1)
+	require.True(t, depth.Bids[0].Price.Equal(order.Price))
+	require.Empty(t, depth.Asks)
+}
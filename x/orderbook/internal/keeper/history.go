@@ -92,3 +92,37 @@ func (k Keeper) SetHistoryItem(ctx sdk.Context, item types.HistoryItem) {
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(item)
 	store.Set(key, bz)
 }
+
+// GetCandles returns marketID OHLCV candles built from historyItems within [startTime, endTime) timestamp range.
+// Zero endTime disables the upper bound.
+func (k Keeper) GetCandles(ctx sdk.Context, marketID dnTypes.ID, interval types.CandleInterval, startTime, endTime int64) (types.Candles, error) {
+	k.modulePerms.AutoCheck(types.PermHistoryRead)
+
+	if !interval.IsValid() {
+		return types.Candles{}, sdkErrors.Wrap(types.ErrWrongCandleInterval, interval.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetHistoryItemMarketPrefix(marketID))
+	defer iterator.Close()
+
+	items := types.HistoryItems{}
+	for ; iterator.Valid(); iterator.Next() {
+		item := types.HistoryItem{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &item); err != nil {
+			return types.Candles{}, sdkErrors.Wrap(types.ErrInternal, "historyItem unmarshal")
+		}
+
+		if item.Timestamp < startTime {
+			continue
+		}
+		// items are sorted by blockHeight, so there is no need to iterate further
+		if endTime > 0 && item.Timestamp >= endTime {
+			break
+		}
+
+		items = append(items, item)
+	}
+
+	return types.NewCandles(items, interval), nil
+}
@@ -5,6 +5,7 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
@@ -90,3 +91,87 @@ func TestOBKeeper_History_StoreIO(t *testing.T) {
 		}
 	}
 }
+
+func TestOBKeeper_History_Candles(t *testing.T) {
+	input := NewTestInput(t)
+	marketID := dnTypes.NewIDFromUint64(0)
+
+	baseTime := int64(1600000000)
+	baseTime -= baseTime % 3600
+
+	newItem := func(marketID dnTypes.ID, blockHeight, timestamp int64, price, volume uint64) types.HistoryItem {
+		item := NewMockHistoryItem(marketID, blockHeight)
+		item.Timestamp = timestamp
+		item.ClearancePrice = sdk.NewUint(price)
+		item.MatchedBidVolume = sdk.NewUint(volume)
+
+		return item
+	}
+
+	input.keeper.SetHistoryItem(input.ctx, newItem(marketID, 1, baseTime, 100, 10))
+	input.keeper.SetHistoryItem(input.ctx, newItem(marketID, 2, baseTime+30, 150, 20))
+	input.keeper.SetHistoryItem(input.ctx, newItem(marketID, 3, baseTime+40, 90, 30))
+	input.keeper.SetHistoryItem(input.ctx, newItem(marketID, 4, baseTime+70, 120, 40))
+	input.keeper.SetHistoryItem(input.ctx, newItem(marketID, 5, baseTime+3700, 110, 50))
+	input.keeper.SetHistoryItem(input.ctx, newItem(dnTypes.NewIDFromUint64(1), 2, baseTime+30, 1000, 1000))
+
+	// invalid interval
+	{
+		_, err := input.keeper.GetCandles(input.ctx, marketID, types.CandleInterval("2m"), 0, 0)
+		require.Error(t, err)
+	}
+
+	// non-existing market
+	{
+		candles, err := input.keeper.GetCandles(input.ctx, dnTypes.NewIDFromUint64(2), types.CandleInterval1m, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, candles, 0)
+	}
+
+	// 1m candles
+	{
+		candles, err := input.keeper.GetCandles(input.ctx, marketID, types.CandleInterval1m, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, candles, 3)
+
+		require.Equal(t, baseTime, candles[0].StartTime)
+		require.True(t, candles[0].Open.Equal(sdk.NewUint(100)))
+		require.True(t, candles[0].High.Equal(sdk.NewUint(150)))
+		require.True(t, candles[0].Low.Equal(sdk.NewUint(90)))
+		require.True(t, candles[0].Close.Equal(sdk.NewUint(90)))
+		require.True(t, candles[0].Volume.Equal(sdk.NewUint(60)))
+
+		require.Equal(t, baseTime+60, candles[1].StartTime)
+		require.True(t, candles[1].Open.Equal(sdk.NewUint(120)))
+		require.True(t, candles[1].Close.Equal(sdk.NewUint(120)))
+		require.True(t, candles[1].Volume.Equal(sdk.NewUint(40)))
+
+		require.Equal(t, baseTime+3660, candles[2].StartTime)
+	}
+
+	// 1h candles
+	{
+		candles, err := input.keeper.GetCandles(input.ctx, marketID, types.CandleInterval1h, 0, 0)
+		require.NoError(t, err)
+		require.Len(t, candles, 2)
+
+		require.True(t, candles[0].MarketID.Equal(marketID))
+		require.Equal(t, types.CandleInterval1h, candles[0].Interval)
+		require.True(t, candles[0].Open.Equal(sdk.NewUint(100)))
+		require.True(t, candles[0].High.Equal(sdk.NewUint(150)))
+		require.True(t, candles[0].Low.Equal(sdk.NewUint(90)))
+		require.True(t, candles[0].Close.Equal(sdk.NewUint(120)))
+		require.True(t, candles[0].Volume.Equal(sdk.NewUint(100)))
+	}
+
+	// time range filter
+	{
+		candles, err := input.keeper.GetCandles(input.ctx, marketID, types.CandleInterval1h, baseTime+30, baseTime+3700)
+		require.NoError(t, err)
+		require.Len(t, candles, 1)
+
+		require.True(t, candles[0].Open.Equal(sdk.NewUint(150)))
+		require.True(t, candles[0].Close.Equal(sdk.NewUint(120)))
+		require.True(t, candles[0].Volume.Equal(sdk.NewUint(90)))
+	}
+}
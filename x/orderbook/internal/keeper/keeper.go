@@ -7,16 +7,18 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/dfinance/dnode/helpers/perms"
+	"github.com/dfinance/dnode/x/markets"
 	"github.com/dfinance/dnode/x/orderbook/internal/types"
 	"github.com/dfinance/dnode/x/orders"
 )
 
 // Module keeper object.
 type Keeper struct {
-	cdc         *codec.Codec
-	storeKey    sdk.StoreKey
-	orderKeeper orders.Keeper
-	modulePerms perms.ModulePermissions
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	orderKeeper  orders.Keeper
+	marketKeeper markets.Keeper
+	modulePerms  perms.ModulePermissions
 }
 
 // GetLogger gets logger with keeper context.
@@ -31,11 +33,18 @@ func (k Keeper) GetOrderIterator(ctx sdk.Context) sdk.Iterator {
 	return k.orderKeeper.GetIterator(ctx)
 }
 
-// ProcessOrderFills passes order fills to the orders module.
-func (k Keeper) ProcessOrderFills(ctx sdk.Context, orderFills orders.OrderFills) {
+// ProcessOrderFills passes order fills to the orders module and returns executed order fills (with charged fees).
+func (k Keeper) ProcessOrderFills(ctx sdk.Context, orderFills orders.OrderFills) orders.OrderFills {
 	k.modulePerms.AutoCheck(types.PermExecFill)
 
-	k.orderKeeper.ExecuteOrderFills(ctx, orderFills)
+	return k.orderKeeper.ExecuteOrderFills(ctx, orderFills)
+}
+
+// RevokeImmediateOrders cancels immediate orders which weren't filled by the orders module.
+func (k Keeper) RevokeImmediateOrders(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermOrdersRevoke)
+
+	k.orderKeeper.RevokeImmediateOrders(ctx)
 }
 
 // NewKeeper creates keeper object.
@@ -43,13 +52,15 @@ func NewKeeper(
 	cdc *codec.Codec,
 	storeKey sdk.StoreKey,
 	ok orders.Keeper,
+	mk markets.Keeper,
 	permsRequesters ...perms.RequestModulePermissions,
 ) Keeper {
 	k := Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		orderKeeper: ok,
-		modulePerms: types.NewModulePerms(),
+		cdc:          cdc,
+		storeKey:     storeKey,
+		orderKeeper:  ok,
+		marketKeeper: mk,
+		modulePerms:  types.NewModulePerms(),
 	}
 	for _, requester := range permsRequesters {
 		k.modulePerms.AutoAddRequester(requester)
@@ -74,8 +74,23 @@ func (m *Matcher) AddOrder(order *orders.Order) error {
 	return nil
 }
 
-// Match sorts order queues, builds order aggregates and SDCurves.
+// Match matches order queues.
+// Matching is repeated without FillOrKill orders that can't be fully filled, so those orders don't affect the result.
 func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
+	for {
+		var killedOrders orders.Orders
+		result, killedOrders, retErr = m.match()
+		if retErr != nil || len(killedOrders) == 0 {
+			return
+		}
+
+		m.removeOrders(killedOrders)
+	}
+}
+
+// match sorts order queues, builds order aggregates and SDCurves.
+// Returns FillOrKill orders that would be partially filled (result is not valid in that case).
+func (m *Matcher) match() (result types.MatcherResult, killedOrders orders.Orders, retErr error) {
 	// orders sorting and aggregating (that can be safely paralleled)
 	wg := sync.WaitGroup{}
 	wg.Add(2)
@@ -123,8 +138,12 @@ func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
 	}
 
 	// fill up orders
-	bidFills, bidMatchedVolume := m.getBidOrderFills(clearanceState)
-	askFills, askMatchedVolume := m.getAskOrderFills(clearanceState)
+	bidFills, bidMatchedVolume, bidKilledOrders := m.getBidOrderFills(clearanceState)
+	askFills, askMatchedVolume, askKilledOrders := m.getAskOrderFills(clearanceState)
+	if len(bidKilledOrders) > 0 || len(askKilledOrders) > 0 {
+		killedOrders = append(bidKilledOrders, askKilledOrders...)
+		return
+	}
 
 	// build the result
 	result = types.MatcherResult{
@@ -155,7 +174,7 @@ func (m *Matcher) Match() (result types.MatcherResult, retErr error) {
 }
 
 // getBidOrderFills fills up bid orders in reverse order (from highest target price and lower order IDs).
-func (m *Matcher) getBidOrderFills(clearanceState types.ClearanceState) (fills orders.OrderFills, matchedVolume sdk.Dec) {
+func (m *Matcher) getBidOrderFills(clearanceState types.ClearanceState) (fills orders.OrderFills, matchedVolume sdk.Dec, killedOrders orders.Orders) {
 	// fills stores result order fills
 	// matchedVolume stores current matched volume (should be <= clearanceState.MaxBidVolume
 	fills, matchedVolume = make(orders.OrderFills, 0, len(m.orders.bid)), sdk.ZeroDec()
@@ -182,6 +201,12 @@ func (m *Matcher) getBidOrderFills(clearanceState types.ClearanceState) (fills o
 			fillQuantity = sdk.NewUintFromBigInt(orderQtyDec.RoundInt().BigInt())
 		}
 
+		// FillOrKill order can't be partially filled
+		if order.OrderType == orders.FillOrKillOrderType && fillQuantity.LT(order.Quantity) {
+			killedOrders = append(killedOrders, *order)
+			continue
+		}
+
 		if fillQuantity.IsZero() {
 			continue
 		}
@@ -199,7 +224,7 @@ func (m *Matcher) getBidOrderFills(clearanceState types.ClearanceState) (fills o
 }
 
 // getAskOrderFills fills up ask orders in direct order (from lowest target price and lower order IDs).
-func (m *Matcher) getAskOrderFills(clearanceState types.ClearanceState) (fills orders.OrderFills, matchedVolume sdk.Dec) {
+func (m *Matcher) getAskOrderFills(clearanceState types.ClearanceState) (fills orders.OrderFills, matchedVolume sdk.Dec, killedOrders orders.Orders) {
 	// fills stores result order fills
 	// matchedVolume stores current matched volume (should be <= clearanceState.MaxBidVolume
 	fills, matchedVolume = make(orders.OrderFills, 0, len(m.orders.ask)), sdk.ZeroDec()
@@ -226,6 +251,12 @@ func (m *Matcher) getAskOrderFills(clearanceState types.ClearanceState) (fills o
 			fillQuantity = sdk.NewUintFromBigInt(orderQtyDec.RoundInt().BigInt())
 		}
 
+		// FillOrKill order can't be partially filled
+		if order.OrderType == orders.FillOrKillOrderType && fillQuantity.LT(order.Quantity) {
+			killedOrders = append(killedOrders, *order)
+			continue
+		}
+
 		if fillQuantity.IsZero() {
 			continue
 		}
@@ -242,6 +273,28 @@ func (m *Matcher) getAskOrderFills(clearanceState types.ClearanceState) (fills o
 	return
 }
 
+// removeOrders removes orders from bid/ask queues.
+func (m *Matcher) removeOrders(ordersToRemove orders.Orders) {
+	removeIDs := make(map[string]bool, len(ordersToRemove))
+	for _, order := range ordersToRemove {
+		removeIDs[order.ID.String()] = true
+	}
+
+	filter := func(queue orders.Orders) orders.Orders {
+		filtered := make(orders.Orders, 0, len(queue))
+		for _, order := range queue {
+			if !removeIDs[order.ID.String()] {
+				filtered = append(filtered, order)
+			}
+		}
+
+		return filtered
+	}
+
+	m.orders.bid = filter(m.orders.bid)
+	m.orders.ask = filter(m.orders.ask)
+}
+
 // GetSDCurves returns SDCurves (for debug use only).
 func (m *Matcher) GetSDCurves() SDCurves {
 	return m.sdCurves
@@ -40,6 +40,8 @@ type MatchingPoolOrderInput struct {
 	MarketID  uint64
 	OrderID   uint64
 	Direction orders.Direction
+	// Order type (empty - limit).
+	OrderType orders.OrderType
 	Price     uint64
 	// Order initial quantity.
 	InQuantity uint64
@@ -69,6 +71,7 @@ func (i *MatchingPoolInput) PostOrders(t *testing.T, pool *MatcherPool) {
 			ID:        dnTypes.NewIDFromUint64(input.OrderID),
 			Market:    extMarkets[input.MarketID],
 			Direction: input.Direction,
+			OrderType: orders.NewOrderTypeRaw(input.OrderType.String()),
 			Price:     sdk.NewUint(input.Price),
 			Quantity:  sdk.NewUint(input.InQuantity),
 		}
@@ -377,3 +380,42 @@ func TestOBKeeper_Matching_TwoOrders(t *testing.T) {
 	inputs.PrintResults(results)
 	inputs.PrintCurves(&matcherPool)
 }
+
+func TestOBKeeper_Matching_FillOrKill(t *testing.T) {
+	// FillOrKill order check.
+	// Demand is higher than supply, so bids would be filled partially (ProRata < 1.0).
+	// FillOrKill order #2 can't be fully filled and should be excluded, order #1 should be fully filled after that.
+	inputs := MatchingPoolInput{
+		Markets: []MatchingPoolMarketInput{
+			{BaseDenom: "btc", QuoteDenom: "xfi", BaseDecimals: 0, QuoteDecimals: 0},
+		},
+		Orders: []MatchingPoolOrderInput{
+			{MarketID: 0, Direction: orders.AskDirection, OrderID: 0, Price: 50, InQuantity: 100, OutQuantity: 50},
+			{MarketID: 0, Direction: orders.BidDirection, OrderID: 1, Price: 100, InQuantity: 50, OutQuantity: 50},
+			{MarketID: 0, Direction: orders.BidDirection, OrderID: 2, Price: 100, InQuantity: 100, OrderType: orders.FillOrKillOrderType},
+		},
+	}
+
+	testLogger := logger.NewDNLogger()
+	testLogger = log.NewFilter(testLogger, log.AllowAll())
+	matcherPool := NewMatcherPool(testLogger)
+
+	inputs.PostOrders(t, &matcherPool)
+	results := matcherPool.Process()
+	require.Len(t, results, 1)
+	require.Len(t, results[0].OrderFills, 2)
+	for _, fill := range results[0].OrderFills {
+		require.NotEqual(t, uint64(2), fill.Order.ID.UInt64(), "FillOrKill order filled")
+	}
+	require.Equal(t, 1, results[0].BidOrdersCount)
+	require.Equal(t, 1, results[0].AskOrdersCount)
+
+	for _, fill := range results[0].OrderFills {
+		for _, orderInput := range inputs.Orders {
+			if orderInput.OrderID == fill.Order.ID.UInt64() {
+				require.Equal(t, orderInput.OutQuantity, fill.QuantityFilled.Uint64(), "order %d: OutQuantity / FillQuantity", orderInput.OrderID)
+			}
+		}
+	}
+	inputs.PrintResults(results)
+}
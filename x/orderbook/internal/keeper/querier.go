@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+)
+
+// NewQuerier return keeper querier.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryCandles:
+			return queryCandles(ctx, k, req)
+		case types.QueryDepth:
+			return queryDepth(ctx, k, req)
+		case types.QuerySimulate:
+			return querySimulate(ctx, k, req)
+		default:
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
+		}
+	}
+}
+
+// queryCandles handles candles query which return market OHLCV candles with pagination.
+func queryCandles(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.CandlesReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	candles, err := k.GetCandles(ctx, params.MarketID, params.Interval, params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := helpers.PaginateSlice(len(candles), params.Page, params.Limit)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "pagination: %v", err)
+	}
+	candles = candles[start:end]
+
+	res, err := codec.MarshalJSONIndent(k.cdc, candles)
+	if err != nil {
+		return nil, fmt.Errorf("candles marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// queryDepth handles depth query which return market order book snapshot.
+func queryDepth(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.DepthReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	// priceStep is optional: nil if omitted from the request (no bucketing)
+	if reflect.DeepEqual(params.PriceStep, sdk.Uint{}) {
+		params.PriceStep = sdk.ZeroUint()
+	}
+
+	depth, err := k.GetDepth(ctx, params.MarketID, params.PriceStep, params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, depth)
+	if err != nil {
+		return nil, fmt.Errorf("depth marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// querySimulate handles simulate query which return projected market clearance for active and hypothetical orders.
+func querySimulate(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.SimulateReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	simulation, err := k.SimulateClearance(ctx, params.MarketID, params.Orders)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, simulation)
+	if err != nil {
+		return nil, fmt.Errorf("simulation marshal: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// SimulateClearance runs the matcher over the active market orders and hypothetical orders without committing the result.
+// Hypothetical orders get IDs following the highest market order ID, so they have the lowest execution priority.
+func (k Keeper) SimulateClearance(ctx sdk.Context, marketID dnTypes.ID, simOrders types.SimulatedOrders) (types.ClearanceSimulation, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.marketKeeper.Has(ctx, marketID) {
+		return types.ClearanceSimulation{}, sdkErrors.Wrapf(types.ErrWrongMarketID, "marketID %s: not found", marketID)
+	}
+
+	iterator := k.GetOrderIterator(ctx)
+	defer iterator.Close()
+
+	matcher := NewMatcher(marketID, k.GetLogger(ctx))
+	var market *markets.MarketExtended
+	nextID := dnTypes.NewZeroID()
+	for ; iterator.Valid(); iterator.Next() {
+		order := orders.Order{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &order); err != nil {
+			return types.ClearanceSimulation{}, fmt.Errorf("order unmarshal: %w", err)
+		}
+
+		if !order.Market.ID.Equal(marketID) {
+			continue
+		}
+
+		if err := matcher.AddOrder(&order); err != nil {
+			return types.ClearanceSimulation{}, err
+		}
+
+		if market == nil {
+			market = &order.Market
+		}
+		if order.ID.GTE(nextID) {
+			nextID = order.ID.Incr()
+		}
+	}
+
+	hypotheticalIDs := make(map[string]bool, len(simOrders))
+	for i, simOrder := range simOrders {
+		if err := simOrder.Valid(); err != nil {
+			return types.ClearanceSimulation{}, sdkErrors.Wrapf(types.ErrSimulationFailed, "orders[%d]: %v", i, err)
+		}
+
+		order := orders.Order{
+			ID:        nextID,
+			Direction: simOrder.Direction,
+			OrderType: orders.NewOrderTypeRaw(simOrder.OrderType.String()),
+			Price:     simOrder.Price,
+			Quantity:  simOrder.Quantity,
+		}
+		if market != nil {
+			order.Market = *market
+		} else {
+			order.Market.ID = marketID
+		}
+
+		if err := matcher.AddOrder(&order); err != nil {
+			return types.ClearanceSimulation{}, err
+		}
+
+		hypotheticalIDs[nextID.String()] = true
+		nextID = nextID.Incr()
+	}
+
+	result, err := matcher.Match()
+	if err != nil {
+		if types.ErrInternal.Is(err) {
+			return types.ClearanceSimulation{}, err
+		}
+
+		return types.ClearanceSimulation{}, sdkErrors.Wrapf(types.ErrSimulationFailed, "marketID %s: %v", marketID, err)
+	}
+
+	fills := make(types.SimulatedFills, 0, len(result.OrderFills))
+	for _, fill := range result.OrderFills {
+		fills = append(fills, types.SimulatedFill{
+			OrderID:          fill.Order.ID,
+			Hypothetical:     hypotheticalIDs[fill.Order.ID.String()],
+			Direction:        fill.Order.Direction,
+			Price:            fill.Order.Price,
+			QuantityFilled:   fill.QuantityFilled,
+			QuantityUnfilled: fill.QuantityUnfilled,
+		})
+	}
+
+	sdCurves := matcher.GetSDCurves()
+	curves := make(types.SDPoints, 0, len(sdCurves))
+	for _, item := range sdCurves {
+		curves = append(curves, types.SDPoint{
+			Price:  item.Price,
+			Supply: item.Supply,
+			Demand: item.Demand,
+		})
+	}
+
+	return types.ClearanceSimulation{
+		MarketID:         marketID,
+		BidOrdersCount:   result.BidOrdersCount,
+		AskOrdersCount:   result.AskOrdersCount,
+		ClearanceState:   result.ClearanceState,
+		MatchedBidVolume: result.MatchedBidVolume,
+		MatchedAskVolume: result.MatchedAskVolume,
+		Fills:            fills,
+		Curves:           curves,
+	}, nil
+}
@@ -0,0 +1,103 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/orderbook/internal/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+func TestOBKeeper_SimulateClearance(t *testing.T) {
+	input := NewTestInput(t)
+
+	market, err := input.marketKeeper.Add(input.ctx, "btc", "xfi")
+	require.NoError(t, err)
+	marketID := market.ID
+
+	newOrder := func(direction orders.Direction, orderType orders.OrderType, price, quantity uint64) types.SimulatedOrder {
+		return types.SimulatedOrder{
+			Direction: direction,
+			OrderType: orderType,
+			Price:     sdk.NewUint(price),
+			Quantity:  sdk.NewUint(quantity),
+		}
+	}
+
+	// fail: unknown market
+	{
+		_, err := input.keeper.SimulateClearance(input.ctx, marketID.Incr(), types.SimulatedOrders{
+			newOrder(orders.BidDirection, orders.LimitOrderType, 100, 10),
+			newOrder(orders.AskDirection, orders.LimitOrderType, 90, 10),
+		})
+		require.Error(t, err)
+		require.True(t, types.ErrWrongMarketID.Is(err))
+	}
+
+	// fail: no orders
+	{
+		_, err := input.keeper.SimulateClearance(input.ctx, marketID, types.SimulatedOrders{})
+		require.Error(t, err)
+		require.True(t, types.ErrSimulationFailed.Is(err))
+	}
+
+	// fail: invalid hypothetical order
+	{
+		_, err := input.keeper.SimulateClearance(input.ctx, marketID, types.SimulatedOrders{
+			newOrder(orders.BidDirection, orders.LimitOrderType, 0, 10),
+		})
+		require.Error(t, err)
+		require.True(t, types.ErrSimulationFailed.Is(err))
+	}
+
+	// fail: no crossing
+	{
+		_, err := input.keeper.SimulateClearance(input.ctx, marketID, types.SimulatedOrders{
+			newOrder(orders.BidDirection, orders.LimitOrderType, 50, 10),
+			newOrder(orders.AskDirection, orders.LimitOrderType, 100, 10),
+		})
+		require.Error(t, err)
+		require.True(t, types.ErrSimulationFailed.Is(err))
+	}
+
+	// ok
+	{
+		res, err := input.keeper.SimulateClearance(input.ctx, marketID, types.SimulatedOrders{
+			newOrder(orders.BidDirection, orders.LimitOrderType, 100, 10),
+			newOrder(orders.AskDirection, orders.LimitOrderType, 90, 10),
+			newOrder(orders.AskDirection, orders.FillOrKillOrderType, 95, 100),
+		})
+		require.NoError(t, err)
+		require.True(t, res.MarketID.Equal(marketID))
+		require.Equal(t, 1, res.BidOrdersCount)
+		require.Equal(t, 2, res.AskOrdersCount)
+		require.True(t, res.ClearanceState.Price.Equal(sdk.NewUint(90)))
+		require.True(t, res.MatchedBidVolume.Equal(sdk.NewDec(10)))
+		require.True(t, res.MatchedAskVolume.Equal(sdk.NewDec(10)))
+		require.NotEmpty(t, res.Curves)
+
+		require.Len(t, res.Fills, 2)
+		for _, fill := range res.Fills {
+			require.True(t, fill.Hypothetical)
+			require.True(t, fill.QuantityFilled.Equal(sdk.NewUint(10)))
+			require.True(t, fill.QuantityUnfilled.IsZero())
+		}
+	}
+
+	// ok: empty order type (limit is assumed)
+	{
+		res, err := input.keeper.SimulateClearance(input.ctx, marketID, types.SimulatedOrders{
+			newOrder(orders.BidDirection, "", 100, 10),
+			newOrder(orders.AskDirection, "", 90, 5),
+		})
+		require.NoError(t, err)
+		require.True(t, res.ClearanceState.Price.Equal(sdk.NewUint(90)))
+		require.True(t, res.MatchedBidVolume.Equal(sdk.NewDec(5)))
+		require.True(t, res.MatchedAskVolume.Equal(sdk.NewDec(5)))
+		require.Len(t, res.Fills, 2)
+	}
+}
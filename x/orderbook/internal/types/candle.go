@@ -0,0 +1,175 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	CandleInterval1m CandleInterval = "1m"
+	CandleInterval5m CandleInterval = "5m"
+	CandleInterval1h CandleInterval = "1h"
+	CandleInterval1d CandleInterval = "1d"
+)
+
+// CandleInterval defines candle time frame.
+type CandleInterval string
+
+// IsValid checks if interval is supported.
+func (i CandleInterval) IsValid() bool {
+	return i.Duration() > 0
+}
+
+// Duration returns interval duration (zero for unsupported intervals).
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case CandleInterval1m:
+		return time.Minute
+	case CandleInterval5m:
+		return 5 * time.Minute
+	case CandleInterval1h:
+		return time.Hour
+	case CandleInterval1d:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// String returns string representation.
+func (i CandleInterval) String() string {
+	return string(i)
+}
+
+// Candle is an OHLCV bar built from HistoryItems clearance prices and matched volumes.
+type Candle struct {
+	// MarketID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Candle interval
+	Interval CandleInterval `json:"interval" yaml:"interval" swaggertype:"string" example:"1m"`
+	// Interval start UNIX timestamp [s]
+	StartTime int64 `json:"start_time" yaml:"start_time"`
+	// First clearance price within the interval
+	Open sdk.Uint `json:"open" yaml:"open" swaggertype:"string" example:"100"`
+	// Highest clearance price within the interval
+	High sdk.Uint `json:"high" yaml:"high" swaggertype:"string" example:"150"`
+	// Lowest clearance price within the interval
+	Low sdk.Uint `json:"low" yaml:"low" swaggertype:"string" example:"90"`
+	// Last clearance price within the interval
+	Close sdk.Uint `json:"close" yaml:"close" swaggertype:"string" example:"120"`
+	// Matched bid orders volume (in BaseAsset) within the interval
+	Volume sdk.Uint `json:"volume" yaml:"volume" swaggertype:"string" example:"1000"`
+}
+
+// Strings returns multi-line text object representation.
+func (c Candle) String() string {
+	b := strings.Builder{}
+	b.WriteString("Candle:\n")
+	b.WriteString(fmt.Sprintf("  MarketID:      %s\n", c.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  Interval:      %s\n", c.Interval.String()))
+	b.WriteString(fmt.Sprintf("  StartTime [s]: %d\n", c.StartTime))
+	b.WriteString(fmt.Sprintf("  Open:          %s\n", c.Open.String()))
+	b.WriteString(fmt.Sprintf("  High:          %s\n", c.High.String()))
+	b.WriteString(fmt.Sprintf("  Low:           %s\n", c.Low.String()))
+	b.WriteString(fmt.Sprintf("  Close:         %s\n", c.Close.String()))
+	b.WriteString(fmt.Sprintf("  Volume:        %s\n", c.Volume.String()))
+
+	return b.String()
+}
+
+// TableHeaders returns table headers for multi-line text table object representation.
+func (c Candle) TableHeaders() []string {
+	return []string{
+		"C.MarketID",
+		"C.Interval",
+		"C.StartTime",
+		"C.Open",
+		"C.High",
+		"C.Low",
+		"C.Close",
+		"C.Volume",
+	}
+}
+
+// TableHeaders returns table rows for multi-line text table object representation.
+func (c Candle) TableValues() []string {
+	return []string{
+		c.MarketID.String(),
+		c.Interval.String(),
+		time.Unix(c.StartTime, 0).UTC().String(),
+		c.Open.String(),
+		c.High.String(),
+		c.Low.String(),
+		c.Close.String(),
+		c.Volume.String(),
+	}
+}
+
+// Candle slice type.
+type Candles []Candle
+
+// Strings returns multi-line text object representation.
+func (c Candles) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader(Candle{}.TableHeaders())
+
+	for _, candle := range c {
+		t.Append(candle.TableValues())
+	}
+	t.Render()
+
+	return buf.String()
+}
+
+// NewCandles aggregates HistoryItems into candles.
+// Items are expected to be sorted by block height (timestamp) and to belong to a single market.
+// Intervals without history items are skipped (no empty candles).
+func NewCandles(items HistoryItems, interval CandleInterval) Candles {
+	candles := make(Candles, 0)
+
+	intervalSec := int64(interval.Duration() / time.Second)
+	if intervalSec <= 0 {
+		return candles
+	}
+
+	for _, item := range items {
+		startTime := item.Timestamp - item.Timestamp%intervalSec
+		volume := item.MatchedBidVolume
+
+		lastIdx := len(candles) - 1
+		if lastIdx < 0 || candles[lastIdx].StartTime != startTime {
+			candles = append(candles, Candle{
+				MarketID:  item.MarketID,
+				Interval:  interval,
+				StartTime: startTime,
+				Open:      item.ClearancePrice,
+				High:      item.ClearancePrice,
+				Low:       item.ClearancePrice,
+				Close:     item.ClearancePrice,
+				Volume:    volume,
+			})
+			continue
+		}
+
+		candle := &candles[lastIdx]
+		if item.ClearancePrice.GT(candle.High) {
+			candle.High = item.ClearancePrice
+		}
+		if item.ClearancePrice.LT(candle.Low) {
+			candle.Low = item.ClearancePrice
+		}
+		candle.Close = item.ClearancePrice
+		candle.Volume = candle.Volume.Add(volume)
+	}
+
+	return candles
+}
@@ -0,0 +1,79 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBook_CandleInterval(t *testing.T) {
+	require.True(t, CandleInterval1m.IsValid())
+	require.True(t, CandleInterval5m.IsValid())
+	require.True(t, CandleInterval1h.IsValid())
+	require.True(t, CandleInterval1d.IsValid())
+	require.False(t, CandleInterval("").IsValid())
+	require.False(t, CandleInterval("1w").IsValid())
+}
+
+func TestOrderBook_NewCandles(t *testing.T) {
+	newItem := func(timestamp int64, price, volume uint64) HistoryItem {
+		item := NewMockHistoryItem(0)
+		item.Timestamp = timestamp
+		item.ClearancePrice = sdk.NewUint(price)
+		item.MatchedBidVolume = sdk.NewUint(volume)
+
+		return item
+	}
+
+	items := HistoryItems{
+		newItem(0, 100, 1),
+		newItem(100, 200, 2),
+		newItem(299, 50, 3),
+		newItem(300, 70, 4),
+		newItem(1000, 80, 5),
+	}
+
+	// unsupported interval
+	{
+		require.Len(t, NewCandles(items, CandleInterval("")), 0)
+	}
+
+	// no items
+	{
+		require.Len(t, NewCandles(HistoryItems{}, CandleInterval5m), 0)
+	}
+
+	// 5m candles (empty intervals are skipped)
+	{
+		candles := NewCandles(items, CandleInterval5m)
+		require.Len(t, candles, 3)
+
+		require.Equal(t, int64(0), candles[0].StartTime)
+		require.True(t, candles[0].Open.Equal(sdk.NewUint(100)))
+		require.True(t, candles[0].High.Equal(sdk.NewUint(200)))
+		require.True(t, candles[0].Low.Equal(sdk.NewUint(50)))
+		require.True(t, candles[0].Close.Equal(sdk.NewUint(50)))
+		require.True(t, candles[0].Volume.Equal(sdk.NewUint(6)))
+
+		require.Equal(t, int64(300), candles[1].StartTime)
+		require.True(t, candles[1].Open.Equal(sdk.NewUint(70)))
+		require.True(t, candles[1].Volume.Equal(sdk.NewUint(4)))
+
+		require.Equal(t, int64(900), candles[2].StartTime)
+		require.True(t, candles[2].Close.Equal(sdk.NewUint(80)))
+	}
+
+	// 1d candles
+	{
+		candles := NewCandles(items, CandleInterval1d)
+		require.Len(t, candles, 1)
+		require.True(t, candles[0].Open.Equal(sdk.NewUint(100)))
+		require.True(t, candles[0].High.Equal(sdk.NewUint(200)))
+		require.True(t, candles[0].Low.Equal(sdk.NewUint(50)))
+		require.True(t, candles[0].Close.Equal(sdk.NewUint(80)))
+		require.True(t, candles[0].Volume.Equal(sdk.NewUint(15)))
+	}
+}
@@ -10,15 +10,15 @@ import (
 // ClearanceState object stores the PQCurve crossing point details.
 type ClearanceState struct {
 	// Crossing point price
-	Price sdk.Uint
+	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
 	// Relation coefficient between crossing point supply and demand (supply / demand)
-	ProRata sdk.Dec
+	ProRata sdk.Dec `json:"pro_rata" yaml:"pro_rata" swaggertype:"string" example:"1.0"`
 	// Inverted ProRata coefficient (1 / ProRata)
-	ProRataInvert sdk.Dec
+	ProRataInvert sdk.Dec `json:"pro_rata_invert" yaml:"pro_rata_invert" swaggertype:"string" example:"1.0"`
 	// Crossing point demand volume adjusted by ProRata (demand * ProRata)
-	MaxBidVolume sdk.Dec
+	MaxBidVolume sdk.Dec `json:"max_bid_volume" yaml:"max_bid_volume" swaggertype:"string" example:"50"`
 	// Crossing point supply volume adjusted by ProRata (supply * ProRataInvert)
-	MaxAskVolume sdk.Dec
+	MaxAskVolume sdk.Dec `json:"max_ask_volume" yaml:"max_ask_volume" swaggertype:"string" example:"50"`
 }
 
 // Strings returns multi-line text object representation.
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+// DepthLevel is an order book price level with aggregated orders quantity.
+type DepthLevel struct {
+	// Level price
+	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	// Orders quantity at the level
+	Quantity sdk.Uint `json:"quantity" yaml:"quantity" swaggertype:"string" example:"10"`
+	// Orders quantity from the best price up to the level (inclusive)
+	CumulativeQuantity sdk.Uint `json:"cumulative_quantity" yaml:"cumulative_quantity" swaggertype:"string" example:"50"`
+}
+
+// DepthLevel slice type.
+type DepthLevels []DepthLevel
+
+// Depth is a market order book snapshot built from the active orders.
+type Depth struct {
+	// MarketID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Bid levels (price sorted DESC, best price first)
+	Bids DepthLevels `json:"bids" yaml:"bids"`
+	// Ask levels (price sorted ASC, best price first)
+	Asks DepthLevels `json:"asks" yaml:"asks"`
+}
+
+// Strings returns multi-line text object representation.
+func (d Depth) String() string {
+	b := strings.Builder{}
+	b.WriteString(fmt.Sprintf("Depth for marketID %q:\n", d.MarketID.String()))
+	b.WriteString("Bids:\n")
+	b.WriteString(d.Bids.String())
+	b.WriteString("Asks:\n")
+	b.WriteString(d.Asks.String())
+
+	return b.String()
+}
+
+// Strings returns multi-line text object representation.
+func (l DepthLevels) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"DL.Price",
+		"DL.Quantity",
+		"DL.CumulativeQuantity",
+	})
+
+	for _, level := range l {
+		t.Append([]string{
+			level.Price.String(),
+			level.Quantity.String(),
+			level.CumulativeQuantity.String(),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
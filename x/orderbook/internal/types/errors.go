@@ -6,4 +6,10 @@ var (
 	ErrInternal = sdkErrors.Register(ModuleName, 100, "internal")
 	// HistoryItem not found.
 	ErrWrongHistoryItem = sdkErrors.Register(ModuleName, 101, "wrong marketID / blockHeight")
+	// Candle interval is not supported.
+	ErrWrongCandleInterval = sdkErrors.Register(ModuleName, 102, "wrong candle interval")
+	// Clearance simulation request is invalid or clearance price can't be found.
+	ErrSimulationFailed = sdkErrors.Register(ModuleName, 103, "clearance simulation failed")
+	// Market not found.
+	ErrWrongMarketID = sdkErrors.Register(ModuleName, 104, "wrong marketID")
 )
@@ -11,6 +11,7 @@ import (
 	"github.com/olekukonko/tablewriter"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders"
 )
 
 // HistoryItem used to store clearanceState and other meta per block.
@@ -32,6 +33,10 @@ type HistoryItem struct {
 	MatchedBidVolume sdk.Uint `json:"matched_bid_volume" yaml:"matched_bid_volume" swaggertype:"string" example:"1000"`
 	// Matched ask orders volume
 	MatchedAskVolume sdk.Uint `json:"matched_ask_volume" yaml:"matched_ask_volume" swaggertype:"string" example:"2000"`
+	// Market fees charged from bid order fills (in BaseAsset)
+	BidFees sdk.Uint `json:"bid_fees" yaml:"bid_fees" swaggertype:"string" example:"10"`
+	// Market fees charged from ask order fills (in QuoteAsset)
+	AskFees sdk.Uint `json:"ask_fees" yaml:"ask_fees" swaggertype:"string" example:"20"`
 	// UNIX timestamp [s]
 	Timestamp int64 `json:"timestamp" yaml:"timestamp"`
 	// Block number
@@ -73,6 +78,8 @@ func (h HistoryItem) String() string {
 	b.WriteString(fmt.Sprintf("  AskVolume:        %s\n", h.AskVolume.String()))
 	b.WriteString(fmt.Sprintf("  MatchedBidVolume: %s\n", h.MatchedBidVolume.String()))
 	b.WriteString(fmt.Sprintf("  MatchedAskVolume: %s\n", h.MatchedAskVolume.String()))
+	b.WriteString(fmt.Sprintf("  BidFees:          %s\n", h.BidFees.String()))
+	b.WriteString(fmt.Sprintf("  AskFees:          %s\n", h.AskFees.String()))
 	b.WriteString(fmt.Sprintf("  Timestamp [s]:    %d\n", h.Timestamp))
 	b.WriteString(fmt.Sprintf("  BlockHeight:      %d\n", h.BlockHeight))
 
@@ -90,6 +97,8 @@ func (h HistoryItem) TableHeaders() []string {
 		"H.AskVolume",
 		"H.MatchedBidVolume",
 		"H.MatchedAskVolume",
+		"H.BidFees",
+		"H.AskFees",
 		"H.Timestamp [s]",
 		"H.BlockHeight",
 	}
@@ -108,6 +117,8 @@ func (h HistoryItem) TableValues() []string {
 		h.AskVolume.String(),
 		h.MatchedBidVolume.String(),
 		h.MatchedAskVolume.String(),
+		h.BidFees.String(),
+		h.AskFees.String(),
 		time.Unix(h.Timestamp, 0).String(),
 		strconv.FormatInt(h.BlockHeight, 10),
 	}
@@ -115,7 +126,24 @@ func (h HistoryItem) TableValues() []string {
 	return values
 }
 
-func NewHistoryItem(ctx sdk.Context, result MatcherResult) HistoryItem {
+// NewHistoryItem creates a new HistoryItem object.
+// Market fees are summed up from the executed order fills (returned by the orders module).
+func NewHistoryItem(ctx sdk.Context, result MatcherResult, executedFills orders.OrderFills) HistoryItem {
+	bidFees, askFees := sdk.ZeroUint(), sdk.ZeroUint()
+	for _, fill := range executedFills {
+		if fill.Fee.Amount.IsNil() || !fill.Fee.IsPositive() {
+			continue
+		}
+
+		fee := sdk.NewUintFromBigInt(fill.Fee.Amount.BigInt())
+		switch fill.Order.Direction {
+		case orders.BidDirection:
+			bidFees = bidFees.Add(fee)
+		case orders.AskDirection:
+			askFees = askFees.Add(fee)
+		}
+	}
+
 	return HistoryItem{
 		MarketID:         result.MarketID,
 		ClearancePrice:   result.ClearanceState.Price,
@@ -125,6 +153,8 @@ func NewHistoryItem(ctx sdk.Context, result MatcherResult) HistoryItem {
 		AskVolume:        sdk.Uint(result.ClearanceState.MaxAskVolume.TruncateInt()),
 		MatchedBidVolume: sdk.Uint(result.MatchedBidVolume.TruncateInt()),
 		MatchedAskVolume: sdk.Uint(result.MatchedAskVolume.TruncateInt()),
+		BidFees:          bidFees,
+		AskFees:          askFees,
 		Timestamp:        ctx.BlockTime().Unix(),
 		BlockHeight:      ctx.BlockHeight(),
 	}
@@ -8,8 +8,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders"
 )
 
 func NewMockHistoryItem(id uint64) HistoryItem {
@@ -22,6 +24,8 @@ func NewMockHistoryItem(id uint64) HistoryItem {
 		AskVolume:        sdk.NewUintFromString("200"),
 		MatchedBidVolume: sdk.NewUintFromString("200"),
 		MatchedAskVolume: sdk.NewUintFromString("200"),
+		BidFees:          sdk.NewUintFromString("2"),
+		AskFees:          sdk.NewUintFromString("2"),
 		Timestamp:        time.Now().Unix(),
 		BlockHeight:      1,
 	}
@@ -87,3 +91,30 @@ func TestOrderBook_History_Valid(t *testing.T) {
 		require.Contains(t, item.Valid().Error(), "negative")
 	}
 }
+
+func TestOrderBook_History_Fees(t *testing.T) {
+	newFill := func(direction orders.Direction, fee sdk.Coin) orders.OrderFill {
+		return orders.OrderFill{
+			Order: orders.Order{Direction: direction},
+			Fee:   fee,
+		}
+	}
+
+	result := MatcherResult{
+		MarketID:         dnTypes.NewIDFromUint64(0),
+		ClearanceState:   ClearanceState{Price: sdk.NewUint(100), MaxBidVolume: sdk.ZeroDec(), MaxAskVolume: sdk.ZeroDec()},
+		MatchedBidVolume: sdk.ZeroDec(),
+		MatchedAskVolume: sdk.ZeroDec(),
+	}
+	executedFills := orders.OrderFills{
+		newFill(orders.BidDirection, sdk.NewCoin("btc", sdk.NewInt(1))),
+		newFill(orders.BidDirection, sdk.NewCoin("btc", sdk.NewInt(2))),
+		newFill(orders.AskDirection, sdk.NewCoin("xfi", sdk.NewInt(10))),
+		newFill(orders.AskDirection, sdk.NewCoin("xfi", sdk.ZeroInt())),
+		newFill(orders.AskDirection, sdk.Coin{}),
+	}
+
+	item := NewHistoryItem(sdk.Context{}.WithBlockHeader(abci.Header{Height: 1}), result, executedFills)
+	require.True(t, item.BidFees.Equal(sdk.NewUint(3)))
+	require.True(t, item.AskFees.Equal(sdk.NewUint(10)))
+}
@@ -25,3 +25,17 @@ func GetHistoryItemKey(marketID dnTypes.ID, blockHeight int64) []byte {
 		KeyDelimiter,
 	)
 }
+
+// GetHistoryItemMarketPrefix returns storage key prefix for marketID history items (used for iteration).
+func GetHistoryItemMarketPrefix(marketID dnTypes.ID) []byte {
+	return append(
+		bytes.Join(
+			[][]byte{
+				HistoryItemKeyPrefix,
+				sdk.Uint64ToBigEndian(marketID.UInt64()),
+			},
+			KeyDelimiter,
+		),
+		KeyDelimiter...,
+	)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/dfinance/dnode/helpers/perms"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
 	ordersClient "github.com/dfinance/dnode/x/orders/client"
 )
 
@@ -14,10 +15,14 @@ const (
 	PermHistoryRead perms.Permission = ModuleName + "PermHistoryRead"
 	// Write history item
 	PermHistoryWrite perms.Permission = ModuleName + "PermHistoryWrite"
+	// Read market depth / clearance simulation
+	PermRead perms.Permission = ModuleName + "PermRead"
 	// Read orders
 	PermOrdersRead perms.Permission = ModuleName + "PermOrdersRead"
 	// Execute order fills
 	PermExecFill perms.Permission = ModuleName + "PermExecFill"
+	// Revoke unfilled orders
+	PermOrdersRevoke perms.Permission = ModuleName + "PermOrdersRevoke"
 )
 
 var (
@@ -26,8 +31,10 @@ var (
 		PermInit,
 		PermHistoryRead,
 		PermHistoryWrite,
+		PermRead,
 		PermOrdersRead,
 		PermExecFill,
+		PermOrdersRevoke,
 	}
 )
 
@@ -42,6 +49,18 @@ func RequestOrdersPerms() perms.RequestModulePermissions {
 		modulePerms = perms.Permissions{
 			ordersClient.PermRead,
 			ordersClient.PermExecFill,
+			ordersClient.PermOrderRevoke,
+		}
+		return
+	}
+}
+
+// RequestMarketsPerms returns module perms used by this module.
+func RequestMarketsPerms() perms.RequestModulePermissions {
+	return func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName = ModuleName
+		modulePerms = perms.Permissions{
+			marketsClient.PermRead,
 		}
 		return
 	}
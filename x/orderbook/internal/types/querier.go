@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+)
+
+const (
+	QueryCandles  = "candles"
+	QueryDepth    = "depth"
+	QuerySimulate = "simulate"
+)
+
+// Client request for candles.
+type CandlesReq struct {
+	// Market ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// Candle interval
+	Interval CandleInterval `json:"interval" yaml:"interval"`
+	// Start UNIX timestamp [s] filter (inclusive)
+	StartTime int64 `json:"start_time" yaml:"start_time"`
+	// End UNIX timestamp [s] filter (exclusive, 0 - no filter)
+	EndTime int64 `json:"end_time" yaml:"end_time"`
+	// Page number
+	Page sdk.Uint `json:"page" yaml:"page"`
+	// Items per page
+	Limit sdk.Uint `json:"limit" yaml:"limit"`
+}
+
+// Client request for depth.
+type DepthReq struct {
+	// Market ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// Price level bucket size (0 - no bucketing)
+	PriceStep sdk.Uint `json:"price_step" yaml:"price_step"`
+	// Max number of levels per side (0 - no limit)
+	Limit uint64 `json:"limit" yaml:"limit"`
+}
+
+// Client request for clearance simulation.
+type SimulateReq struct {
+	// Market ID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id"`
+	// Hypothetical orders added to the active ones
+	Orders SimulatedOrders `json:"orders" yaml:"orders"`
+}
@@ -0,0 +1,208 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders"
+)
+
+// SimulatedOrder is a hypothetical order added to the active orders for the clearance simulation.
+type SimulatedOrder struct {
+	// Order direction (bid/ask)
+	Direction orders.Direction `json:"direction" yaml:"direction" swaggertype:"string" example:"bid"`
+	// Order execution type (limit/ioc/fok, limit if empty)
+	OrderType orders.OrderType `json:"order_type" yaml:"order_type" swaggertype:"string" example:"limit"`
+	// Order target price (in quote asset denom)
+	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	// Order target quantity
+	Quantity sdk.Uint `json:"quantity" yaml:"quantity" swaggertype:"string" example:"50"`
+}
+
+// Valid checks that SimulatedOrder is valid.
+// Empty order type is allowed (Limit is assumed).
+func (o SimulatedOrder) Valid() error {
+	if !o.Direction.IsValid() {
+		return fmt.Errorf("direction: invalid")
+	}
+	if o.OrderType != "" && !o.OrderType.IsValid() {
+		return fmt.Errorf("order_type: invalid")
+	}
+	if o.OrderType == orders.PostOnlyOrderType {
+		return fmt.Errorf("order_type: %s is not supported", o.OrderType)
+	}
+	if o.Price.IsZero() {
+		return fmt.Errorf("price: is zero")
+	}
+	if o.Quantity.IsZero() {
+		return fmt.Errorf("quantity: is zero")
+	}
+
+	return nil
+}
+
+// SimulatedOrder slice type.
+type SimulatedOrders []SimulatedOrder
+
+// NewSimulatedOrderFromString parses SimulatedOrder from the "{direction}:{price}:{quantity}[:{order_type}]" string.
+// Order type is optional (limit by default).
+func NewSimulatedOrderFromString(str string) (SimulatedOrder, error) {
+	parts := strings.Split(strings.TrimSpace(str), ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return SimulatedOrder{}, fmt.Errorf("format must be {direction}:{price}:{quantity}[:{order_type}]")
+	}
+
+	price, err := sdk.ParseUint(parts[1])
+	if err != nil {
+		return SimulatedOrder{}, fmt.Errorf("price: %v", err)
+	}
+
+	quantity, err := sdk.ParseUint(parts[2])
+	if err != nil {
+		return SimulatedOrder{}, fmt.Errorf("quantity: %v", err)
+	}
+
+	orderTypeStr := ""
+	if len(parts) == 4 {
+		orderTypeStr = parts[3]
+	}
+
+	order := SimulatedOrder{
+		Direction: orders.Direction(parts[0]),
+		OrderType: orders.NewOrderTypeRaw(orderTypeStr),
+		Price:     price,
+		Quantity:  quantity,
+	}
+	if err := order.Valid(); err != nil {
+		return SimulatedOrder{}, err
+	}
+
+	return order, nil
+}
+
+// SimulatedFill is a projected order fill.
+type SimulatedFill struct {
+	// Order ID (hypothetical orders get IDs following the active orders IDs)
+	OrderID dnTypes.ID `json:"order_id" yaml:"order_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Order is a hypothetical one (passed with the request)
+	Hypothetical bool `json:"hypothetical" yaml:"hypothetical"`
+	// Order direction (bid/ask)
+	Direction orders.Direction `json:"direction" yaml:"direction" swaggertype:"string" example:"bid"`
+	// Order target price
+	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	// Order quantity filled
+	QuantityFilled sdk.Uint `json:"quantity_filled" yaml:"quantity_filled" swaggertype:"string" example:"50"`
+	// Order quantity left unfilled
+	QuantityUnfilled sdk.Uint `json:"quantity_unfilled" yaml:"quantity_unfilled" swaggertype:"string" example:"0"`
+}
+
+// SimulatedFill slice type.
+type SimulatedFills []SimulatedFill
+
+// Strings returns multi-line text object representation.
+func (f SimulatedFills) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"SF.OrderID",
+		"SF.Hypothetical",
+		"SF.Direction",
+		"SF.Price",
+		"SF.QFilled",
+		"SF.QUnfilled",
+	})
+
+	for _, fill := range f {
+		t.Append([]string{
+			fill.OrderID.String(),
+			fmt.Sprintf("%v", fill.Hypothetical),
+			fill.Direction.String(),
+			fill.Price.String(),
+			fill.QuantityFilled.String(),
+			fill.QuantityUnfilled.String(),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
+
+// SDPoint is a supply-demand curves point.
+type SDPoint struct {
+	// Point price
+	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
+	// Ask orders quantity with price lower or equal to the point price
+	Supply sdk.Uint `json:"supply" yaml:"supply" swaggertype:"string" example:"50"`
+	// Bid orders quantity with price higher or equal to the point price
+	Demand sdk.Uint `json:"demand" yaml:"demand" swaggertype:"string" example:"50"`
+}
+
+// SDPoint slice type.
+type SDPoints []SDPoint
+
+// Strings returns multi-line text object representation.
+func (p SDPoints) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"SD.Price",
+		"SD.Supply",
+		"SD.Demand",
+	})
+
+	for _, point := range p {
+		t.Append([]string{
+			point.Price.String(),
+			point.Supply.String(),
+			point.Demand.String(),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
+
+// ClearanceSimulation is a projected matching result for the market.
+type ClearanceSimulation struct {
+	// MarketID
+	MarketID dnTypes.ID `json:"market_id" yaml:"market_id" example:"0" format:"string representation for big.Uint" swaggertype:"string"`
+	// Number of bid orders used by the matcher
+	BidOrdersCount int `json:"bid_orders_count" yaml:"bid_orders_count"`
+	// Number of ask orders used by the matcher
+	AskOrdersCount int `json:"ask_orders_count" yaml:"ask_orders_count"`
+	// PQCurve crossing point data
+	ClearanceState ClearanceState `json:"clearance_state" yaml:"clearance_state"`
+	// Sum of matched bid orders volume
+	MatchedBidVolume sdk.Dec `json:"matched_bid_volume" yaml:"matched_bid_volume" swaggertype:"string" example:"50"`
+	// Sum of matched ask orders volume
+	MatchedAskVolume sdk.Dec `json:"matched_ask_volume" yaml:"matched_ask_volume" swaggertype:"string" example:"50"`
+	// Projected order fills
+	Fills SimulatedFills `json:"fills" yaml:"fills"`
+	// Supply-demand curves points
+	Curves SDPoints `json:"curves" yaml:"curves"`
+}
+
+// Strings returns multi-line text object representation.
+func (s ClearanceSimulation) String() string {
+	b := strings.Builder{}
+	b.WriteString("ClearanceSimulation:\n")
+	b.WriteString(fmt.Sprintf("  MarketID:         %s\n", s.MarketID.String()))
+	b.WriteString(fmt.Sprintf("  BidOrdersCount:   %d\n", s.BidOrdersCount))
+	b.WriteString(fmt.Sprintf("  AskOrdersCount:   %d\n", s.AskOrdersCount))
+	b.WriteString(fmt.Sprintf("  MatchedBidVolume: %s\n", s.MatchedBidVolume.String()))
+	b.WriteString(fmt.Sprintf("  MatchedAskVolume: %s\n", s.MatchedAskVolume.String()))
+	b.WriteString(s.ClearanceState.String() + "\n")
+	b.WriteString("Fills:\n")
+	b.WriteString(s.Fills.String())
+	b.WriteString("Curves:\n")
+	b.WriteString(s.Curves.String())
+
+	return b.String()
+}
@@ -0,0 +1,85 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/orders"
+)
+
+func TestOrderBook_NewSimulatedOrderFromString(t *testing.T) {
+	// ok: default order type
+	{
+		order, err := NewSimulatedOrderFromString("bid:100:10")
+		require.NoError(t, err)
+		require.Equal(t, orders.BidDirection, order.Direction)
+		require.Equal(t, orders.LimitOrderType, order.OrderType)
+		require.True(t, order.Price.Equal(sdk.NewUint(100)))
+		require.True(t, order.Quantity.Equal(sdk.NewUint(10)))
+	}
+
+	// ok: order type
+	{
+		order, err := NewSimulatedOrderFromString(" ask:90:5:fok ")
+		require.NoError(t, err)
+		require.Equal(t, orders.AskDirection, order.Direction)
+		require.Equal(t, orders.FillOrKillOrderType, order.OrderType)
+	}
+
+	// fail: format
+	{
+		_, err := NewSimulatedOrderFromString("bid:100")
+		require.Error(t, err)
+		_, err = NewSimulatedOrderFromString("bid:100:10:limit:1")
+		require.Error(t, err)
+	}
+
+	// fail: direction
+	{
+		_, err := NewSimulatedOrderFromString("buy:100:10")
+		require.Error(t, err)
+	}
+
+	// fail: price / quantity
+	{
+		_, err := NewSimulatedOrderFromString("bid:abc:10")
+		require.Error(t, err)
+		_, err = NewSimulatedOrderFromString("bid:0:10")
+		require.Error(t, err)
+		_, err = NewSimulatedOrderFromString("bid:100:0")
+		require.Error(t, err)
+	}
+
+	// fail: order type
+	{
+		_, err := NewSimulatedOrderFromString("bid:100:10:post_only")
+		require.Error(t, err)
+		_, err = NewSimulatedOrderFromString("bid:100:10:gtc")
+		require.Error(t, err)
+	}
+}
+
+func TestOrderBook_SimulatedOrder_Valid(t *testing.T) {
+	newOrder := func(orderType orders.OrderType) SimulatedOrder {
+		return SimulatedOrder{
+			Direction: orders.BidDirection,
+			OrderType: orderType,
+			Price:     sdk.NewUint(100),
+			Quantity:  sdk.NewUint(10),
+		}
+	}
+
+	// ok: empty order type (limit is assumed)
+	require.NoError(t, newOrder("").Valid())
+
+	// ok
+	require.NoError(t, newOrder(orders.ImmediateOrCancelOrderType).Valid())
+
+	// fail: unknown / unsupported order type
+	require.Error(t, newOrder("gtc").Valid())
+	require.Error(t, newOrder(orders.PostOnlyOrderType).Valid())
+}
@@ -9,6 +9,19 @@ import (
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
+// BeginBlocker processes stop orders: cancels them by TTL timeout condition or triggers them by the oracle price.
+// Stop orders are processed before the orderbook matching of the current block, so the triggered order
+// enters the batch following the one the trigger price was observed in.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	prevEventsCnt := len(ctx.EventManager().Events())
+
+	k.ProcessStopOrders(ctx)
+
+	if curEventsCnt := len(ctx.EventManager().Events()); curEventsCnt != prevEventsCnt {
+		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+	}
+}
+
 // EndBlocker iterates over active orders and cancels them by TTL timeout condition.
 func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
 	now := ctx.BlockTime()
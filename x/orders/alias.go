@@ -13,26 +13,47 @@ type (
 	OrderFill      = types.OrderFill
 	OrderFills     = types.OrderFills
 	Direction      = types.Direction
+	OrderType      = types.OrderType
 	MsgPostOrder   = types.MsgPostOrder
 	MsgRevokeOrder = types.MsgRevokeOrder
+	MsgAmendOrder  = types.MsgAmendOrder
 	OrdersReq      = types.OrdersReq
+	//
+	StopOrder        = types.StopOrder
+	StopOrders       = types.StopOrders
+	MsgPostStopOrder = types.MsgPostStopOrder
 )
 
 const (
-	ModuleName   = types.ModuleName
-	StoreKey     = types.StoreKey
-	BidDirection = types.Bid
-	AskDirection = types.Ask
+	ModuleName       = types.ModuleName
+	StoreKey         = types.StoreKey
+	FeeCollectorName = types.FeeCollectorName
+	BidDirection     = types.Bid
+	AskDirection     = types.Ask
+	//
+	LimitOrderType             = types.Limit
+	ImmediateOrCancelOrderType = types.ImmediateOrCancel
+	FillOrKillOrderType        = types.FillOrKill
+	PostOnlyOrderType          = types.PostOnly
 	// Event types, attribute types
 	EventTypeOrderPost            = types.EventTypeOrderPost
 	EventTypeOrderCancel          = types.EventTypeOrderCancel
+	EventTypeOrderAmend           = types.EventTypeOrderAmend
 	EventTypeFullyFilledOrder     = types.EventTypeFullyFilledOrder
 	EventTypePartiallyFilledOrder = types.EventTypePartiallyFilledOrder
+	EventTypeStopOrderPost        = types.EventTypeStopOrderPost
+	EventTypeStopOrderCancel      = types.EventTypeStopOrderCancel
+	EventTypeStopOrderTrigger     = types.EventTypeStopOrderTrigger
 	//
-	AttributeKeyMarketID = types.AttributeMarketId
-	AttributeKeyOrderID  = types.AttributeOrderId
-	AttributeKeyOwner    = types.AttributeOwner
-	AttributeKeyQuantity = types.AttributeQuantity
+	AttributeKeyMarketID    = types.AttributeMarketId
+	AttributeKeyOrderID     = types.AttributeOrderId
+	AttributeKeyPrevOrderID = types.AttributePrevOrderId
+	AttributeKeyOwner       = types.AttributeOwner
+	AttributeKeyQuantity    = types.AttributeQuantity
+	AttributeKeyReason      = types.AttributeReason
+	//
+	AttributeValueRevoke = types.AttributeValueRevoke
+	AttributeValueTtl    = types.AttributeValueTtl
 )
 
 var (
@@ -44,15 +65,25 @@ var (
 	DefaultGenesisState = types.DefaultGenesisState
 	NewKeeper           = keeper.NewKeeper
 	NewQuerier          = keeper.NewQuerier
+	NewOrderTypeRaw     = types.NewOrderTypeRaw
+	NewMsgPostStop      = types.NewMsgPostStop
+	NewMsgAmendOrder    = types.NewMsgAmendOrder
 	// perms requests
 	RequestMarketsPerms = types.RequestMarketsPerms
+	RequestOraclePerms  = types.RequestOraclePerms
 	// error aliases
-	ErrWrongMarketID  = types.ErrWrongMarketID
-	ErrWrongOwner     = types.ErrWrongOwner
-	ErrWrongPrice     = types.ErrWrongPrice
-	ErrWrongQuantity  = types.ErrWrongQuantity
-	ErrWrongTtl       = types.ErrWrongTtl
-	ErrWrongDirection = types.ErrWrongDirection
-	ErrWrongOrderID   = types.ErrWrongOrderID
-	ErrWrongAssetCode = types.ErrWrongAssetCode
+	ErrWrongMarketID   = types.ErrWrongMarketID
+	ErrWrongOwner      = types.ErrWrongOwner
+	ErrWrongPrice      = types.ErrWrongPrice
+	ErrWrongQuantity   = types.ErrWrongQuantity
+	ErrWrongTtl        = types.ErrWrongTtl
+	ErrWrongDirection  = types.ErrWrongDirection
+	ErrWrongOrderID    = types.ErrWrongOrderID
+	ErrWrongAssetCode  = types.ErrWrongAssetCode
+	ErrWrongOrderType  = types.ErrWrongOrderType
+	ErrPostOnlyCrossed = types.ErrPostOnlyCrossed
+	//
+	ErrWrongTriggerPrice = types.ErrWrongTriggerPrice
+	ErrWrongStopOrderID  = types.ErrWrongStopOrderID
+	ErrMarketPaused      = types.ErrMarketPaused
 )
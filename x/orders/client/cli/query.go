@@ -127,3 +127,111 @@ func GetCmdOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdListStopOrders returns query command that lists all pending stop order objects with filters and pagination.
+func GetCmdListStopOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stop-list",
+		Args:    cobra.ExactArgs(0),
+		Example: "stop-list",
+		Short:   "Lists all pending stop orders",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			ownerFilterStr := viper.GetString(flagOrderOwner)
+			directionFilterStr := viper.GetString(flagOrderDirection)
+			marketIDFilter := viper.GetString(flagOrderMarketID)
+			pageStr, limitStr := viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit)
+			page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			ownerFilter := sdk.AccAddress{}
+			if ownerFilterStr != "" {
+				var err error
+				ownerFilter, err = sdk.AccAddressFromBech32(ownerFilterStr)
+				if err != nil {
+					return fmt.Errorf("%s argument %q parse error: %w", flagOrderOwner, ownerFilterStr, err)
+				}
+			}
+
+			// prepare request
+			req := types.OrdersReq{
+				Page:      page,
+				Limit:     limit,
+				Owner:     ownerFilter,
+				Direction: types.NewDirectionRaw(directionFilterStr),
+				MarketID:  marketIDFilter,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryStopList), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.StopOrders
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagOrderOwner, "", "(optional) filter by owner address")
+	cmd.Flags().String(flagOrderDirection, "", "(optional) filter by direction (bid/ask)")
+	cmd.Flags().String(flagOrderMarketID, "", "(optional) filter by marketID")
+
+	return cmd
+}
+
+// GetCmdStopOrder returns query command that returns pending stop order by id.
+func GetCmdStopOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stop-order [id]",
+		Example: "stop-order 1",
+		Short:   "Get pending stop order by id",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			orderID, err := helpers.ParseDnIDParam("id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.OrderReq{
+				ID: orderID,
+			}
+
+			bz, err := ctx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryStopOrder), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.StopOrder
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return ctx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"stop order ID [uint]",
+	})
+
+	return cmd
+}
@@ -8,16 +8,21 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/dfinance/dnode/helpers"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
+const (
+	flagOrderType = "order-type"
+)
+
 // GetCmdPostOrder returns tx command which post a new order.
 func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "post [asset_code] [direction] [price] [quantity] [TTL_in_sec]",
-		Example: "post btc_xfi bid 100 100000000 --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Example: "post btc_xfi bid 100 100000000 60 --order-type ioc --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
 		Short:   "Post a new order",
 		Args:    cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -54,8 +59,13 @@ func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			orderType := types.NewOrderTypeRaw(strings.ToLower(viper.GetString(flagOrderType)))
+			if !orderType.IsValid() {
+				return helpers.BuildError(flagOrderType, orderType.String(), helpers.ParamTypeCliFlag, "invalid (limit / ioc / fok / post_only)")
+			}
+
 			// prepare and send message
-			msg := types.NewMsgPost(fromAddr, assetCode, direction, price, quantity, ttlInSec)
+			msg := types.NewMsgPost(fromAddr, assetCode, direction, orderType, price, quantity, ttlInSec)
 
 			cliCtx.WithOutput(os.Stdout)
 
@@ -69,6 +79,133 @@ func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 		"baseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)",
 		"order TTL [s]",
 	})
+	cmd.Flags().String(flagOrderType, types.Limit.String(), "(optional) order type (limit / ioc / fok / post_only)")
+
+	return cmd
+}
+
+// GetCmdPostStopOrder returns tx command which post a new stop order.
+func GetCmdPostStopOrder(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "post-stop [asset_code] [direction] [trigger_price] [price] [quantity] [TTL_in_sec]",
+		Example: "post-stop btc_xfi ask 950000000000 100 100000000 3600 --order-type ioc --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Short:   "Post a new stop order triggered by the oracle price",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			assetCode, err := helpers.ParseAssetCodeParam("asset_code", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			direction := types.Direction(strings.ToLower(args[1]))
+			if !direction.IsValid() {
+				return helpers.BuildError("direction", args[1], helpers.ParamTypeCliArg, "invalid (bid / ask)")
+			}
+
+			triggerPrice, err := helpers.ParseSdkUintParam("trigger_price", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			price, err := helpers.ParseSdkUintParam("price", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			quantity, err := helpers.ParseSdkUintParam("quantity", args[4], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			ttlInSec, err := helpers.ParseUint64Param("TTL_in_sec", args[5], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			orderType := types.NewOrderTypeRaw(strings.ToLower(viper.GetString(flagOrderType)))
+			if !orderType.IsValid() || orderType == types.PostOnly {
+				return helpers.BuildError(flagOrderType, orderType.String(), helpers.ParamTypeCliFlag, "invalid (limit / ioc / fok)")
+			}
+
+			// prepare and send message
+			msg := types.NewMsgPostStop(fromAddr, assetCode, direction, orderType, triggerPrice, price, quantity, ttlInSec)
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"asset code in {base denomination_symbol}_{quote_denomination_symbol} format",
+		"order type [bid/ask]",
+		"oracle price that triggers the order (ask order: bid price falls to, bid order: ask price rises to)",
+		"quoteAsset price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)",
+		"baseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)",
+		"order TTL [s] (stop order is canceled if not triggered within TTL)",
+	})
+	cmd.Flags().String(flagOrderType, types.Limit.String(), "(optional) order type after trigger (limit: stop-limit / ioc, fok: stop)")
+
+	return cmd
+}
+
+// GetCmdAmendOrder returns tx command which amends an order.
+func GetCmdAmendOrder(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend [order_id] [price] [quantity] [TTL_in_sec]",
+		Short:   "Amend an order price, quantity and TTL",
+		Example: "amend 0 100 50000000 60 --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := helpers.ParseDnIDParam("order_id", args[0], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			price, err := helpers.ParseSdkUintParam("price", args[1], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			quantity, err := helpers.ParseSdkUintParam("quantity", args[2], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			ttlInSec, err := helpers.ParseUint64Param("TTL_in_sec", args[3], helpers.ParamTypeCliArg)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			msg := types.NewMsgAmendOrder(fromAddr, orderID, price, quantity, ttlInSec)
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"order ID [uint]",
+		"new quoteAsset price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)",
+		"new baseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)",
+		"new order TTL [s] (counted from the order creation)",
+	})
 
 	return cmd
 }
@@ -77,7 +214,7 @@ func GetCmdPostOrder(cdc *codec.Codec) *cobra.Command {
 func GetCmdRevokeOrder(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "revoke [order_id]",
-		Short:   "Revoke an order",
+		Short:   "Revoke an order or a pending stop order",
 		Example: "revoke 0 --from wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m",
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
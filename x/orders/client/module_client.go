@@ -20,6 +20,8 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd.AddCommand(sdkClient.GetCommands(
 		cli.GetCmdListOrders(types.ModuleName, cdc),
 		cli.GetCmdOrder(types.ModuleName, cdc),
+		cli.GetCmdListStopOrders(types.ModuleName, cdc),
+		cli.GetCmdStopOrder(types.ModuleName, cdc),
 	)...)
 
 	return queryCmd
@@ -37,6 +39,8 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(sdkClient.PostCommands(
 		cli.GetCmdPostOrder(cdc),
+		cli.GetCmdPostStopOrder(cdc),
+		cli.GetCmdAmendOrder(cdc),
 		cli.GetCmdRevokeOrder(cdc),
 	)...,
 	)
@@ -28,6 +28,8 @@ type PostOrderReq struct {
 	AssetCode dnTypes.AssetCode `json:"asset_code" example:"btc_xfi"`
 	// Order type (ask/bid)
 	Direction types.Direction `json:"direction" example:"ask"`
+	// Order execution type (limit/ioc/fok/post_only), optional (limit by default)
+	OrderType types.OrderType `json:"order_type" example:"limit"`
 	// QuoteAsset price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)
 	Price string `json:"price" example:"100"`
 	// BaseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)
@@ -36,6 +38,36 @@ type PostOrderReq struct {
 	TtlInSec string `json:"ttl_in_sec" example:"3"`
 }
 
+type PostStopOrderReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Market assetCode in the following format: {base_denomination_symbol}_{quote_denomination_symbol}
+	AssetCode dnTypes.AssetCode `json:"asset_code" example:"btc_xfi"`
+	// Order type (ask/bid)
+	Direction types.Direction `json:"direction" example:"ask"`
+	// Order execution type after trigger (limit: stop-limit, ioc/fok: stop), optional (limit by default)
+	OrderType types.OrderType `json:"order_type" example:"limit"`
+	// Oracle price that triggers the order (ask order: bid price falls to, bid order: ask price rises to)
+	TriggerPrice string `json:"trigger_price" example:"95"`
+	// QuoteAsset price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)
+	Price string `json:"price" example:"100"`
+	// BaseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)
+	Quantity string `json:"quantity" example:"10"`
+	// Stop order TTL [s]
+	TtlInSec string `json:"ttl_in_sec" example:"3600"`
+}
+
+type AmendOrderReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	// Order ID
+	OrderID string `json:"order_id" yaml:"order_id" example:"100"`
+	// New QuoteAsset price with decimals (1.0 XFI with 18 decimals -> 1000000000000000000)
+	Price string `json:"price" example:"100"`
+	// New BaseAsset quantity with decimals (1.0 BTC with 8 decimals -> 100000000)
+	Quantity string `json:"quantity" example:"10"`
+	// New order TTL [s] (counted from the order creation)
+	TtlInSec string `json:"ttl_in_sec" example:"3"`
+}
+
 type RevokeOrderReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	OrderID string       `json:"order_id" yaml:"order_id" example:"100"`
@@ -44,8 +76,12 @@ type RevokeOrderReq struct {
 // RegisterRoutes adds endpoint to REST router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s", types.ModuleName), getOrdersWithParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/stop", types.ModuleName), getStopOrdersWithParams(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/stop/{%s}", types.ModuleName, OrderID), getStopOrder(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/{%s}", types.ModuleName, OrderID), getOrder(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/post", types.ModuleName), postOrder(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/post_stop", types.ModuleName), postStopOrder(cliCtx)).Methods("PUT")
+	r.HandleFunc(fmt.Sprintf("/%s/amend", types.ModuleName), amendOrder(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/revoke", types.ModuleName), revokeOrder(cliCtx)).Methods("PUT")
 }
 
@@ -204,6 +240,277 @@ func postOrder(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		orderType := types.NewOrderTypeRaw(req.OrderType.String())
+		if !orderType.IsValid() {
+			err := helpers.BuildError("order_type", req.OrderType.String(), helpers.ParamTypeRestRequest, types.ErrWrongOrderType.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		price, err := helpers.ParseSdkUintParam("price", req.Price, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		quantity, err := helpers.ParseSdkUintParam("quantity", req.Quantity, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ttl, err := helpers.ParseUint64Param("ttl_in_sec", req.TtlInSec, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare and send msg
+		msg := types.NewMsgPost(fromAddr, req.AssetCode, req.Direction, orderType, price, quantity, ttl)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// GetStopOrdersWithParams godoc
+// @Tags Orders
+// @Summary Get stop orders
+// @Description Get array of pending StopOrder objects with pagination and filters
+// @ID ordersGetStopOrdersWithParams
+// @Accept  json
+// @Produce json
+// @Param page query int false "page number (first page: 1)"
+// @Param limit query int false "items per page (default: 100)"
+// @Param owner query string false "owner filter"
+// @Param direction query string false "direction filter (bid/ask)"
+// @Param marketID query string false "marketID filter"
+// @Success 200 {object} OrdersRespGetStopOrders
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/stop [get]
+func getStopOrdersWithParams(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		pageStr := r.URL.Query().Get("page")
+		limitStr := r.URL.Query().Get("limit")
+		page, limit, err := helpers.ParsePaginationParams(pageStr, limitStr, helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ownerFilterStr := r.URL.Query().Get(OrderOwner)
+		directionFilterStr := r.URL.Query().Get(OrderDirection)
+		marketIDFitler := r.URL.Query().Get(OrderMarketID)
+
+		ownerFilter := sdk.AccAddress{}
+		if ownerFilterStr != "" {
+			var err error
+			ownerFilter, err = sdk.AccAddressFromBech32(ownerFilterStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s param parsing: %v", OrderOwner, err))
+				return
+			}
+		}
+
+		// prepare request
+		req := types.OrdersReq{
+			Page:      page,
+			Limit:     limit,
+			Owner:     ownerFilter,
+			Direction: types.NewDirectionRaw(directionFilterStr),
+			MarketID:  marketIDFitler,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryStopList), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetStopOrder godoc
+// @Tags Orders
+// @Summary Get stop order
+// @Description Get pending StopOrder object by orderID
+// @ID ordersGetStopOrder
+// @Accept  json
+// @Produce json
+// @Param orderID path string true "orderID"
+// @Success 200 {object} OrdersRespGetStopOrder
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query/path params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/stop/{orderID} [get]
+func getStopOrder(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+		id, err := helpers.ParseDnIDParam(OrderID, vars[OrderID], helpers.ParamTypeRestPath)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.OrderReq{
+			ID: id,
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// query and parse the result
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryStopOrder), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// postStopOrder godoc
+// @Tags Orders
+// @Summary Post new stop order
+// @Description Post new stop order triggered by the oracle price
+// @ID ordersPostStopOrder
+// @Accept  json
+// @Produce json
+// @Param postRequest body PostStopOrderReq true "PostStopOrder request with signed transaction"
+// @Success 200 {object} OrdersRespPostStopOrder
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/post_stop [put]
+func postStopOrder(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req PostStopOrderReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if err := req.AssetCode.Validate(); err != nil {
+			err := helpers.BuildError("asset_code", req.AssetCode.String(), helpers.ParamTypeRestRequest, err.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if !req.Direction.IsValid() {
+			err := helpers.BuildError("direction", req.Direction.String(), helpers.ParamTypeRestRequest, types.ErrWrongDirection.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		orderType := types.NewOrderTypeRaw(req.OrderType.String())
+		if !orderType.IsValid() || orderType == types.PostOnly {
+			err := helpers.BuildError("order_type", req.OrderType.String(), helpers.ParamTypeRestRequest, types.ErrWrongOrderType.Error())
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		triggerPrice, err := helpers.ParseSdkUintParam("trigger_price", req.TriggerPrice, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		price, err := helpers.ParseSdkUintParam("price", req.Price, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		quantity, err := helpers.ParseSdkUintParam("quantity", req.Quantity, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		ttl, err := helpers.ParseUint64Param("ttl_in_sec", req.TtlInSec, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare and send msg
+		msg := types.NewMsgPostStop(fromAddr, req.AssetCode, req.Direction, orderType, triggerPrice, price, quantity, ttl)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// amendOrder godoc
+// @Tags Orders
+// @Summary Amend order
+// @Description Amend order price, quantity and TTL
+// @ID ordersAmendOrder
+// @Accept  json
+// @Produce json
+// @Param postRequest body AmendOrderReq true "AmendOrder request with signed transaction"
+// @Success 200 {object} OrdersRespAmendOrder
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /orders/amend [put]
+func amendOrder(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		var req AmendOrderReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := helpers.ParseSdkAddressParam("from", baseReq.From, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		id, err := helpers.ParseDnIDParam("order_id", req.OrderID, helpers.ParamTypeRestRequest)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		price, err := helpers.ParseSdkUintParam("price", req.Price, helpers.ParamTypeRestRequest)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -223,7 +530,7 @@ func postOrder(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// prepare and send msg
-		msg := types.NewMsgPost(fromAddr, req.AssetCode, req.Direction, price, quantity, ttl)
+		msg := types.NewMsgAmendOrder(fromAddr, id, price, quantity, ttl)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -236,7 +543,7 @@ func postOrder(cliCtx context.CLIContext) http.HandlerFunc {
 // revokeOrder godoc
 // @Tags Orders
 // @Summary Revoke order
-// @Description Revoke order
+// @Description Revoke order or pending stop order
 // @ID ordersRevokeOrder
 // @Accept  json
 // @Produce json
@@ -19,6 +19,10 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgPostOrder(ctx, k, msg)
 		case MsgRevokeOrder:
 			return handleMsgCancelOrder(ctx, k, msg)
+		case MsgAmendOrder:
+			return handleMsgAmendOrder(ctx, k, msg)
+		case MsgPostStopOrder:
+			return handleMsgPostStopOrder(ctx, k, msg)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized orders message type: %T", msg)
 		}
@@ -27,7 +31,7 @@ func NewHandler(k Keeper) sdk.Handler {
 
 // handleMsgPostOrder handles MsgPostOrder message which creates a new order.
 func handleMsgPostOrder(ctx sdk.Context, k Keeper, msg MsgPostOrder) (*sdk.Result, error) {
-	order, err := k.PostOrder(ctx, msg.Owner, msg.AssetCode, msg.Direction, msg.Price, msg.Quantity, msg.TtlInSec)
+	order, err := k.PostOrder(ctx, msg.Owner, msg.AssetCode, msg.Direction, msg.OrderType, msg.Price, msg.Quantity, msg.TtlInSec)
 	if err != nil {
 		return nil, err
 	}
@@ -45,8 +49,62 @@ func handleMsgPostOrder(ctx sdk.Context, k Keeper, msg MsgPostOrder) (*sdk.Resul
 	}, nil
 }
 
+// handleMsgAmendOrder handles MsgAmendOrder message which updates an existing order.
+func handleMsgAmendOrder(ctx sdk.Context, k Keeper, msg MsgAmendOrder) (*sdk.Result, error) {
+	order, err := k.Get(ctx, msg.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.Owner.Equals(msg.Owner) {
+		return nil, sdkErrors.Wrap(ErrWrongOwner, "order owner mismatch")
+	}
+
+	order, err = k.AmendOrder(ctx, msg.OrderID, msg.Price, msg.Quantity, msg.TtlInSec)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(order)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// handleMsgPostStopOrder handles MsgPostStopOrder message which creates a new stop order.
+func handleMsgPostStopOrder(ctx sdk.Context, k Keeper, msg MsgPostStopOrder) (*sdk.Result, error) {
+	stopOrder, err := k.PostStopOrder(ctx, msg.Owner, msg.AssetCode, msg.Direction, msg.OrderType, msg.TriggerPrice, msg.Price, msg.Quantity, msg.TtlInSec)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(stopOrder)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
 // handleMsgCancelOrder handles MsgRevokeOrder message which deletes a new order.
+// Pending (not triggered) stop order is revoked if regular order not found.
 func handleMsgCancelOrder(ctx sdk.Context, k Keeper, msg MsgRevokeOrder) (*sdk.Result, error) {
+	if !k.Has(ctx, msg.OrderID) && k.HasStopOrder(ctx, msg.OrderID) {
+		return handleMsgCancelStopOrder(ctx, k, msg)
+	}
+
 	order, err := k.Get(ctx, msg.OrderID)
 	if err != nil {
 		return nil, err
@@ -72,3 +130,31 @@ func handleMsgCancelOrder(ctx sdk.Context, k Keeper, msg MsgRevokeOrder) (*sdk.R
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// handleMsgCancelStopOrder handles MsgRevokeOrder message which deletes a pending stop order.
+func handleMsgCancelStopOrder(ctx sdk.Context, k Keeper, msg MsgRevokeOrder) (*sdk.Result, error) {
+	stopOrder, err := k.GetStopOrder(ctx, msg.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !stopOrder.Order.Owner.Equals(msg.Owner) {
+		return nil, sdkErrors.Wrap(ErrWrongOwner, "stop order owner mismatch")
+	}
+
+	if err := k.RevokeStopOrder(ctx, msg.OrderID, AttributeValueRevoke); err != nil {
+		return nil, err
+	}
+
+	res, err := ModuleCdc.MarshalBinaryLengthPrefixed(stopOrder)
+	if err != nil {
+		return nil, fmt.Errorf("result marshal: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{
+		Data:   res,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/dfinance/dnode/x/ccstorage"
 	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/oracle"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 	"github.com/dfinance/dnode/x/vm"
 )
@@ -39,6 +40,7 @@ type TestInput struct {
 	keyCCS     *sdk.KVStoreKey
 	keyMarkets *sdk.KVStoreKey
 	keyOrders  *sdk.KVStoreKey
+	keyOracle  *sdk.KVStoreKey
 	keyVMS     *sdk.KVStoreKey
 	tKeyParams *sdk.TransientStoreKey
 	//
@@ -54,6 +56,7 @@ type TestInput struct {
 	supplyKeeper  supply.Keeper
 	ccsKeeper     ccstorage.Keeper
 	marketKeeper  markets.Keeper
+	oracleKeeper  oracle.Keeper
 	paramsKeeper  params.Keeper
 	keeper        Keeper
 	//
@@ -69,6 +72,7 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 		keyCCS:     sdk.NewKVStoreKey(ccstorage.StoreKey),
 		keyMarkets: sdk.NewKVStoreKey(markets.StoreKey),
 		keyOrders:  sdk.NewKVStoreKey(types.StoreKey),
+		keyOracle:  sdk.NewKVStoreKey(oracle.StoreKey),
 		keyVMS:     sdk.NewKVStoreKey(vm.StoreKey),
 		tKeyParams: sdk.NewTransientStoreKey(params.TStoreKey),
 		//
@@ -87,6 +91,7 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 	auth.RegisterCodec(input.cdc)
 	bank.RegisterCodec(input.cdc)
 	supply.RegisterCodec(input.cdc)
+	oracle.RegisterCodec(input.cdc)
 	types.RegisterCodec(input.cdc)
 
 	// init in-memory DB
@@ -99,6 +104,7 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 	mstore.MountStoreWithDB(input.keyCCS, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyMarkets, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.keyOrders, sdk.StoreTypeIAVL, db)
+	mstore.MountStoreWithDB(input.keyOracle, sdk.StoreTypeIAVL, db)
 	mstore.MountStoreWithDB(input.tKeyParams, sdk.StoreTypeTransient, db)
 	require.NoError(t, mstore.LoadLatestVersion(), "in-memory DB init")
 
@@ -111,6 +117,18 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 		}
 	}
 
+	// create custom oracle module permission requester as some test do need to set oracle prices
+	oracleRequester := func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName, modulePerms = types.ModuleName, oracle.AvailablePermissions
+		return
+	}
+
+	// create custom ccstorage module permission requester as some test do need to pause currencies
+	ccsRequester := func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName, modulePerms = types.ModuleName, ccstorage.AvailablePermissions
+		return
+	}
+
 	// create target and dependant keepers
 	input.vmStorage = tests.NewVMStorage(input.keyVMS)
 	input.paramsKeeper = params.NewKeeper(input.cdc, input.keyParams, input.tKeyParams)
@@ -122,6 +140,7 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 		input.keyCCS,
 		input.vmStorage,
 		markets.RequestCCStoragePerms(),
+		ccsRequester,
 	)
 	input.marketKeeper = markets.NewKeeper(
 		input.cdc,
@@ -129,7 +148,14 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 		input.ccsKeeper,
 		marketsRequester,
 	)
-	input.keeper = NewKeeper(input.cdc, input.keyOrders, input.bankKeeper, input.supplyKeeper, input.marketKeeper)
+	input.oracleKeeper = oracle.NewKeeper(
+		input.cdc,
+		input.keyOracle,
+		input.paramsKeeper.Subspace(oracle.DefaultParamspace),
+		input.vmStorage,
+		oracleRequester,
+	)
+	input.keeper = NewKeeper(input.cdc, input.keyOrders, input.bankKeeper, input.supplyKeeper, input.marketKeeper, input.oracleKeeper)
 
 	// create context
 	input.ctx = sdk.NewContext(mstore, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
@@ -137,10 +163,30 @@ func NewTestInput(t *testing.T, customMarketsPerms perms.Permissions) TestInput
 	// init genesis / params
 	input.ccsKeeper.InitDefaultGenesis(input.ctx)
 	input.marketKeeper.InitDefaultGenesis(input.ctx)
+	input.oracleKeeper.InitDefaultGenesis(input.ctx)
 
 	return input
 }
 
+// SetOraclePrice sets the current oracle price for the asset (asset is created).
+func (i *TestInput) SetOraclePrice(assetCode dnTypes.AssetCode, askPrice, bidPrice sdk.Int) {
+	params := oracle.DefaultParams()
+	params.Assets = oracle.Assets{oracle.NewAsset(assetCode, oracle.Oracles{}, true)}
+
+	state := oracle.GenesisState{
+		Params: params,
+		CurrentPrices: oracle.CurrentPrices{
+			{
+				AssetCode:  assetCode,
+				AskPrice:   askPrice,
+				BidPrice:   bidPrice,
+				ReceivedAt: i.ctx.BlockTime(),
+			},
+		},
+	}
+	i.oracleKeeper.InitGenesis(i.ctx, i.cdc.MustMarshalJSON(state))
+}
+
 func (i *TestInput) GetAccountBalance(address sdk.AccAddress, baseDenom string) (baseBalance, quoteBalance sdk.Int) {
 	acc := i.accountKeeper.GetAccount(i.ctx, address)
 	for _, coin := range acc.GetCoins() {
@@ -173,6 +219,7 @@ func NewBtcXfiMockOrder(direction types.Direction) types.Order {
 			},
 		},
 		Direction: direction,
+		OrderType: types.Limit,
 		Price:     sdk.NewUintFromString("1000000000000000000"),
 		Quantity:  sdk.NewUintFromString("100000000"),
 		Ttl:       60,
@@ -199,6 +246,7 @@ func NewEthXfiMockOrder(direction types.Direction) types.Order {
 			},
 		},
 		Direction: direction,
+		OrderType: types.Limit,
 		Price:     sdk.NewUintFromString("1000000000000000000"),
 		Quantity:  sdk.NewUintFromString("1000000000000000000"),
 		Ttl:       120,
@@ -217,6 +265,7 @@ func CompareOrders(t *testing.T, order1, order2 types.Order) {
 	require.True(t, order1.Price.Equal(order2.Price), "Price")
 	require.True(t, order1.Quantity.Equal(order2.Quantity), "Quantity")
 	require.True(t, order1.Direction.Equal(order2.Direction), "Direction")
+	require.True(t, order1.OrderType.Equal(order2.OrderType), "OrderType")
 	require.Equal(t, order1.Owner.String(), order2.Owner.String(), "Owner")
 	require.Equal(t, order1.Ttl, order2.Ttl, "Ttl")
 	require.True(t, order1.CreatedAt.Equal(order2.CreatedAt), "CreatedAt")
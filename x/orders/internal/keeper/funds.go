@@ -7,6 +7,7 @@ import (
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/markets"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
@@ -44,13 +45,53 @@ func (k Keeper) UnlockOrderCoins(ctx sdk.Context, order types.Order) error {
 	return nil
 }
 
+// RelockOrderCoins re-balances locked account funds on order amend.
+// Lock coins difference is transferred from Account to Module (lock increased) or from Module to Account (lock decreased).
+func (k Keeper) RelockOrderCoins(ctx sdk.Context, prevOrder, order types.Order) error {
+	k.modulePerms.AutoCheck(types.PermOrderLock)
+
+	prevCoin, err := prevOrder.LockCoin()
+	if err != nil {
+		return sdkErrors.Wrap(err, "creating previous lock coin")
+	}
+
+	coin, err := order.LockCoin()
+	if err != nil {
+		return sdkErrors.Wrap(err, "creating lock coin")
+	}
+
+	if coin.Denom != prevCoin.Denom {
+		return sdkErrors.Wrapf(types.ErrInternal, "lock coin denom mismatch: %s / %s", prevCoin.Denom, coin.Denom)
+	}
+
+	switch {
+	case coin.Amount.GT(prevCoin.Amount):
+		diffCoin := sdk.NewCoin(coin.Denom, coin.Amount.Sub(prevCoin.Amount))
+		if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, order.Owner, types.ModuleName, sdk.NewCoins(diffCoin)); err != nil {
+			return sdkErrors.Wrapf(types.ErrInternal, "locking coins: %v", err)
+		}
+	case coin.Amount.LT(prevCoin.Amount):
+		diffCoin := sdk.NewCoin(coin.Denom, prevCoin.Amount.Sub(coin.Amount))
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, order.Owner, sdk.NewCoins(diffCoin)); err != nil {
+			return sdkErrors.Wrapf(types.ErrInternal, "unlocking coins: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // ExecuteOrderFills processes orderFills transfers fund on full / partial order execution.
+// Market fee is charged from the fill coin (maker fee for orders posted before the current block, taker fee otherwise),
+// transferred to the fee collector module account and added to the market collected fees.
 // Refunding is done for bid order if clearancePrice is less that order target price.
 // Order is removed from the store on full order fill.
 // Order stays active on partial order fill (order quantity is reduced).
-func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills) {
+// Executed orderFills are returned with the charged fee set (input orderFills are not modified).
+func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills) types.OrderFills {
 	k.modulePerms.AutoCheck(types.PermExecFill)
 
+	marketsCache := make(map[string]markets.Market)
+	executedFills := make(types.OrderFills, 0, len(orderFills))
 	for _, orderFill := range orderFills {
 		fillCoin, err := orderFill.FillCoin()
 		if err != nil {
@@ -58,10 +99,27 @@ func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills)
 			k.GetLogger(ctx).Error(fmt.Sprintf("creating fill coin: %v", err))
 			continue
 		}
+
+		feeCoin, err := k.getFillFeeCoin(ctx, marketsCache, orderFill, fillCoin)
+		if err != nil {
+			k.GetLogger(ctx).Debug(orderFill.String())
+			panic(fmt.Sprintf("creating fee coin: %v", err))
+		}
+		orderFill.Fee = feeCoin
+		if feeCoin.IsPositive() {
+			fillCoin = fillCoin.Sub(feeCoin)
+			if _, err = k.bankKeeper.AddCoins(ctx, k.getFeeCollectorAddress(ctx), sdk.NewCoins(feeCoin)); err != nil {
+				k.GetLogger(ctx).Debug(orderFill.String())
+				panic(fmt.Sprintf("transfering fee coins: %v", err))
+			}
+			k.marketKeeper.AddCollectedFees(ctx, orderFill.Order.Market.ID, sdk.NewCoins(feeCoin))
+		}
+
 		if _, err = k.bankKeeper.AddCoins(ctx, orderFill.Order.Owner, sdk.NewCoins(fillCoin)); err != nil {
 			k.GetLogger(ctx).Debug(orderFill.String())
 			panic(fmt.Sprintf("transfering fill coins: %v", err))
 		}
+		executedFills = append(executedFills, orderFill)
 
 		doRefund, refundCoin, err := orderFill.RefundCoin()
 		if err != nil {
@@ -98,4 +156,38 @@ func (k Keeper) ExecuteOrderFills(ctx sdk.Context, orderFills types.OrderFills)
 	if len(orderFills) > 0 {
 		ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(types.ModuleName))
 	}
+
+	return executedFills
+}
+
+// getFillFeeCoin returns market fee coin for orderFill.
+// Market objects are cached as fee rates are read from the current market state (not from the order snapshot).
+func (k Keeper) getFillFeeCoin(ctx sdk.Context, marketsCache map[string]markets.Market, orderFill types.OrderFill, fillCoin sdk.Coin) (sdk.Coin, error) {
+	marketID := orderFill.Order.Market.ID
+	market, ok := marketsCache[marketID.String()]
+	if !ok {
+		var err error
+		market, err = k.marketKeeper.Get(ctx, marketID)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		marketsCache[marketID.String()] = market
+	}
+
+	// Orders are matched in batches using a single clearance price, so there is no intra-batch sequence to decide
+	// which side "took" the liquidity: orders resting in the book from previous blocks are makers,
+	// orders posted within the clearing block (CreatedAt equals the block time) are takers.
+	isMaker := orderFill.Order.CreatedAt.Before(ctx.BlockTime())
+
+	return orderFill.FeeCoin(fillCoin, market.FeeRate(isMaker)), nil
+}
+
+// getFeeCollectorAddress returns fee collector module account address (account is created if not exists).
+func (k Keeper) getFeeCollectorAddress(ctx sdk.Context) sdk.AccAddress {
+	feeAcc := k.supplyKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	if feeAcc == nil {
+		panic(fmt.Sprintf("module account %q is not registered", types.FeeCollectorName))
+	}
+
+	return feeAcc.GetAddress()
 }
@@ -56,12 +56,12 @@ func TestOrdersKeeper_OrderFill(t *testing.T) {
 	// post orders
 	askPrice := sdk.NewUintFromString("10000000000000000000") // 10 xfi
 	askQuantity := sdk.NewUintFromString("5000000000")        // 50 btc
-	askOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Ask, askPrice, askQuantity, 60)
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, askPrice, askQuantity, 60)
 	require.NoError(t, err)
 
 	bidPrice := sdk.NewUintFromString("25000000000000000000") // 25 xfi
 	bidQuantity := sdk.NewUintFromString("2500000000")        // 25 btc
-	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, bidPrice, bidQuantity, 60)
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, types.Limit, bidPrice, bidQuantity, 60)
 	require.NoError(t, err)
 
 	now := time.Now()
@@ -215,3 +215,129 @@ func TestOrdersKeeper_OrderFill(t *testing.T) {
 		}
 	}
 }
+
+func TestOrdersKeeper_OrderFillFees(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+			marketsClient.PermFeesUpdate,
+			marketsClient.PermFeesCollect,
+		},
+	)
+
+	// create market and set fees
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	makerFee, takerFee := sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(2, 3)
+	_, err = input.marketKeeper.SetFees(input.ctx, market.ID, makerFee, takerFee)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	curBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	curQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewCoin(input.baseBtcDenom, curBaseBalance), sdk.NewCoin(input.quoteDenom, curQuoteBalance))))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	assetCode := helperTypes.AssetCode(market.GetAssetCode())
+	feeCollectorAddr := input.supplyKeeper.GetModuleAddress(types.FeeCollectorName)
+
+	// post orders
+	askPrice := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	askQuantity := sdk.NewUintFromString("5000000000")        // 50 btc
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, askPrice, askQuantity, 60)
+	require.NoError(t, err)
+
+	bidPrice := sdk.NewUintFromString("10000000000000000000") // 10 xfi
+	bidQuantity := sdk.NewUintFromString("2500000000")        // 25 btc
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, types.Limit, bidPrice, bidQuantity, 60)
+	require.NoError(t, err)
+
+	curBaseBalance, curQuoteBalance = input.GetAccountBalance(addr, input.baseBtcDenom)
+
+	// ask order posted within the current block: taker fee (in QuoteAsset)
+	{
+		fills := types.OrderFills{
+			types.OrderFill{
+				Order:            askOrder,
+				ClearancePrice:   askPrice,
+				QuantityFilled:   askQuantity.Quo(sdk.NewUint(2)),
+				QuantityUnfilled: askQuantity.Sub(askQuantity.Quo(sdk.NewUint(2))),
+			},
+		}
+		executedFills := input.keeper.ExecuteOrderFills(input.ctx, fills)
+		require.Len(t, executedFills, 1)
+		require.True(t, fills[0].Fee.Amount.IsNil(), "input fills must not be modified")
+
+		fillCoin, err := fills[0].FillCoin()
+		require.NoError(t, err)
+		expectedFee := takerFee.MulInt(fillCoin.Amount).TruncateInt()
+		require.True(t, expectedFee.IsPositive())
+
+		// check fee is recorded
+		require.Equal(t, input.quoteDenom, executedFills[0].Fee.Denom)
+		require.True(t, executedFills[0].Fee.Amount.Equal(expectedFee))
+
+		// check account balance
+		orderBaseBalance, orderQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, orderBaseBalance.Equal(curBaseBalance))
+		require.True(t, orderQuoteBalance.Equal(curQuoteBalance.Add(fillCoin.Amount).Sub(expectedFee)))
+		curBaseBalance, curQuoteBalance = orderBaseBalance, orderQuoteBalance
+
+		// check fee collector balance
+		_, feeQuoteBalance := input.GetAccountBalance(feeCollectorAddr, input.baseBtcDenom)
+		require.True(t, feeQuoteBalance.Equal(expectedFee))
+
+		// check market collected fees
+		marketFees, err := input.marketKeeper.GetFees(input.ctx, market.ID)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(executedFills[0].Fee).String(), marketFees.Collected.String())
+	}
+
+	// bid order posted before the current block: maker fee (in BaseAsset)
+	{
+		ctx := input.ctx.WithBlockTime(input.ctx.BlockTime().Add(5 * time.Second))
+		fills := types.OrderFills{
+			types.OrderFill{
+				Order:            bidOrder,
+				ClearancePrice:   bidPrice,
+				QuantityFilled:   bidQuantity,
+				QuantityUnfilled: sdk.ZeroUint(),
+			},
+		}
+		executedFills := input.keeper.ExecuteOrderFills(ctx, fills)
+		require.Len(t, executedFills, 1)
+
+		fillCoin, err := fills[0].FillCoin()
+		require.NoError(t, err)
+		expectedFee := makerFee.MulInt(fillCoin.Amount).TruncateInt()
+		require.True(t, expectedFee.IsPositive())
+
+		// check fee is recorded
+		require.Equal(t, input.baseBtcDenom, executedFills[0].Fee.Denom)
+		require.True(t, executedFills[0].Fee.Amount.Equal(expectedFee))
+
+		// check account balance
+		orderBaseBalance, orderQuoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, orderBaseBalance.Equal(curBaseBalance.Add(fillCoin.Amount).Sub(expectedFee)))
+		require.True(t, orderQuoteBalance.Equal(curQuoteBalance))
+
+		// check fee collector balance
+		feeBaseBalance, _ := input.GetAccountBalance(feeCollectorAddr, input.baseBtcDenom)
+		require.True(t, feeBaseBalance.Equal(expectedFee))
+
+		// check market collected fees (both assets)
+		_, feeQuoteBalance := input.GetAccountBalance(feeCollectorAddr, input.baseBtcDenom)
+		marketFees, err := input.marketKeeper.GetFees(ctx, market.ID)
+		require.NoError(t, err)
+		require.True(t, marketFees.Collected.AmountOf(input.baseBtcDenom).Equal(expectedFee))
+		require.True(t, marketFees.Collected.AmountOf(input.quoteDenom).Equal(feeQuoteBalance))
+	}
+}
@@ -24,10 +24,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 		if _, err := k.marketKeeper.Get(ctx, order.Market.ID); err != nil {
 			panic(fmt.Errorf("market id: %d not found: %v", order.Market.ID.UInt64(), err))
 		}
+		// backfill order type for orders exported before order types were introduced
+		order.OrderType = types.NewOrderTypeRaw(order.OrderType.String())
 
 		k.set(ctx, order)
 	}
 
+	for _, stopOrder := range state.StopOrders {
+		if _, err := k.marketKeeper.Get(ctx, stopOrder.Order.Market.ID); err != nil {
+			panic(fmt.Errorf("market id: %d not found: %v", stopOrder.Order.Market.ID.UInt64(), err))
+		}
+		stopOrder.Order.OrderType = types.NewOrderTypeRaw(stopOrder.Order.OrderType.String())
+
+		k.setStopOrder(ctx, stopOrder)
+	}
+
 	if state.LastOrderId != nil {
 		k.setID(ctx, *state.LastOrderId)
 	}
@@ -46,6 +57,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 
 	state.Orders = append(state.Orders, orders...)
 
+	stopOrders, err := k.GetStopOrdersList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	state.StopOrders = append(state.StopOrders, stopOrders...)
+
 	if ok := k.hasLastOrderID(ctx); ok {
 		lastID := k.getLastOrderID(ctx)
 		state.LastOrderId = &lastID
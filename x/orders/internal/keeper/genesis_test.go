@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/helpers/perms"
@@ -54,10 +55,16 @@ func TestOrdersKeeper_Genesis_Init(t *testing.T) {
 		order2.Market = exM
 		keeper.setID(ctx, order2.ID)
 
+		stopOrder := types.NewStopOrder(NewBtcXfiMockOrder(types.Ask), sdk.NewUint(100))
+		stopOrder.Order.ID = keeper.nextID(ctx)
+		stopOrder.Order.Market = exM
+		keeper.setID(ctx, stopOrder.Order.ID)
+
 		lastId := keeper.getLastOrderID(ctx)
 
 		state := types.GenesisState{
 			Orders:      types.Orders{order, order2},
+			StopOrders:  types.StopOrders{stopOrder},
 			LastOrderId: &lastId,
 		}
 
@@ -65,6 +72,9 @@ func TestOrdersKeeper_Genesis_Init(t *testing.T) {
 		orders, err := keeper.GetList(ctx)
 		require.Nil(t, err)
 		require.Len(t, orders, len(state.Orders))
+		stopOrders, err := keeper.GetStopOrdersList(ctx)
+		require.Nil(t, err)
+		require.Len(t, stopOrders, len(state.StopOrders))
 
 		var exportedState types.GenesisState
 		cdc.MustUnmarshalJSON(keeper.ExportGenesis(ctx), &exportedState)
@@ -72,4 +82,27 @@ func TestOrdersKeeper_Genesis_Init(t *testing.T) {
 		require.False(t, exportedState.IsEmpty())
 		require.True(t, exportedState.Equal(state))
 	}
+
+	// import order without type (exported before order types were introduced)
+	{
+		orders, err := keeper.GetList(ctx)
+		require.Nil(t, err)
+		require.NotEmpty(t, orders)
+
+		order := NewBtcXfiMockOrder(types.Bid)
+		order.ID = keeper.nextID(ctx)
+		order.Market = orders[0].Market
+		order.OrderType = ""
+		keeper.setID(ctx, order.ID)
+		lastId := keeper.getLastOrderID(ctx)
+
+		keeper.InitGenesis(ctx, cdc.MustMarshalJSON(types.GenesisState{
+			Orders:      types.Orders{order},
+			LastOrderId: &lastId,
+		}))
+
+		importedOrder, err := keeper.Get(ctx, order.ID)
+		require.Nil(t, err)
+		require.Equal(t, types.Limit, importedOrder.OrderType)
+	}
 }
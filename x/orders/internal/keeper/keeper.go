@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/dfinance/dnode/helpers/perms"
 	dnTypes "github.com/dfinance/dnode/helpers/types"
 	"github.com/dfinance/dnode/x/markets"
+	"github.com/dfinance/dnode/x/oracle"
 	"github.com/dfinance/dnode/x/orders/internal/types"
 )
 
@@ -25,6 +27,7 @@ type Keeper struct {
 	bankKeeper   bank.Keeper
 	supplyKeeper supply.Keeper
 	marketKeeper markets.Keeper
+	oracleKeeper oracle.Keeper
 	modulePerms  perms.ModulePermissions
 }
 
@@ -34,32 +37,45 @@ func (k Keeper) PostOrder(
 	owner sdk.AccAddress,
 	assetCode dnTypes.AssetCode,
 	direction types.Direction,
+	orderType types.OrderType,
 	price sdk.Uint,
 	quantity sdk.Uint,
 	ttlInSec uint64) (types.Order, error) {
 
 	k.modulePerms.AutoCheck(types.PermOrderPost)
 
-	filter := markets.NewMarketsFilter(1, 1)
-	filter.AssetCode = assetCode.String()
-
-	marketsList := k.marketKeeper.GetListFiltered(ctx, filter)
-
-	if len(marketsList) == 0 {
-		return types.Order{}, sdkErrors.Wrap(types.ErrWrongAssetCode, "not found")
+	// order type is optional for clients (Limit is used by default)
+	if orderType == "" {
+		orderType = types.Limit
+	}
+	if !orderType.IsValid() {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongOrderType, orderType.String())
 	}
 
-	market, err := k.marketKeeper.GetExtended(ctx, marketsList[0].ID)
+	market, err := k.getMarketByAssetCode(ctx, assetCode)
 	if err != nil {
 		return types.Order{}, err
 	}
+	if market.IsPaused() {
+		return types.Order{}, sdkErrors.Wrapf(types.ErrMarketPaused, "market %s", market.ID)
+	}
 
 	id := k.nextID(ctx)
-	order := types.NewOrder(ctx, id, owner, market, direction, price, quantity, ttlInSec)
+	order := types.NewOrder(ctx, id, owner, market, direction, orderType, price, quantity, ttlInSec)
 	if err := order.ValidatePriceQuantity(); err != nil {
 		return types.Order{}, err
 	}
 
+	if order.OrderType == types.PostOnly {
+		crossed, err := k.isCrossingOrder(ctx, order)
+		if err != nil {
+			return types.Order{}, err
+		}
+		if crossed {
+			return types.Order{}, types.ErrPostOnlyCrossed
+		}
+	}
+
 	if err := k.LockOrderCoins(ctx, order); err != nil {
 		return types.Order{}, err
 	}
@@ -92,11 +108,243 @@ func (k Keeper) RevokeOrder(ctx sdk.Context, id dnTypes.ID) error {
 	return nil
 }
 
+// AmendOrder updates an active order object price, quantity, TTL and re-balances locked account funds (coins).
+// Order keeps its ID (matching priority) if only quantity is reduced and / or TTL is changed.
+// Otherwise, order is re-queued: it gets a new ID and creation timestamp.
+func (k Keeper) AmendOrder(ctx sdk.Context, id dnTypes.ID, price sdk.Uint, quantity sdk.Uint, ttlInSec uint64) (types.Order, error) {
+	k.modulePerms.AutoCheck(types.PermOrderAmend)
+
+	prevOrder, err := k.Get(ctx, id)
+	if err != nil {
+		return types.Order{}, sdkErrors.Wrap(types.ErrWrongOrderID, "not found")
+	}
+
+	now := ctx.BlockTime()
+	order := prevOrder
+	order.Price = price
+	order.Quantity = quantity
+	order.Ttl = time.Duration(ttlInSec) * time.Second
+	order.UpdatedAt = now
+	if err := order.ValidatePriceQuantity(); err != nil {
+		return types.Order{}, err
+	}
+
+	keepPriority := order.Price.Equal(prevOrder.Price) && order.Quantity.LTE(prevOrder.Quantity)
+	if !keepPriority {
+		order.ID = k.nextID(ctx)
+		order.CreatedAt = now
+	}
+
+	if order.OrderType == types.PostOnly && !order.Price.Equal(prevOrder.Price) {
+		crossed, err := k.isCrossingOrder(ctx, order)
+		if err != nil {
+			return types.Order{}, err
+		}
+		if crossed {
+			return types.Order{}, types.ErrPostOnlyCrossed
+		}
+	}
+
+	if err := k.RelockOrderCoins(ctx, prevOrder, order); err != nil {
+		return types.Order{}, err
+	}
+	if !keepPriority {
+		k.del(ctx, prevOrder.ID)
+		k.setID(ctx, order.ID)
+	}
+	k.set(ctx, order)
+
+	ctx.EventManager().EmitEvent(types.NewOrderAmendedEvent(prevOrder.ID, order))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("order %s from %s: amended (ID: %s)", prevOrder.ID, order.Owner, order.ID))
+
+	return order, nil
+}
+
+// RevokeImmediateOrders removes all immediate (IOC / FOK) order objects and unlocks account funds (coins).
+// Method should be called after the batch matching: immediate orders that are still stored weren't (fully) filled.
+func (k Keeper) RevokeImmediateOrders(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+
+	orders, err := k.GetList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, order := range orders {
+		if !order.OrderType.IsImmediate() {
+			continue
+		}
+
+		k.GetLogger(ctx).Info(fmt.Sprintf("%s order canceled as unfilled: %s", order.OrderType, order.ID))
+		if err := k.RevokeOrder(ctx, order.ID); err != nil {
+			k.GetLogger(ctx).Error(fmt.Sprintf("Revoking %s order %q: %v", order.OrderType, order.ID, err))
+		}
+	}
+}
+
+// PostStopOrder creates a new stop order object and locks account funds (coins).
+// Order is posted to the order book once triggered by the oracle price (refer to ProcessStopOrders).
+func (k Keeper) PostStopOrder(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	assetCode dnTypes.AssetCode,
+	direction types.Direction,
+	orderType types.OrderType,
+	triggerPrice sdk.Uint,
+	price sdk.Uint,
+	quantity sdk.Uint,
+	ttlInSec uint64) (types.StopOrder, error) {
+
+	k.modulePerms.AutoCheck(types.PermOrderPost)
+
+	// order type is optional for clients (stop-limit is used by default)
+	if orderType == "" {
+		orderType = types.Limit
+	}
+	if !orderType.IsValid() || orderType == types.PostOnly {
+		return types.StopOrder{}, sdkErrors.Wrap(types.ErrWrongOrderType, orderType.String())
+	}
+	if triggerPrice.IsZero() {
+		return types.StopOrder{}, types.ErrWrongTriggerPrice
+	}
+
+	market, err := k.getMarketByAssetCode(ctx, assetCode)
+	if err != nil {
+		return types.StopOrder{}, err
+	}
+	if market.IsPaused() {
+		return types.StopOrder{}, sdkErrors.Wrapf(types.ErrMarketPaused, "market %s", market.ID)
+	}
+
+	id := k.nextID(ctx)
+	order := types.NewOrder(ctx, id, owner, market, direction, orderType, price, quantity, ttlInSec)
+	if err := order.ValidatePriceQuantity(); err != nil {
+		return types.StopOrder{}, err
+	}
+	stopOrder := types.NewStopOrder(order, triggerPrice)
+
+	if err := k.LockOrderCoins(ctx, order); err != nil {
+		return types.StopOrder{}, err
+	}
+	k.setStopOrder(ctx, stopOrder)
+	k.setID(ctx, id)
+
+	ctx.EventManager().EmitEvent(types.NewStopOrderPostedEvent(stopOrder))
+
+	k.GetLogger(ctx).Debug(fmt.Sprintf("stop order %s from %s: posted", id, owner))
+
+	return stopOrder, nil
+}
+
+// RevokeStopOrder removes a stop order object and unlocks account funds (coins).
+func (k Keeper) RevokeStopOrder(ctx sdk.Context, id dnTypes.ID, reason string) error {
+	k.modulePerms.AutoCheck(types.PermOrderRevoke)
+
+	stopOrder, err := k.GetStopOrder(ctx, id)
+	if err != nil {
+		return sdkErrors.Wrap(types.ErrWrongStopOrderID, "not found")
+	}
+
+	if err := k.UnlockOrderCoins(ctx, stopOrder.Order); err != nil {
+		return err
+	}
+	k.delStopOrder(ctx, id)
+
+	ctx.EventManager().EmitEvent(types.NewStopOrderCanceledEvent(stopOrder, reason))
+
+	return nil
+}
+
+// ProcessStopOrders cancels stop orders by TTL and converts triggered stop orders to regular orders.
+// Stop order is triggered using the current oracle price of the market asset (stop order is skipped if price doesn't exist).
+// Stop order is also skipped while market base or quote currency is paused.
+// Triggered order keeps its ID and locked funds, order TTL is counted from the trigger moment.
+func (k Keeper) ProcessStopOrders(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermOrderPost)
+
+	stopOrders, err := k.GetStopOrdersList(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	now := ctx.BlockTime()
+	for _, stopOrder := range stopOrders {
+		order := stopOrder.Order
+
+		if now.Sub(order.CreatedAt) >= order.Ttl {
+			k.GetLogger(ctx).Info(fmt.Sprintf("stop order canceled by TTL: %s", order.ID))
+			if err := k.RevokeStopOrder(ctx, order.ID, types.AttributeValueTtl); err != nil {
+				k.GetLogger(ctx).Error(fmt.Sprintf("Revoking stop order %q by TTL: %v", order.ID, err))
+			}
+			continue
+		}
+
+		// stale prices are not trusted to trigger orders
+		price := k.oracleKeeper.GetCurrentPrice(ctx, order.Market.GetAssetCode())
+		if price.AssetCode == "" || price.Stale || !stopOrder.IsTriggered(price.AskPrice, price.BidPrice) {
+			continue
+		}
+
+		// triggered stop orders wait until the market currencies are unpaused
+		if market, err := k.marketKeeper.GetExtended(ctx, order.Market.ID); err != nil || market.IsPaused() {
+			continue
+		}
+
+		order.CreatedAt = now
+		order.UpdatedAt = now
+		k.delStopOrder(ctx, order.ID)
+		k.set(ctx, order)
+
+		ctx.EventManager().EmitEvent(types.NewStopOrderTriggeredEvent(stopOrder))
+		ctx.EventManager().EmitEvent(types.NewOrderPostedEvent(order))
+
+		k.GetLogger(ctx).Info(fmt.Sprintf("stop order triggered: %s", order.ID))
+	}
+}
+
 // GetLogger gets logger with keeper context.
 func (k Keeper) GetLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// isCrossingOrder checks if order would be matched with any existing opposite direction order of the same market.
+func (k Keeper) isCrossingOrder(ctx sdk.Context, order types.Order) (bool, error) {
+	orders, err := k.GetList(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, o := range orders {
+		if !o.Market.ID.Equal(order.Market.ID) || o.Direction.Equal(order.Direction) {
+			continue
+		}
+
+		if order.Direction == types.Bid && o.Price.LTE(order.Price) {
+			return true, nil
+		}
+		if order.Direction == types.Ask && o.Price.GTE(order.Price) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getMarketByAssetCode returns an extended market object for the asset code.
+func (k Keeper) getMarketByAssetCode(ctx sdk.Context, assetCode dnTypes.AssetCode) (markets.MarketExtended, error) {
+	filter := markets.NewMarketsFilter(1, 1)
+	filter.AssetCode = assetCode.String()
+
+	marketsList := k.marketKeeper.GetListFiltered(ctx, filter)
+
+	if len(marketsList) == 0 {
+		return markets.MarketExtended{}, sdkErrors.Wrap(types.ErrWrongAssetCode, "not found")
+	}
+
+	return k.marketKeeper.GetExtended(ctx, marketsList[0].ID)
+}
+
 // nextID return next unique order object ID.
 func (k Keeper) nextID(ctx sdk.Context) dnTypes.ID {
 	store := ctx.KVStore(k.storeKey)
@@ -138,6 +386,7 @@ func NewKeeper(
 	bk bank.Keeper,
 	sk supply.Keeper,
 	mk markets.Keeper,
+	ok oracle.Keeper,
 	permsRequesters ...perms.RequestModulePermissions,
 ) Keeper {
 	k := Keeper{
@@ -146,6 +395,7 @@ func NewKeeper(
 		bankKeeper:   bk,
 		supplyKeeper: sk,
 		marketKeeper: mk,
+		oracleKeeper: ok,
 		modulePerms:  types.NewModulePerms(),
 	}
 	for _, requester := range permsRequesters {
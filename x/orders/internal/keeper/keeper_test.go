@@ -4,6 +4,7 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -27,7 +28,7 @@ func TestOrdersKeeper_PostRevokeOrder(t *testing.T) {
 	// non-existing market
 	{
 		owner := sdk.AccAddress("wallet13jyjuz3kkdvqx`")
-		_, err := input.keeper.PostOrder(input.ctx, owner, dnTypes.AssetCode("xfi_usd"), types.Bid, sdk.OneUint(), sdk.OneUint(), 60)
+		_, err := input.keeper.PostOrder(input.ctx, owner, dnTypes.AssetCode("xfi_usd"), types.Bid, types.Limit, sdk.OneUint(), sdk.OneUint(), 60)
 		require.Error(t, err)
 	}
 
@@ -66,7 +67,7 @@ func TestOrdersKeeper_PostRevokeOrder(t *testing.T) {
 			quantity := sdk.NewUintFromString("1000000000") // 10 btc
 
 			// post and check returned order
-			postOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, price, quantity, 60)
+			postOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, types.Limit, price, quantity, 60)
 			require.NoError(t, err)
 			require.Equal(t, postOrder.ID.UInt64(), uint64(0))
 			require.True(t, postOrder.Market.ID.Equal(market.ID))
@@ -99,7 +100,7 @@ func TestOrdersKeeper_PostRevokeOrder(t *testing.T) {
 			quantity := sdk.NewUintFromString("1000000000")        // 10 btc
 
 			// post and check returned order
-			postOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, price, quantity, 60)
+			postOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, types.Limit, price, quantity, 60)
 			require.NoError(t, err)
 			require.Equal(t, postOrder.ID.UInt64(), uint64(1))
 			require.True(t, postOrder.Market.ID.Equal(market.ID))
@@ -196,3 +197,277 @@ func TestOrdersKeeper_PostRevokeOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestOrdersKeeper_OrderTypes(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	initBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	initQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(
+		sdk.NewCoin(input.baseBtcDenom, initBaseBalance),
+		sdk.NewCoin(input.quoteDenom, initQuoteBalance),
+	)))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	quantity := sdk.NewUintFromString("100000000") // 1 btc
+
+	// fail: invalid order type
+	{
+		_, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, types.OrderType("foo"), sdk.OneUint(), quantity, 60)
+		require.Error(t, err)
+	}
+
+	// ok: empty order type is Limit by default
+	askPrice := sdk.NewUintFromString("2000000000000000000") // 2 xfi
+	askOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, "", askPrice, quantity, 60)
+	require.NoError(t, err)
+	require.Equal(t, types.Limit, askOrder.OrderType)
+
+	// post-only order
+	{
+		// fail: bid crosses the ask
+		_, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, types.PostOnly, askPrice, quantity, 60)
+		require.True(t, types.ErrPostOnlyCrossed.Is(err))
+
+		// ok: bid price is lower than the ask one
+		bidPrice := sdk.NewUintFromString("1000000000000000000") // 1 xfi
+		bidOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, types.PostOnly, bidPrice, quantity, 60)
+		require.NoError(t, err)
+		require.Equal(t, types.PostOnly, bidOrder.OrderType)
+	}
+
+	// immediate orders revoke
+	{
+		iocOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, types.ImmediateOrCancel, askPrice, quantity, 60)
+		require.NoError(t, err)
+		fokOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Ask, types.FillOrKill, askPrice, quantity, 60)
+		require.NoError(t, err)
+
+		input.keeper.RevokeImmediateOrders(input.ctx)
+		require.False(t, input.keeper.Has(input.ctx, iocOrder.ID))
+		require.False(t, input.keeper.Has(input.ctx, fokOrder.ID))
+
+		orders, err := input.keeper.GetList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, orders, 2)
+
+		// check immediate orders funds are unlocked (only limit ask order base coins are locked)
+		baseBalance, _ := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, baseBalance.Equal(initBaseBalance.Sub(sdk.NewIntFromBigInt(quantity.BigInt()))))
+	}
+}
+
+func TestOrdersKeeper_AmendOrder(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.ctx = input.ctx.WithBlockTime(time.Now())
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	initBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	initQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(
+		sdk.NewCoin(input.baseBtcDenom, initBaseBalance),
+		sdk.NewCoin(input.quoteDenom, initQuoteBalance),
+	)))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	checkLockedBalance := func(orders ...types.Order) {
+		expBaseBalance, expQuoteBalance := initBaseBalance, initQuoteBalance
+		for _, order := range orders {
+			lockCoin, err := order.LockCoin()
+			require.NoError(t, err)
+			if lockCoin.Denom == input.baseBtcDenom {
+				expBaseBalance = expBaseBalance.Sub(lockCoin.Amount)
+			} else {
+				expQuoteBalance = expQuoteBalance.Sub(lockCoin.Amount)
+			}
+		}
+
+		baseBalance, quoteBalance := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, baseBalance.Equal(expBaseBalance), "base balance: %s / %s", baseBalance, expBaseBalance)
+		require.True(t, quoteBalance.Equal(expQuoteBalance), "quote balance: %s / %s", quoteBalance, expQuoteBalance)
+	}
+
+	price := sdk.NewUintFromString("1000000000000000000") // 1 xfi
+	quantity := sdk.NewUintFromString("1000000000")       // 10 btc
+
+	bidOrder, err := input.keeper.PostOrder(input.ctx, addr, market.GetAssetCode(), types.Bid, types.Limit, price, quantity, 60)
+	require.NoError(t, err)
+	checkLockedBalance(bidOrder)
+
+	// fail: non-existing order
+	{
+		_, err := input.keeper.AmendOrder(input.ctx, dnTypes.NewIDFromUint64(10), price, quantity, 60)
+		require.Error(t, err)
+	}
+
+	// fail: price is too small
+	{
+		_, err := input.keeper.AmendOrder(input.ctx, bidOrder.ID, sdk.ZeroUint(), quantity, 60)
+		require.Error(t, err)
+	}
+
+	input.ctx = input.ctx.WithBlockTime(input.ctx.BlockTime().Add(time.Second))
+
+	// ok: quantity reduced and TTL changed, priority is kept
+	{
+		newQuantity := sdk.NewUintFromString("500000000") // 5 btc
+		amendedOrder, err := input.keeper.AmendOrder(input.ctx, bidOrder.ID, price, newQuantity, 120)
+		require.NoError(t, err)
+		require.True(t, amendedOrder.ID.Equal(bidOrder.ID))
+		require.True(t, amendedOrder.Quantity.Equal(newQuantity))
+		require.Equal(t, 120*time.Second, amendedOrder.Ttl)
+		require.True(t, amendedOrder.CreatedAt.Equal(bidOrder.CreatedAt))
+		require.True(t, amendedOrder.UpdatedAt.Equal(input.ctx.BlockTime()))
+
+		readOrder, err := input.keeper.Get(input.ctx, bidOrder.ID)
+		require.NoError(t, err)
+		CompareOrders(t, amendedOrder, readOrder)
+		checkLockedBalance(amendedOrder)
+
+		bidOrder = amendedOrder
+	}
+
+	// ok: price increased, priority is lost
+	{
+		newPrice := sdk.NewUintFromString("2000000000000000000") // 2 xfi
+		amendedOrder, err := input.keeper.AmendOrder(input.ctx, bidOrder.ID, newPrice, bidOrder.Quantity, 60)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), amendedOrder.ID.UInt64())
+		require.True(t, amendedOrder.Price.Equal(newPrice))
+		require.True(t, amendedOrder.CreatedAt.Equal(input.ctx.BlockTime()))
+
+		require.False(t, input.keeper.Has(input.ctx, bidOrder.ID))
+		readOrder, err := input.keeper.Get(input.ctx, amendedOrder.ID)
+		require.NoError(t, err)
+		CompareOrders(t, amendedOrder, readOrder)
+		checkLockedBalance(amendedOrder)
+
+		bidOrder = amendedOrder
+	}
+
+	// ok: quantity increased, priority is lost
+	{
+		amendedOrder, err := input.keeper.AmendOrder(input.ctx, bidOrder.ID, bidOrder.Price, quantity, 60)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), amendedOrder.ID.UInt64())
+		checkLockedBalance(amendedOrder)
+
+		orders, err := input.keeper.GetList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+	}
+
+	// fail: insufficient funds
+	{
+		bigQuantity := sdk.NewUintFromString("10000000000000") // 100000 btc
+		_, err := input.keeper.AmendOrder(input.ctx, dnTypes.NewIDFromUint64(2), price, bigQuantity, 60)
+		require.Error(t, err)
+	}
+}
+
+func TestOrdersKeeper_PausedMarket(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.ctx = input.ctx.WithBlockTime(time.Now())
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	assetCode := market.GetAssetCode()
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	initBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	initQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(
+		sdk.NewCoin(input.baseBtcDenom, initBaseBalance),
+		sdk.NewCoin(input.quoteDenom, initQuoteBalance),
+	)))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	price := sdk.NewUintFromString("1000000000000000000") // 1 xfi
+	quantity := sdk.NewUintFromString("100000000")        // 1 btc
+
+	// post stop order before the pause
+	stopOrder, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, sdk.NewUint(100), price, quantity, 60)
+	require.NoError(t, err)
+
+	// fail: base currency paused
+	{
+		require.NoError(t, input.ccsKeeper.SetCurrencyPaused(input.ctx, input.baseBtcDenom, true))
+
+		_, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, types.Limit, price, quantity, 60)
+		require.True(t, types.ErrMarketPaused.Is(err))
+
+		_, err = input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, sdk.NewUint(100), price, quantity, 60)
+		require.True(t, types.ErrMarketPaused.Is(err))
+
+		require.NoError(t, input.ccsKeeper.SetCurrencyPaused(input.ctx, input.baseBtcDenom, false))
+	}
+
+	// fail: quote currency paused, triggered stop order is not posted
+	{
+		require.NoError(t, input.ccsKeeper.SetCurrencyPaused(input.ctx, input.quoteDenom, true))
+
+		_, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, types.Limit, price, quantity, 60)
+		require.True(t, types.ErrMarketPaused.Is(err))
+
+		input.SetOraclePrice(assetCode, sdk.NewInt(50), sdk.NewInt(50))
+		input.keeper.ProcessStopOrders(input.ctx)
+
+		_, err = input.keeper.GetStopOrder(input.ctx, stopOrder.Order.ID)
+		require.NoError(t, err)
+		require.False(t, input.keeper.Has(input.ctx, stopOrder.Order.ID))
+	}
+
+	// ok: unpaused
+	{
+		require.NoError(t, input.ccsKeeper.SetCurrencyPaused(input.ctx, input.quoteDenom, false))
+
+		input.keeper.ProcessStopOrders(input.ctx)
+		require.True(t, input.keeper.Has(input.ctx, stopOrder.Order.ID))
+
+		_, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Bid, types.Limit, price, quantity, 60)
+		require.NoError(t, err)
+	}
+}
@@ -19,6 +19,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryList(ctx, k, req)
 		case types.QueryOrder:
 			return queryOrder(ctx, k, req)
+		case types.QueryStopList:
+			return queryStopList(ctx, k, req)
+		case types.QueryStopOrder:
+			return queryStopOrder(ctx, k, req)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -67,3 +71,44 @@ func queryOrder(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error
 
 	return res, nil
 }
+
+// queryStopList handles stop list query which return all pending stop order objects filtered.
+func queryStopList(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.OrdersReq
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	stopOrders, err := k.GetStopOrdersListFiltered(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, stopOrders)
+	if err != nil {
+		return nil, fmt.Errorf("stop orders marshal: %w", err)
+	}
+
+	return res, nil
+}
+
+// queryStopOrder handles stop order query which return stop order by id.
+func queryStopOrder(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.OrderReq
+
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	stopOrder, err := k.GetStopOrder(ctx, params.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, stopOrder)
+	if err != nil {
+		return nil, fmt.Errorf("stop order marshal: %w", err)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/helpers"
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+// HasStopOrder checks if stop order object with ID exists.
+func (k Keeper) HasStopOrder(ctx sdk.Context, id dnTypes.ID) bool {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetStopOrderKey(id))
+}
+
+// GetStopOrder gets stop order object by ID.
+func (k Keeper) GetStopOrder(ctx sdk.Context, id dnTypes.ID) (types.StopOrder, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStopOrderKey(id))
+	if bz == nil {
+		return types.StopOrder{}, types.ErrWrongStopOrderID
+	}
+
+	stopOrder := types.StopOrder{}
+	if err := k.cdc.UnmarshalBinaryLengthPrefixed(bz, &stopOrder); err != nil {
+		panic(fmt.Errorf("stop order unmarshal: %w", err))
+	}
+
+	return stopOrder, nil
+}
+
+// GetStopOrdersList return all pending (not triggered) stop orders.
+func (k Keeper) GetStopOrdersList(ctx sdk.Context) (retOrders types.StopOrders, retErr error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	iterator := k.GetStopOrderIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		stopOrder := types.StopOrder{}
+		if err := k.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &stopOrder); err != nil {
+			retErr = fmt.Errorf("stop order unmarshal: %w", err)
+			return
+		}
+		retOrders = append(retOrders, stopOrder)
+	}
+
+	return
+}
+
+// GetStopOrdersListFiltered returns stop order objects filtered by params.
+func (k Keeper) GetStopOrdersListFiltered(ctx sdk.Context, params types.OrdersReq) (types.StopOrders, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	stopOrders, err := k.GetStopOrdersList(ctx)
+	if err != nil {
+		return types.StopOrders{}, err
+	}
+
+	paramsMarketID, _ := dnTypes.NewIDFromString(params.MarketID)
+	filteredOrders := make(types.StopOrders, 0, len(stopOrders))
+	for _, so := range stopOrders {
+		match := true
+
+		if params.OwnerFilter() && !so.Order.Owner.Equals(params.Owner) {
+			match = false
+		}
+		if params.MarketIDFilter() && !so.Order.Market.ID.Equal(paramsMarketID) {
+			match = false
+		}
+		if params.DirectionFilter() && !so.Order.Direction.Equal(params.Direction) {
+			match = false
+		}
+
+		if match {
+			filteredOrders = append(filteredOrders, so)
+		}
+	}
+
+	start, end, err := helpers.PaginateSlice(len(filteredOrders), params.Page, params.Limit)
+	if err != nil {
+		return types.StopOrders{}, err
+	}
+
+	return filteredOrders[start:end], nil
+}
+
+// GetStopOrderIterator return stop order object iterator (direct sort order).
+func (k Keeper) GetStopOrderIterator(ctx sdk.Context) sdk.Iterator {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	store := ctx.KVStore(k.storeKey)
+
+	return sdk.KVStorePrefixIterator(store, types.StopOrderKeyPrefix)
+}
+
+// setStopOrder creates / overwrites stop order object.
+func (k Keeper) setStopOrder(ctx sdk.Context, stopOrder types.StopOrder) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetStopOrderKey(stopOrder.Order.ID)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(stopOrder)
+	store.Set(key, bz)
+}
+
+// delStopOrder removes stop order object.
+func (k Keeper) delStopOrder(ctx sdk.Context, id dnTypes.ID) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetStopOrderKey(id)
+	store.Delete(key)
+}
@@ -0,0 +1,167 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/perms"
+	dnTypes "github.com/dfinance/dnode/helpers/types"
+	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	"github.com/dfinance/dnode/x/orders/internal/types"
+)
+
+func TestOrdersKeeper_StopOrders(t *testing.T) {
+	input := NewTestInput(
+		t,
+		perms.Permissions{
+			marketsClient.PermCreate,
+			marketsClient.PermRead,
+		},
+	)
+	input.ctx = input.ctx.WithBlockTime(time.Now())
+
+	// create market
+	market, err := input.marketKeeper.Add(input.ctx, input.baseBtcDenom, input.quoteDenom)
+	require.NoError(t, err)
+	assetCode := market.GetAssetCode()
+
+	// create account with supplies
+	_, _, addr := authTypes.KeyTestPubAddr()
+	initBaseBalance, ok := sdk.NewIntFromString("100000000000") // 1000 btc
+	require.True(t, ok)
+	initQuoteBalance, ok := sdk.NewIntFromString("1000000000000000000000") // 1000 xfi
+	require.True(t, ok)
+
+	acc := input.accountKeeper.NewAccountWithAddress(input.ctx, addr)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(
+		sdk.NewCoin(input.baseBtcDenom, initBaseBalance),
+		sdk.NewCoin(input.quoteDenom, initQuoteBalance),
+	)))
+	input.accountKeeper.SetAccount(input.ctx, acc)
+
+	price := sdk.NewUintFromString("1000000000000000000") // 1 xfi
+	quantity := sdk.NewUintFromString("100000000")        // 1 btc
+	quantityInt := sdk.NewIntFromBigInt(quantity.BigInt())
+
+	// fail: non-existing market
+	{
+		_, err := input.keeper.PostStopOrder(input.ctx, addr, dnTypes.AssetCode("xfi_usd"), types.Ask, types.Limit, sdk.NewUint(100), price, quantity, 60)
+		require.Error(t, err)
+	}
+
+	// fail: post-only order type
+	{
+		_, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, types.PostOnly, sdk.NewUint(100), price, quantity, 60)
+		require.True(t, types.ErrWrongOrderType.Is(err))
+	}
+
+	// fail: zero trigger price
+	{
+		_, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, sdk.ZeroUint(), price, quantity, 60)
+		require.True(t, types.ErrWrongTriggerPrice.Is(err))
+	}
+
+	// post stop orders
+	stopLossOrder, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, "", sdk.NewUint(100), price, quantity, 60)
+	require.NoError(t, err)
+	require.Equal(t, types.Limit, stopLossOrder.Order.OrderType)
+	require.Equal(t, uint64(0), stopLossOrder.Order.ID.UInt64())
+
+	expiringOrder, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, types.ImmediateOrCancel, sdk.NewUint(50), price, quantity, 30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), expiringOrder.Order.ID.UInt64())
+
+	revokedOrder, err := input.keeper.PostStopOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, sdk.NewUint(10), price, quantity, 60)
+	require.NoError(t, err)
+
+	// check stop orders are stored apart from active orders and funds are locked
+	{
+		orders, err := input.keeper.GetList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, orders, 0)
+
+		stopOrders, err := input.keeper.GetStopOrdersList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, stopOrders, 3)
+
+		readOrder, err := input.keeper.GetStopOrder(input.ctx, stopLossOrder.Order.ID)
+		require.NoError(t, err)
+		require.True(t, readOrder.TriggerPrice.Equal(stopLossOrder.TriggerPrice))
+		CompareOrders(t, stopLossOrder.Order, readOrder.Order)
+
+		baseBalance, _ := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, baseBalance.Equal(initBaseBalance.Sub(quantityInt.MulRaw(3))))
+	}
+
+	// revoke stop order
+	{
+		require.NoError(t, input.keeper.RevokeStopOrder(input.ctx, revokedOrder.Order.ID, types.AttributeValueRevoke))
+		require.False(t, input.keeper.HasStopOrder(input.ctx, revokedOrder.Order.ID))
+		require.Error(t, input.keeper.RevokeStopOrder(input.ctx, revokedOrder.Order.ID, types.AttributeValueRevoke))
+
+		baseBalance, _ := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, baseBalance.Equal(initBaseBalance.Sub(quantityInt.MulRaw(2))))
+	}
+
+	// no oracle price: nothing is triggered
+	{
+		input.keeper.ProcessStopOrders(input.ctx)
+
+		stopOrders, err := input.keeper.GetStopOrdersList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, stopOrders, 2)
+	}
+
+	// oracle price is above trigger prices: nothing is triggered
+	{
+		input.SetOraclePrice(assetCode, sdk.NewInt(200), sdk.NewInt(150))
+		input.keeper.ProcessStopOrders(input.ctx)
+
+		stopOrders, err := input.keeper.GetStopOrdersList(input.ctx)
+		require.NoError(t, err)
+		require.Len(t, stopOrders, 2)
+	}
+
+	// expired stop order is canceled
+	{
+		input.ctx = input.ctx.WithBlockTime(input.ctx.BlockTime().Add(30 * time.Second))
+		input.keeper.ProcessStopOrders(input.ctx)
+
+		require.False(t, input.keeper.HasStopOrder(input.ctx, expiringOrder.Order.ID))
+		require.False(t, input.keeper.Has(input.ctx, expiringOrder.Order.ID))
+
+		baseBalance, _ := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, baseBalance.Equal(initBaseBalance.Sub(quantityInt)))
+	}
+
+	// bid price fell to the trigger price: stop order is converted to the active one
+	{
+		input.SetOraclePrice(assetCode, sdk.NewInt(110), sdk.NewInt(100))
+		input.keeper.ProcessStopOrders(input.ctx)
+
+		require.False(t, input.keeper.HasStopOrder(input.ctx, stopLossOrder.Order.ID))
+
+		order, err := input.keeper.Get(input.ctx, stopLossOrder.Order.ID)
+		require.NoError(t, err)
+		require.True(t, order.CreatedAt.Equal(input.ctx.BlockTime()))
+		require.True(t, order.Price.Equal(stopLossOrder.Order.Price))
+		require.True(t, order.Quantity.Equal(stopLossOrder.Order.Quantity))
+
+		// funds stay locked
+		baseBalance, _ := input.GetAccountBalance(addr, input.baseBtcDenom)
+		require.True(t, baseBalance.Equal(initBaseBalance.Sub(quantityInt)))
+	}
+
+	// stop orders share the orders ID sequence
+	{
+		order, err := input.keeper.PostOrder(input.ctx, addr, assetCode, types.Ask, types.Limit, price, quantity, 60)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), order.ID.UInt64())
+	}
+}
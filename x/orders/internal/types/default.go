@@ -3,4 +3,6 @@ package types
 const (
 	ModuleName = "orders"
 	StoreKey   = ModuleName
+	// Module account collecting market fees charged on order fills
+	FeeCollectorName = ModuleName + "_fees"
 )
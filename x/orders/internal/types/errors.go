@@ -20,4 +20,14 @@ var (
 	ErrWrongOrderID = sdkErrors.Register(ModuleName, 107, "wrong orderID")
 	// Asset code not exists.
 	ErrWrongAssetCode = sdkErrors.Register(ModuleName, 108, "wrong asset code")
+	// Order type enum is invalid.
+	ErrWrongOrderType = sdkErrors.Register(ModuleName, 109, "wrong order type")
+	// PostOnly order crosses an opposite direction order.
+	ErrPostOnlyCrossed = sdkErrors.Register(ModuleName, 110, "post-only order would be matched immediately")
+	// Stop order trigger price is empty.
+	ErrWrongTriggerPrice = sdkErrors.Register(ModuleName, 111, "wrong trigger price, should be greater that 0")
+	// Stop order not exists.
+	ErrWrongStopOrderID = sdkErrors.Register(ModuleName, 112, "wrong stop orderID")
+	// Market base or quote currency is paused.
+	ErrMarketPaused = sdkErrors.Register(ModuleName, 113, "market currency is paused")
 )
@@ -2,20 +2,33 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 )
 
 const (
 	EventTypeOrderPost            = ModuleName + ".post"
 	EventTypeOrderCancel          = ModuleName + ".cancel"
+	EventTypeOrderAmend           = ModuleName + ".amend"
 	EventTypeFullyFilledOrder     = ModuleName + ".full_fill"
 	EventTypePartiallyFilledOrder = ModuleName + ".partial_fill"
+	EventTypeStopOrderPost        = ModuleName + ".stop_post"
+	EventTypeStopOrderCancel      = ModuleName + ".stop_cancel"
+	EventTypeStopOrderTrigger     = ModuleName + ".stop_trigger"
+	//
+	AttributeMarketId    = "market_id"
+	AttributeOrderId     = "order_id"
+	AttributePrevOrderId = "prev_order_id"
+	AttributeOwner       = "owner"
+	AttributeDirection   = "direction"
+	AttributeOrderType   = "order_type"
+	AttributePrice       = "price"
+	AttributeQuantity    = "quantity"
 	//
-	AttributeMarketId  = "market_id"
-	AttributeOrderId   = "order_id"
-	AttributeOwner     = "owner"
-	AttributeDirection = "direction"
-	AttributePrice     = "price"
-	AttributeQuantity  = "quantity"
+	AttributeTriggerPrice = "trigger_price"
+	AttributeReason       = "reason"
+	AttributeValueRevoke  = "revoke"
+	AttributeValueTtl     = "ttl"
 )
 
 // NewOrderPostedEvent creates an Event on order post (creation).
@@ -26,6 +39,7 @@ func NewOrderPostedEvent(order Order) sdk.Event {
 		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
 		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
 		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
+		sdk.NewAttribute(AttributeOrderType, order.OrderType.String()),
 		sdk.NewAttribute(AttributePrice, order.Price.String()),
 		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
 	)
@@ -44,6 +58,20 @@ func NewOrderCanceledEvent(order Order) sdk.Event {
 	)
 }
 
+// NewOrderAmendedEvent creates an Event on order amend (prevID differs from the current one if order priority is lost).
+func NewOrderAmendedEvent(prevID dnTypes.ID, order Order) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeOrderAmend,
+		sdk.NewAttribute(AttributeOwner, order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, order.ID.String()),
+		sdk.NewAttribute(AttributePrevOrderId, prevID.String()),
+		sdk.NewAttribute(AttributeDirection, order.Direction.String()),
+		sdk.NewAttribute(AttributePrice, order.Price.String()),
+		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
+	)
+}
+
 // NewFullyFilledOrderEvent creates an Event on order fully filled (triggered by Matcher).
 func NewFullyFilledOrderEvent(order Order) sdk.Event {
 	return sdk.NewEvent(
@@ -69,3 +97,36 @@ func NewPartiallyFilledOrderEvent(order Order) sdk.Event {
 		sdk.NewAttribute(AttributeQuantity, order.Quantity.String()),
 	)
 }
+
+// NewStopOrderPostedEvent creates an Event on stop order post (creation).
+func NewStopOrderPostedEvent(stopOrder StopOrder) sdk.Event {
+	return newStopOrderEvent(EventTypeStopOrderPost, stopOrder)
+}
+
+// NewStopOrderCanceledEvent creates an Event on stop order cancel (reason: revoke / TTL).
+func NewStopOrderCanceledEvent(stopOrder StopOrder, reason string) sdk.Event {
+	event := newStopOrderEvent(EventTypeStopOrderCancel, stopOrder)
+	event = event.AppendAttributes(sdk.NewAttribute(AttributeReason, reason))
+
+	return event
+}
+
+// NewStopOrderTriggeredEvent creates an Event on stop order trigger (conversion to a regular order).
+func NewStopOrderTriggeredEvent(stopOrder StopOrder) sdk.Event {
+	return newStopOrderEvent(EventTypeStopOrderTrigger, stopOrder)
+}
+
+// newStopOrderEvent creates a stop order Event with common attributes.
+func newStopOrderEvent(eventType string, stopOrder StopOrder) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeOwner, stopOrder.Order.Owner.String()),
+		sdk.NewAttribute(AttributeMarketId, stopOrder.Order.Market.ID.String()),
+		sdk.NewAttribute(AttributeOrderId, stopOrder.Order.ID.String()),
+		sdk.NewAttribute(AttributeDirection, stopOrder.Order.Direction.String()),
+		sdk.NewAttribute(AttributeOrderType, stopOrder.Order.OrderType.String()),
+		sdk.NewAttribute(AttributeTriggerPrice, stopOrder.TriggerPrice.String()),
+		sdk.NewAttribute(AttributePrice, stopOrder.Order.Price.String()),
+		sdk.NewAttribute(AttributeQuantity, stopOrder.Order.Quantity.String()),
+	)
+}
@@ -11,13 +11,14 @@ import (
 // GenesisState orders state that must be provided at genesis.
 type GenesisState struct {
 	Orders      Orders      `json:"orders" yaml:"orders"`
+	StopOrders  StopOrders  `json:"stop_orders" yaml:"stop_orders"`
 	LastOrderId *dnTypes.ID `json:"last_order_id" yaml:"last_order_id"`
 }
 
 // Validate checks that genesis state is valid.
 func (gs GenesisState) Validate(blockTime time.Time) error {
 	maxOrderID := dnTypes.NewZeroID()
-	ordersIdsSet := make(map[string]bool, len(gs.Orders))
+	ordersIdsSet := make(map[string]bool, len(gs.Orders)+len(gs.StopOrders))
 
 	for i, order := range gs.Orders {
 		if err := order.Valid(); err != nil {
@@ -43,10 +44,36 @@ func (gs GenesisState) Validate(blockTime time.Time) error {
 		}
 	}
 
-	if gs.LastOrderId == nil && len(gs.Orders) != 0 {
+	// stop orders share the orders ID sequence
+	for i, stopOrder := range gs.StopOrders {
+		if err := stopOrder.Valid(); err != nil {
+			return fmt.Errorf("stop_order[%d]: %w", i, err)
+		}
+
+		order := stopOrder.Order
+		if !blockTime.IsZero() && order.CreatedAt.After(blockTime) {
+			return fmt.Errorf("stop_order[%d]: create_at after block time", i)
+		}
+
+		if !blockTime.IsZero() && order.UpdatedAt.After(blockTime) {
+			return fmt.Errorf("stop_order[%d]: updated_at after block time", i)
+		}
+
+		if ordersIdsSet[order.ID.String()] {
+			return fmt.Errorf("stop_order[%d]: duplicated ID %q", i, order.ID.String())
+		}
+
+		ordersIdsSet[order.ID.String()] = true
+
+		if order.ID.GT(maxOrderID) {
+			maxOrderID = order.ID
+		}
+	}
+
+	if gs.LastOrderId == nil && len(ordersIdsSet) != 0 {
 		return fmt.Errorf("last_order_id: nil with existing orders")
 	}
-	if gs.LastOrderId != nil && len(gs.Orders) == 0 {
+	if gs.LastOrderId != nil && len(ordersIdsSet) == 0 {
 		return fmt.Errorf("last_order_id: not nil without existing orders")
 	}
 	if gs.LastOrderId != nil {
@@ -77,6 +104,7 @@ func (gs GenesisState) IsEmpty() bool {
 // DefaultGenesisState defines default GenesisState for orders.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Orders: Orders{},
+		Orders:     Orders{},
+		StopOrders: StopOrders{},
 	}
 }
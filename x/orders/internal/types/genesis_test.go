@@ -46,6 +46,23 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 		require.True(t, GenesisState{Orders: Orders{order3}}.Equal(GenesisState{Orders: Orders{order4}}))
 	}
 
+	// ok: empty order type (order stored before order types were introduced)
+	{
+		state := getTestGenesisState()
+		state.Orders[0].OrderType = ""
+		require.NoError(t, state.Validate(time.Now()))
+	}
+
+	// wrong order type
+	{
+		state := getTestGenesisState()
+		state.Orders[0].OrderType = "unknown"
+		err := state.Validate(time.Now())
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "type")
+	}
+
 	// wrong id
 	{
 		state := getTestGenesisState()
@@ -272,6 +289,48 @@ func TestOrders_Genesis_Valid(t *testing.T) {
 		require.Contains(t, err.Error(), "not equal to max order ID")
 	}
 
+	// with stop orders
+	{
+		order := NewMockOrder()
+		order.ID = types.NewIDFromUint64(0)
+		stopOrder := NewStopOrder(NewMockOrder(), sdk.NewUint(100))
+		stopOrder.Order.ID = types.NewIDFromUint64(1)
+
+		// ok
+		require.NoError(t, GenesisState{
+			Orders:      Orders{order},
+			StopOrders:  StopOrders{stopOrder},
+			LastOrderId: &stopOrder.Order.ID,
+		}.Validate(time.Now()))
+
+		// stop orders only, without lastId
+		err := GenesisState{StopOrders: StopOrders{stopOrder}}.Validate(time.Now())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "last_order_id")
+
+		// ID shared with an order
+		stopOrder.Order.ID = order.ID
+		err = GenesisState{
+			Orders:      Orders{order},
+			StopOrders:  StopOrders{stopOrder},
+			LastOrderId: &order.ID,
+		}.Validate(time.Now())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "stop_order[0]")
+		require.Contains(t, err.Error(), "duplicated ID")
+
+		// invalid trigger price
+		stopOrder.Order.ID = types.NewIDFromUint64(1)
+		stopOrder.TriggerPrice = sdk.ZeroUint()
+		err = GenesisState{
+			Orders:      Orders{order},
+			StopOrders:  StopOrders{stopOrder},
+			LastOrderId: &stopOrder.Order.ID,
+		}.Validate(time.Now())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "trigger_price")
+	}
+
 	// updatedAt later than block time
 	{
 		state := getTestGenesisState()
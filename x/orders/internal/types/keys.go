@@ -13,6 +13,8 @@ var (
 	KeyDelimiter = []byte(":")
 	OrderKeyPrefix = []byte("order")
 	LastOrderIDKey = []byte("last_order_id")
+	// Prefix must not start with OrderKeyPrefix: stop orders must not be iterated over as active orders
+	StopOrderKeyPrefix = []byte("stop_order")
 )
 
 // GetOrderKey returns storage key for order ID.
@@ -25,3 +27,14 @@ func GetOrderKey(id dnTypes.ID) []byte {
 		KeyDelimiter,
 	)
 }
+
+// GetStopOrderKey returns storage key for stop order ID.
+func GetStopOrderKey(id dnTypes.ID) []byte {
+	return bytes.Join(
+		[][]byte{
+			StopOrderKeyPrefix,
+			sdk.Uint64ToBigEndian(id.UInt64()),
+		},
+		KeyDelimiter,
+	)
+}
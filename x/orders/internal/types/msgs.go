@@ -10,6 +10,8 @@ import (
 var (
 	_ sdk.Msg = MsgPostOrder{}
 	_ sdk.Msg = MsgRevokeOrder{}
+	_ sdk.Msg = MsgPostStopOrder{}
+	_ sdk.Msg = MsgAmendOrder{}
 )
 
 // Client message to post an order object.
@@ -17,6 +19,7 @@ type MsgPostOrder struct {
 	Owner     sdk.AccAddress    `json:"owner" yaml:"owner"`
 	AssetCode dnTypes.AssetCode `json:"asset_code" yaml:"asset_code"`
 	Direction Direction         `json:"direction" yaml:"direction"`
+	OrderType OrderType         `json:"order_type,omitempty" yaml:"order_type,omitempty"`
 	Price     sdk.Uint          `json:"price" yaml:"price"`
 	Quantity  sdk.Uint          `json:"quantity" yaml:"quantity"`
 	TtlInSec  uint64            `json:"ttl_in_sec" yaml:"ttl_in_sec"`
@@ -43,6 +46,9 @@ func (msg MsgPostOrder) ValidateBasic() error {
 	if !msg.Direction.IsValid() {
 		return ErrWrongDirection
 	}
+	if msg.OrderType != "" && !msg.OrderType.IsValid() {
+		return ErrWrongOrderType
+	}
 	if msg.Price.IsZero() {
 		return ErrWrongPrice
 	}
@@ -67,11 +73,12 @@ func (msg MsgPostOrder) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgPost creates MsgPostOrder message object.
-func NewMsgPost(owner sdk.AccAddress, assetCode dnTypes.AssetCode, direction Direction, price sdk.Uint, quantity sdk.Uint, ttlInSec uint64) MsgPostOrder {
+func NewMsgPost(owner sdk.AccAddress, assetCode dnTypes.AssetCode, direction Direction, orderType OrderType, price sdk.Uint, quantity sdk.Uint, ttlInSec uint64) MsgPostOrder {
 	return MsgPostOrder{
 		Owner:     owner,
 		AssetCode: assetCode,
 		Direction: direction,
+		OrderType: orderType,
 		Price:     price,
 		Quantity:  quantity,
 		TtlInSec:  ttlInSec,
@@ -123,3 +130,140 @@ func NewMsgRevokeOrder(owner sdk.AccAddress, id dnTypes.ID) MsgRevokeOrder {
 		OrderID: id,
 	}
 }
+
+// Client message to post a stop order object.
+type MsgPostStopOrder struct {
+	Owner        sdk.AccAddress    `json:"owner" yaml:"owner"`
+	AssetCode    dnTypes.AssetCode `json:"asset_code" yaml:"asset_code"`
+	Direction    Direction         `json:"direction" yaml:"direction"`
+	OrderType    OrderType         `json:"order_type,omitempty" yaml:"order_type,omitempty"`
+	TriggerPrice sdk.Uint          `json:"trigger_price" yaml:"trigger_price"`
+	Price        sdk.Uint          `json:"price" yaml:"price"`
+	Quantity     sdk.Uint          `json:"quantity" yaml:"quantity"`
+	TtlInSec     uint64            `json:"ttl_in_sec" yaml:"ttl_in_sec"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) Route() string {
+	return ModuleName
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) Type() string {
+	return "post_stop"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) ValidateBasic() error {
+	if err := msg.AssetCode.Validate(); err != nil {
+		return sdkErrors.Wrap(ErrWrongAssetCode, err.Error())
+	}
+	if msg.Owner.Empty() {
+		return ErrWrongOwner
+	}
+	if !msg.Direction.IsValid() {
+		return ErrWrongDirection
+	}
+	if msg.OrderType != "" && (!msg.OrderType.IsValid() || msg.OrderType == PostOnly) {
+		return ErrWrongOrderType
+	}
+	if msg.TriggerPrice.IsZero() {
+		return ErrWrongTriggerPrice
+	}
+	if msg.Price.IsZero() {
+		return ErrWrongPrice
+	}
+	if msg.Quantity.IsZero() {
+		return ErrWrongQuantity
+	}
+	if msg.TtlInSec == 0 {
+		return ErrWrongTtl
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgPostStopOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgPostStop creates MsgPostStopOrder message object.
+func NewMsgPostStop(owner sdk.AccAddress, assetCode dnTypes.AssetCode, direction Direction, orderType OrderType, triggerPrice, price, quantity sdk.Uint, ttlInSec uint64) MsgPostStopOrder {
+	return MsgPostStopOrder{
+		Owner:        owner,
+		AssetCode:    assetCode,
+		Direction:    direction,
+		OrderType:    orderType,
+		TriggerPrice: triggerPrice,
+		Price:        price,
+		Quantity:     quantity,
+		TtlInSec:     ttlInSec,
+	}
+}
+
+// Client message to amend an active order object (price, quantity and TTL are replaced).
+type MsgAmendOrder struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	OrderID  dnTypes.ID     `json:"order_id" yaml:"order_id"`
+	Price    sdk.Uint       `json:"price" yaml:"price"`
+	Quantity sdk.Uint       `json:"quantity" yaml:"quantity"`
+	TtlInSec uint64         `json:"ttl_in_sec" yaml:"ttl_in_sec"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) Route() string {
+	return ModuleName
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) Type() string {
+	return "amend"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return ErrWrongOwner
+	}
+	if err := msg.OrderID.Valid(); err != nil {
+		return sdkErrors.Wrap(ErrWrongOrderID, err.Error())
+	}
+	if msg.Price.IsZero() {
+		return ErrWrongPrice
+	}
+	if msg.Quantity.IsZero() {
+		return ErrWrongQuantity
+	}
+	if msg.TtlInSec == 0 {
+		return ErrWrongTtl
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgAmendOrder creates MsgAmendOrder message object.
+func NewMsgAmendOrder(owner sdk.AccAddress, id dnTypes.ID, price, quantity sdk.Uint, ttlInSec uint64) MsgAmendOrder {
+	return MsgAmendOrder{
+		Owner:    owner,
+		OrderID:  id,
+		Price:    price,
+		Quantity: quantity,
+		TtlInSec: ttlInSec,
+	}
+}
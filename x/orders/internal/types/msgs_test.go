@@ -14,7 +14,7 @@ import (
 func TestOrders_PostOrderMsg_Valid(t *testing.T) {
 	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
 
-	msg := NewMsgPost(ownerAddr, dnTypes.AssetCode("btc_xfi"), Bid, sdk.OneUint(), sdk.OneUint(), 60)
+	msg := NewMsgPost(ownerAddr, dnTypes.AssetCode("btc_xfi"), Bid, Limit, sdk.OneUint(), sdk.OneUint(), 60)
 	require.NoError(t, msg.ValidateBasic())
 }
 
@@ -22,27 +22,31 @@ func TestOrders_PostOrderMsg_Invalid(t *testing.T) {
 	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
 	assetCode := dnTypes.AssetCode("btc_xfi")
 	direction := Bid
+	orderType := Limit
 	price := sdk.OneUint()
 	quantity := sdk.OneUint()
 	ttl := uint64(60)
 
 	// owner
-	require.Error(t, NewMsgPost(sdk.AccAddress{}, assetCode, direction, price, quantity, ttl).ValidateBasic())
+	require.Error(t, NewMsgPost(sdk.AccAddress{}, assetCode, direction, orderType, price, quantity, ttl).ValidateBasic())
 
 	// assetCode
-	require.Error(t, NewMsgPost(ownerAddr, dnTypes.AssetCode(""), direction, price, quantity, ttl).ValidateBasic())
+	require.Error(t, NewMsgPost(ownerAddr, dnTypes.AssetCode(""), direction, orderType, price, quantity, ttl).ValidateBasic())
 
 	// direction
-	require.Error(t, NewMsgPost(ownerAddr, assetCode, Direction(""), price, quantity, ttl).ValidateBasic())
+	require.Error(t, NewMsgPost(ownerAddr, assetCode, Direction(""), orderType, price, quantity, ttl).ValidateBasic())
+
+	// orderType
+	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, OrderType("foo"), price, quantity, ttl).ValidateBasic())
 
 	// price
-	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, sdk.ZeroUint(), quantity, ttl).ValidateBasic())
+	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, orderType, sdk.ZeroUint(), quantity, ttl).ValidateBasic())
 
 	// quantity
-	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, price, sdk.ZeroUint(), ttl).ValidateBasic())
+	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, orderType, price, sdk.ZeroUint(), ttl).ValidateBasic())
 
 	// ttl
-	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, price, quantity, 0).ValidateBasic())
+	require.Error(t, NewMsgPost(ownerAddr, assetCode, direction, orderType, price, quantity, 0).ValidateBasic())
 }
 
 func TestOrders_RevokeOrderMsg_Valid(t *testing.T) {
@@ -62,3 +66,79 @@ func TestOrders_RevokeOrderMsg_Invalid(t *testing.T) {
 	// orderID
 	require.Error(t, NewMsgRevokeOrder(ownerAddr, dnTypes.ID{}).ValidateBasic())
 }
+
+func TestOrders_AmendOrderMsg_Valid(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+
+	msg := NewMsgAmendOrder(ownerAddr, dnTypes.NewIDFromUint64(0), sdk.OneUint(), sdk.OneUint(), 60)
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestOrders_AmendOrderMsg_Invalid(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+	orderID := dnTypes.NewIDFromUint64(0)
+	price := sdk.OneUint()
+	quantity := sdk.OneUint()
+	ttl := uint64(60)
+
+	// owner
+	require.Error(t, NewMsgAmendOrder(sdk.AccAddress{}, orderID, price, quantity, ttl).ValidateBasic())
+
+	// orderID
+	require.Error(t, NewMsgAmendOrder(ownerAddr, dnTypes.ID{}, price, quantity, ttl).ValidateBasic())
+
+	// price
+	require.Error(t, NewMsgAmendOrder(ownerAddr, orderID, sdk.ZeroUint(), quantity, ttl).ValidateBasic())
+
+	// quantity
+	require.Error(t, NewMsgAmendOrder(ownerAddr, orderID, price, sdk.ZeroUint(), ttl).ValidateBasic())
+
+	// ttl
+	require.Error(t, NewMsgAmendOrder(ownerAddr, orderID, price, quantity, 0).ValidateBasic())
+}
+
+func TestOrders_PostStopOrderMsg_Valid(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+
+	msg := NewMsgPostStop(ownerAddr, dnTypes.AssetCode("btc_xfi"), Ask, ImmediateOrCancel, sdk.OneUint(), sdk.OneUint(), sdk.OneUint(), 60)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.OrderType = ""
+	require.NoError(t, msg.ValidateBasic())
+}
+
+func TestOrders_PostStopOrderMsg_Invalid(t *testing.T) {
+	ownerAddr := sdk.AccAddress("wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h")
+	assetCode := dnTypes.AssetCode("btc_xfi")
+	direction := Ask
+	orderType := Limit
+	triggerPrice := sdk.OneUint()
+	price := sdk.OneUint()
+	quantity := sdk.OneUint()
+	ttl := uint64(60)
+
+	// owner
+	require.Error(t, NewMsgPostStop(sdk.AccAddress{}, assetCode, direction, orderType, triggerPrice, price, quantity, ttl).ValidateBasic())
+
+	// assetCode
+	require.Error(t, NewMsgPostStop(ownerAddr, dnTypes.AssetCode(""), direction, orderType, triggerPrice, price, quantity, ttl).ValidateBasic())
+
+	// direction
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, Direction(""), orderType, triggerPrice, price, quantity, ttl).ValidateBasic())
+
+	// orderType
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, direction, OrderType("foo"), triggerPrice, price, quantity, ttl).ValidateBasic())
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, direction, PostOnly, triggerPrice, price, quantity, ttl).ValidateBasic())
+
+	// triggerPrice
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, direction, orderType, sdk.ZeroUint(), price, quantity, ttl).ValidateBasic())
+
+	// price
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, direction, orderType, triggerPrice, sdk.ZeroUint(), quantity, ttl).ValidateBasic())
+
+	// quantity
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, direction, orderType, triggerPrice, price, sdk.ZeroUint(), ttl).ValidateBasic())
+
+	// ttl
+	require.Error(t, NewMsgPostStop(ownerAddr, assetCode, direction, orderType, triggerPrice, price, quantity, 0).ValidateBasic())
+}
@@ -22,8 +22,10 @@ type Order struct {
 	Owner sdk.AccAddress `json:"owner" yaml:"owner" swaggertype:"string" format:"bech32" example:"wallet13jyjuz3kkdvqw8u4qfkwd94emdl3vx394kn07h"`
 	// Market order belong to
 	Market markets.MarketExtended `json:"market" yaml:"market"`
-	// Order type (bid/ask)
+	// Order direction (bid/ask)
 	Direction Direction `json:"direction" yaml:"direction" swaggertype:"string" example:"bid"`
+	// Order execution type (limit/ioc/fok/post_only)
+	OrderType OrderType `json:"order_type" yaml:"order_type" swaggertype:"string" example:"limit"`
 	// Order target price (in quote asset denom)
 	Price sdk.Uint `json:"price" yaml:"price" swaggertype:"string" example:"100"`
 	// Order target quantity
@@ -53,6 +55,10 @@ func (o Order) Valid() error {
 	if !o.Direction.IsValid() {
 		return fmt.Errorf("direction: invalid")
 	}
+	// empty type is allowed for orders stored before order types were introduced (Limit is assumed)
+	if o.OrderType != "" && !o.OrderType.IsValid() {
+		return fmt.Errorf("type: invalid")
+	}
 	if o.Price.IsZero() {
 		return fmt.Errorf("price: is zero")
 	}
@@ -128,6 +134,7 @@ func (o Order) String() string {
 	b.WriteString(fmt.Sprintf("  ID:        %s\n", o.ID.String()))
 	b.WriteString(fmt.Sprintf("  Owner:     %s\n", o.Owner.String()))
 	b.WriteString(fmt.Sprintf("  Direction: %s\n", o.Direction.String()))
+	b.WriteString(fmt.Sprintf("  OrderType: %s\n", o.OrderType.String()))
 	b.WriteString(fmt.Sprintf("  Price:     %s\n", o.Price.String()))
 	if o.Direction == Bid {
 		b.WriteString(fmt.Sprintf("  QQuantity: %s\n", o.Market.QuoteCurrency.UintToDec(o.Quantity).String()))
@@ -148,6 +155,7 @@ func (o Order) TableHeaders() []string {
 		"O.ID",
 		"O.Owner",
 		"O.Direction",
+		"O.OrderType",
 		"O.Price",
 		"O.QBQuantity",
 		"O.TTL",
@@ -164,6 +172,7 @@ func (o Order) TableValues() []string {
 		o.ID.String(),
 		o.Owner.String(),
 		o.Direction.String(),
+		o.OrderType.String(),
 		o.Price.String(),
 	}
 	if o.Direction == Bid {
@@ -185,6 +194,7 @@ func NewOrder(
 	owner sdk.AccAddress,
 	market markets.MarketExtended,
 	direction Direction,
+	orderType OrderType,
 	price sdk.Uint,
 	quantity sdk.Uint,
 	ttlInSec uint64) Order {
@@ -194,6 +204,7 @@ func NewOrder(
 		Owner:     owner,
 		Market:    market,
 		Direction: direction,
+		OrderType: NewOrderTypeRaw(orderType.String()),
 		Price:     price,
 		Quantity:  quantity,
 		Ttl:       time.Duration(ttlInSec) * time.Second,
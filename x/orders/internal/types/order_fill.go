@@ -17,6 +17,8 @@ type OrderFill struct {
 	ClearancePrice   sdk.Uint
 	QuantityFilled   sdk.Uint
 	QuantityUnfilled sdk.Uint
+	// Market fee charged from the fill coin (set on fill execution)
+	Fee sdk.Coin
 }
 
 // FillCoin returns Coin that should be filled (transferred from Bank to Account).
@@ -45,6 +47,21 @@ func (f OrderFill) FillCoin() (retCoin sdk.Coin, retErr error) {
 	return
 }
 
+// FeeCoin returns Coin that should be charged from the fill coin as a market fee.
+// Fee is rounded down, so small fills might not be charged at all.
+func (f OrderFill) FeeCoin(fillCoin sdk.Coin, feeRate sdk.Dec) sdk.Coin {
+	if feeRate.IsNil() || !feeRate.IsPositive() {
+		return sdk.NewCoin(fillCoin.Denom, sdk.ZeroInt())
+	}
+
+	feeAmount := feeRate.MulInt(fillCoin.Amount).TruncateInt()
+	if feeAmount.GT(fillCoin.Amount) {
+		feeAmount = fillCoin.Amount
+	}
+
+	return sdk.NewCoin(fillCoin.Denom, feeAmount)
+}
+
 // RefundCoin returns Coin that should be refunded (transferred from Bank to Account).
 // Coin denom and quantity is Market and Order type specific.
 //   (doRefund: true, retCoin: not nil) - refund should be done and a proper refund coin was generated;
@@ -78,6 +95,7 @@ func (f OrderFill) String() string {
 	b.WriteString(fmt.Sprintf("  ClearancePrice: %s\n", f.ClearancePrice.String()))
 	b.WriteString(fmt.Sprintf("  Filled:   %s\n", f.QuantityFilled.String()))
 	b.WriteString(fmt.Sprintf("  Unfilled: %s\n", f.QuantityUnfilled.String()))
+	b.WriteString(fmt.Sprintf("  Fee:      %s\n", f.Fee.String()))
 	b.WriteString(f.Order.String())
 
 	return b.String()
@@ -89,6 +107,7 @@ func (f OrderFill) TableHeaders() []string {
 		"F.ClearancePrice",
 		"F.Filled",
 		"F.Unfilled",
+		"F.Fee",
 	}
 
 	return append(h, f.Order.TableHeaders()...)
@@ -100,6 +119,7 @@ func (f OrderFill) TableValues() []string {
 		f.ClearancePrice.String(),
 		f.QuantityFilled.String(),
 		f.QuantityUnfilled.String(),
+		f.Fee.String(),
 	}
 
 	return append(v, f.Order.TableValues()...)
@@ -117,3 +117,31 @@ func TestOrders_OrderFill_RefundCoin(t *testing.T) {
 		require.Nil(t, coin)
 	}
 }
+
+func TestOrders_OrderFill_FeeCoin(t *testing.T) {
+	fill := newMockOrderFill()
+	fillCoin := sdk.NewCoin("xfi", sdk.NewInt(1999))
+
+	// no fee
+	{
+		coin := fill.FeeCoin(fillCoin, sdk.ZeroDec())
+		require.Equal(t, fillCoin.Denom, coin.Denom)
+		require.True(t, coin.Amount.IsZero())
+
+		coin = fill.FeeCoin(fillCoin, sdk.Dec{})
+		require.True(t, coin.Amount.IsZero())
+	}
+
+	// fee is rounded down
+	{
+		coin := fill.FeeCoin(fillCoin, sdk.NewDecWithPrec(1, 3))
+		require.Equal(t, fillCoin.Denom, coin.Denom)
+		require.True(t, coin.Amount.Equal(sdk.NewInt(1)))
+	}
+
+	// fee doesn't exceed the fill amount
+	{
+		coin := fill.FeeCoin(fillCoin, sdk.NewDec(2))
+		require.True(t, coin.Amount.Equal(fillCoin.Amount))
+	}
+}
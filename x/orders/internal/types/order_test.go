@@ -32,6 +32,7 @@ func NewMockOrder() Order {
 			},
 		},
 		Direction: Bid,
+		OrderType: Limit,
 		Price:     sdk.NewUintFromString("1000000000000000000"),
 		Quantity:  sdk.NewUintFromString("100000000"),
 		Ttl:       60,
@@ -0,0 +1,50 @@
+package types
+
+// Enum type to define order execution type.
+type OrderType string
+
+const (
+	// Order stays active until it is fully filled or canceled (by TTL / owner).
+	Limit OrderType = "limit"
+	// Order is matched within the batch it was posted, unfilled quantity is canceled.
+	ImmediateOrCancel OrderType = "ioc"
+	// Order is matched within the batch it was posted only if it can be fully filled, canceled otherwise.
+	FillOrKill OrderType = "fok"
+	// Order is rejected if it crosses an opposite direction order (order can't take liquidity).
+	PostOnly OrderType = "post_only"
+)
+
+// IsValid validates enum.
+func (t OrderType) IsValid() bool {
+	switch t {
+	case Limit, ImmediateOrCancel, FillOrKill, PostOnly:
+		return true
+	}
+
+	return false
+}
+
+// IsImmediate checks if order must be canceled if not filled within the batch it was posted.
+func (t OrderType) IsImmediate() bool {
+	return t == ImmediateOrCancel || t == FillOrKill
+}
+
+// Equal check whether t and t2 are equal.
+func (t OrderType) Equal(t2 OrderType) bool {
+	return t.String() == t2.String()
+}
+
+// String returns string enum representation.
+func (t OrderType) String() string {
+	return string(t)
+}
+
+// NewOrderTypeRaw creates a new OrderType object without checks.
+// Empty string is converted to the default (Limit) type.
+func NewOrderTypeRaw(str string) OrderType {
+	if str == "" {
+		return Limit
+	}
+
+	return OrderType(str)
+}
@@ -0,0 +1,31 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_OrderType_Validity(t *testing.T) {
+	// ok
+	require.True(t, Limit.IsValid())
+	require.True(t, ImmediateOrCancel.IsValid())
+	require.True(t, FillOrKill.IsValid())
+	require.True(t, PostOnly.IsValid())
+
+	// fail
+	require.False(t, OrderType("").IsValid())
+	require.False(t, OrderType("foo").IsValid())
+
+	// immediate
+	require.False(t, Limit.IsImmediate())
+	require.True(t, ImmediateOrCancel.IsImmediate())
+	require.True(t, FillOrKill.IsImmediate())
+	require.False(t, PostOnly.IsImmediate())
+
+	// raw
+	require.Equal(t, Limit, NewOrderTypeRaw(""))
+	require.Equal(t, FillOrKill, NewOrderTypeRaw("fok"))
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"github.com/dfinance/dnode/helpers/perms"
 	marketsClient "github.com/dfinance/dnode/x/markets/client"
+	oracleClient "github.com/dfinance/dnode/x/oracle/client"
 )
 
 const (
@@ -10,6 +11,8 @@ const (
 	PermOrderPost perms.Permission = ModuleName + "PermOrderPost"
 	// Revoke order
 	PermOrderRevoke perms.Permission = ModuleName + "PermOrderRevoke"
+	// Amend order
+	PermOrderAmend perms.Permission = ModuleName + "PermOrderAmend"
 	// Init genesis
 	PermInit perms.Permission = ModuleName + "PermInit"
 	// Read order / orders
@@ -23,7 +26,7 @@ const (
 )
 
 var (
-	AvailablePermissions = perms.Permissions{PermOrderPost, PermOrderRevoke, PermInit, PermRead, PermOrderLock, PermOrderUnlock, PermExecFill}
+	AvailablePermissions = perms.Permissions{PermOrderPost, PermOrderRevoke, PermOrderAmend, PermInit, PermRead, PermOrderLock, PermOrderUnlock, PermExecFill}
 )
 
 func NewModulePerms() perms.ModulePermissions {
@@ -36,6 +39,18 @@ func RequestMarketsPerms() perms.RequestModulePermissions {
 		moduleName = ModuleName
 		modulePerms = perms.Permissions{
 			marketsClient.PermRead,
+			marketsClient.PermFeesCollect,
+		}
+		return
+	}
+}
+
+// RequestOraclePerms returns module perms used by this module.
+func RequestOraclePerms() perms.RequestModulePermissions {
+	return func() (moduleName string, modulePerms perms.Permissions) {
+		moduleName = ModuleName
+		modulePerms = perms.Permissions{
+			oracleClient.PermRead,
 		}
 		return
 	}
@@ -7,8 +7,10 @@ import (
 )
 
 const (
-	QueryList  = "list"
-	QueryOrder = "order"
+	QueryList      = "list"
+	QueryOrder     = "order"
+	QueryStopList  = "stop_list"
+	QueryStopOrder = "stop_order"
 )
 
 // Client request for order.
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+)
+
+// Conditional (stop) order object type.
+// Stop order is stored apart from active orders and is converted to a regular order once triggered by the oracle price.
+// Stop order ID is reserved from the orders ID sequence and is kept after the conversion.
+type StopOrder struct {
+	// Order to be posted on trigger (order type defines stop (ioc) / stop-limit (limit) behaviour)
+	Order Order `json:"order" yaml:"order"`
+	// Oracle price that triggers the order (in oracle price format)
+	TriggerPrice sdk.Uint `json:"trigger_price" yaml:"trigger_price" swaggertype:"string" example:"100"`
+}
+
+// Valid checks that StopOrder is valid (used for genesis ops).
+func (o StopOrder) Valid() error {
+	if err := o.Order.Valid(); err != nil {
+		return fmt.Errorf("order: %w", err)
+	}
+	if o.Order.OrderType == PostOnly {
+		return fmt.Errorf("order: type %q is not supported", o.Order.OrderType)
+	}
+	if o.TriggerPrice.IsZero() {
+		return fmt.Errorf("trigger_price: is zero")
+	}
+
+	return nil
+}
+
+// IsTriggered checks if oracle price has crossed the trigger price.
+// Ask stop order is triggered if oracle bid price fell to the trigger price (stop-loss).
+// Bid stop order is triggered if oracle ask price rose to the trigger price.
+func (o StopOrder) IsTriggered(askPrice, bidPrice sdk.Int) bool {
+	triggerPrice := sdk.NewIntFromBigInt(o.TriggerPrice.BigInt())
+
+	switch o.Order.Direction {
+	case Ask:
+		return bidPrice.IsPositive() && bidPrice.LTE(triggerPrice)
+	case Bid:
+		return askPrice.IsPositive() && askPrice.GTE(triggerPrice)
+	}
+
+	return false
+}
+
+// Strings returns multi-line text object representation.
+func (o StopOrder) String() string {
+	b := strings.Builder{}
+	b.WriteString("StopOrder:\n")
+	b.WriteString(fmt.Sprintf("  TriggerPrice: %s\n", o.TriggerPrice.String()))
+	b.WriteString(o.Order.String())
+
+	return b.String()
+}
+
+// TableHeaders returns table headers for multi-line text table object representation.
+func (o StopOrder) TableHeaders() []string {
+	h := []string{
+		"SO.TriggerPrice",
+	}
+
+	return append(h, o.Order.TableHeaders()...)
+}
+
+// TableHeaders returns table rows for multi-line text table object representation.
+func (o StopOrder) TableValues() []string {
+	v := []string{
+		o.TriggerPrice.String(),
+	}
+
+	return append(v, o.Order.TableValues()...)
+}
+
+// NewStopOrder creates a new stop order object.
+func NewStopOrder(order Order, triggerPrice sdk.Uint) StopOrder {
+	return StopOrder{
+		Order:        order,
+		TriggerPrice: triggerPrice,
+	}
+}
+
+// StopOrder slice type.
+type StopOrders []StopOrder
+
+// Strings returns multi-line text object representation.
+func (l StopOrders) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader(StopOrder{}.TableHeaders())
+
+	for _, o := range l {
+		t.Append(o.TableValues())
+	}
+	t.Render()
+
+	return buf.String()
+}
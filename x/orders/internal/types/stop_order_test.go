@@ -0,0 +1,65 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrders_StopOrder_Valid(t *testing.T) {
+	// ok
+	{
+		stopOrder := NewStopOrder(NewMockOrder(), sdk.NewUint(100))
+		require.NoError(t, stopOrder.Valid())
+	}
+
+	// wrong order
+	{
+		order := NewMockOrder()
+		order.Owner = sdk.AccAddress{}
+		require.Error(t, NewStopOrder(order, sdk.NewUint(100)).Valid())
+	}
+
+	// post-only order type
+	{
+		order := NewMockOrder()
+		order.OrderType = PostOnly
+		require.Error(t, NewStopOrder(order, sdk.NewUint(100)).Valid())
+	}
+
+	// zero trigger price
+	{
+		require.Error(t, NewStopOrder(NewMockOrder(), sdk.ZeroUint()).Valid())
+	}
+}
+
+func TestOrders_StopOrder_IsTriggered(t *testing.T) {
+	triggerPrice := sdk.NewUint(100)
+
+	// ask: triggered by bid price falling to the trigger price
+	{
+		order := NewMockOrder()
+		order.Direction = Ask
+		stopOrder := NewStopOrder(order, triggerPrice)
+
+		require.False(t, stopOrder.IsTriggered(sdk.NewInt(90), sdk.NewInt(101)))
+		require.True(t, stopOrder.IsTriggered(sdk.NewInt(110), sdk.NewInt(100)))
+		require.True(t, stopOrder.IsTriggered(sdk.NewInt(110), sdk.NewInt(50)))
+		require.False(t, stopOrder.IsTriggered(sdk.NewInt(110), sdk.ZeroInt()))
+	}
+
+	// bid: triggered by ask price rising to the trigger price
+	{
+		order := NewMockOrder()
+		order.Direction = Bid
+		stopOrder := NewStopOrder(order, triggerPrice)
+
+		require.False(t, stopOrder.IsTriggered(sdk.NewInt(99), sdk.NewInt(110)))
+		require.True(t, stopOrder.IsTriggered(sdk.NewInt(100), sdk.NewInt(90)))
+		require.True(t, stopOrder.IsTriggered(sdk.NewInt(150), sdk.NewInt(90)))
+		require.False(t, stopOrder.IsTriggered(sdk.ZeroInt(), sdk.NewInt(90)))
+	}
+}
@@ -0,0 +1,13 @@
+package poa
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// EndBlocker removes PoA validators which haven't sent heartbeats for the HeartbeatTimeout period.
+func EndBlocker(ctx sdk.Context, k Keeper) []abci.ValidatorUpdate {
+	k.RemoveInactiveValidators(ctx)
+
+	return []abci.ValidatorUpdate{}
+}
@@ -14,8 +14,13 @@ type (
 	MsgAddValidator             = types.MsgAddValidator
 	MsgReplaceValidator         = types.MsgReplaceValidator
 	MsgRemoveValidator          = types.MsgRemoveValidator
+	MsgHeartbeat                = types.MsgHeartbeat
 	ValidatorReq                = types.ValidatorReq
 	ValidatorsConfirmationsResp = types.ValidatorsConfirmationsResp
+	ValidatorWeight             = types.ValidatorWeight
+	ValidatorWeights            = types.ValidatorWeights
+	ValidatorLiveness           = types.ValidatorLiveness
+	ValidatorLivenesses         = types.ValidatorLivenesses
 )
 
 const (
@@ -27,15 +32,26 @@ const (
 	QueryValidators = types.QueryValidators
 	QueryValidator  = types.QueryValidator
 	QueryMinMax     = types.QueryMinMax
+	QueryInactive   = types.QueryInactive
 	//
 	DefaultMaxValidators = types.DefaultMaxValidators
 	DefaultMinValidators = types.DefaultMinValidators
+	//
+	DefaultHeartbeatTimeout = types.DefaultHeartbeatTimeout
+	//
+	DefaultValidatorWeight = types.DefaultValidatorWeight
+	//
+	ValidatorEthSignatureMsgPrefix = types.ValidatorEthSignatureMsgPrefix
 	// Event types, attribute types and values
 	EventTypeAdd    = types.EventTypeAdd
 	EventTypeRemove = types.EventTypeRemove
 	//
-	AttributeSdkAddress = types.AttributeSdkAddress
-	AttributeEthAddress = types.AttributeEthAddress
+	EventTypeHeartbeat      = types.EventTypeHeartbeat
+	EventTypeInactiveRemove = types.EventTypeInactiveRemove
+	//
+	AttributeSdkAddress          = types.AttributeSdkAddress
+	AttributeEthAddress          = types.AttributeEthAddress
+	AttributeLastHeartbeatHeight = types.AttributeLastHeartbeatHeight
 )
 
 var (
@@ -51,11 +67,18 @@ var (
 	NewMsgAddValidator     = types.NewMsgAddValidator
 	NewMsgReplaceValidator = types.NewMsgReplaceValidator
 	NewMsgRemoveValidator  = types.NewMsgRemoveValidator
+	NewMsgHeartbeat        = types.NewMsgHeartbeat
+	NewParams              = types.NewParams
+	NewValidator           = types.NewValidator
+	//
+	ValidatorEthSignatureMsg = types.ValidatorEthSignatureMsg
 	// errors
-	ErrInternal             = types.ErrInternal
-	ErrWrongEthereumAddress = types.ErrWrongEthereumAddress
-	ErrValidatorExists      = types.ErrValidatorExists
-	ErrValidatorNotExists   = types.ErrValidatorNotExists
-	ErrMaxValidatorsReached = types.ErrMaxValidatorsReached
-	ErrMinValidatorsReached = types.ErrMinValidatorsReached
+	ErrInternal               = types.ErrInternal
+	ErrWrongEthereumAddress   = types.ErrWrongEthereumAddress
+	ErrValidatorExists        = types.ErrValidatorExists
+	ErrValidatorNotExists     = types.ErrValidatorNotExists
+	ErrMaxValidatorsReached   = types.ErrMaxValidatorsReached
+	ErrMinValidatorsReached   = types.ErrMinValidatorsReached
+	ErrWrongEthereumSignature = types.ErrWrongEthereumSignature
+	ErrEthAddressExists       = types.ErrEthAddressExists
 )
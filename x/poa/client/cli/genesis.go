@@ -46,6 +46,9 @@ func AddGenesisPoAValidatorCmd(ctx *server.Context, cdc *codec.Codec, defaultNod
 
 			// update and validate the state
 			genesisState.Validators = append(genesisState.Validators, types.NewValidator(sdkAddr, ethAddr))
+			if len(genesisState.Liveness) > 0 {
+				genesisState.Liveness = append(genesisState.Liveness, types.NewValidatorLiveness(sdkAddr, 0))
+			}
 			if err := genesisState.Validate(true); err != nil {
 				return err
 			}
@@ -16,10 +16,10 @@ import (
 // PostMsAddValidator returns tx command which post a new multisig add validator request.
 func PostMsAddValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "ms-add-validator [uniqueID] [address] [ethAddress]",
+		Use:     "ms-add-validator [uniqueID] [address] [ethAddress] [ethSignature]",
 		Short:   "Add a new PoA validator to the validator list via multisignature",
-		Example: "ms-add-validator add1 {validatorAccount} 0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b7d --from {account}",
-		Args:    cobra.ExactArgs(3),
+		Example: "ms-add-validator add1 {validatorAccount} 0x2c7536E3605D9C16a7a3D7b1898e529396a65c23 {ethSignature} --from {account}",
+		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
 
@@ -39,8 +39,13 @@ func PostMsAddValidator(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// check Ethereum address ownership signature (signed message depends on the chain ID)
+			if err := types.NewValidator(sdkAddr, ethAddr).VerifyEthAddressOwnership(cliCtx.ChainID, args[3]); err != nil {
+				return err
+			}
+
 			// prepare and send multisig message
-			msg := types.NewMsgAddValidator(sdkAddr, ethAddr, fromAddr)
+			msg := types.NewMsgAddValidator(sdkAddr, ethAddr, args[3], fromAddr)
 			callMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
 			if err := callMsg.ValidateBasic(); err != nil {
 				return err
@@ -55,6 +60,7 @@ func PostMsAddValidator(cdc *codec.Codec) *cobra.Command {
 		"unique multi signature call ID",
 		"validator SDK address",
 		"validator Ethereum address",
+		"Ethereum personal_sign signature (hex) of the \"dfinance PoA validator:{chainID}:{address}\" message made by the ethAddress key",
 	})
 
 	return cmd
@@ -104,9 +110,9 @@ func PostMsRemoveValidator(cdc *codec.Codec) *cobra.Command {
 // PostMsReplaceValidator returns tx command which post a new multisig replace validator request.
 func PostMsReplaceValidator(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "ms-replace-validator [uniqueID] [oldValidator] [newValidator] [ethAddress]",
+		Use:   "ms-replace-validator [uniqueID] [oldValidator] [newValidator] [ethAddress] [ethSignature]",
 		Short: "Replace an old PoA validator with the new one via multisignature",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
 
@@ -131,8 +137,13 @@ func PostMsReplaceValidator(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// check Ethereum address ownership signature (signed message depends on the chain ID)
+			if err := types.NewValidator(sdkAddrNew, ethAddr).VerifyEthAddressOwnership(cliCtx.ChainID, args[4]); err != nil {
+				return err
+			}
+
 			// prepare and send multisig message
-			msg := types.NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, fromAddr)
+			msg := types.NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, args[4], fromAddr)
 			msMsg := msClient.NewMsgSubmitCall(msg, args[0], fromAddr)
 			if err := msMsg.ValidateBasic(); err != nil {
 				return err
@@ -148,6 +159,7 @@ func PostMsReplaceValidator(cdc *codec.Codec) *cobra.Command {
 		"old validator SDK address to replace",
 		"new validator SDK address to replace",
 		"new validator Ethereum address",
+		"Ethereum personal_sign signature (hex) of the \"dfinance PoA validator:{chainID}:{newValidator}\" message made by the ethAddress key",
 	})
 
 	return cmd
@@ -100,3 +100,25 @@ func GetMinMax(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetInactive returns query command that returns inactive validators liveness.
+func GetInactive(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "inactive",
+		Short: "Get validators which haven't sent heartbeats for the heartbeat timeout period",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryInactive), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.ValidatorLivenesses
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
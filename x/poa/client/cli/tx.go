@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/poa/internal/types"
+)
+
+// PostHeartbeat returns tx command which reports PoA validator liveness.
+func PostHeartbeat(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "heartbeat",
+		Short:   "Report PoA validator liveness (should be sent periodically by every validator)",
+		Example: "heartbeat --from {validatorAccount}",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			msg := types.NewMsgHeartbeat(fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
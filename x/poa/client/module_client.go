@@ -20,6 +20,7 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 		cli.GetValidator(types2.ModuleName, cdc),
 		cli.GetValidators(types2.ModuleName, cdc),
 		cli.GetMinMax(types2.ModuleName, cdc),
+		cli.GetInactive(types2.ModuleName, cdc),
 	)...)
 
 	return queryCmd
@@ -36,6 +37,7 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 		cli.PostMsAddValidator(cdc),
 		cli.PostMsRemoveValidator(cdc),
 		cli.PostMsReplaceValidator(cdc),
+		cli.PostHeartbeat(cdc),
 	)...)
 
 	return txCmd
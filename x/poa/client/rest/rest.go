@@ -14,6 +14,7 @@ import (
 // RegisterRoutes adds endpoint to REST router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/validators", types.ModuleName), getValidators(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/inactive", types.ModuleName), getInactive(cliCtx)).Methods("GET")
 }
 
 // GetValidators godoc
@@ -37,3 +38,25 @@ func getValidators(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// GetInactive godoc
+// @Tags PoA
+// @Summary Get inactive validators
+// @Description Get liveness of validators which haven't sent heartbeats for the heartbeat timeout period
+// @ID poaInactive
+// @Accept  json
+// @Produce json
+// @Success 200 {object} PoaRespGetInactive
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /poa/inactive [get]
+func getInactive(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryInactive), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
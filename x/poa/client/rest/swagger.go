@@ -10,4 +10,9 @@ type (
 		Height int64                             `json:"height"`
 		Result types.ValidatorsConfirmationsResp `json:"result"`
 	}
+
+	PoaRespGetInactive struct {
+		Height int64                     `json:"height"`
+		Result types.ValidatorLivenesses `json:"result"`
+	}
 )
@@ -4,13 +4,33 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	dnTypes "github.com/dfinance/dnode/helpers/types"
 	"github.com/dfinance/dnode/x/core"
 	"github.com/dfinance/dnode/x/poa/internal/keeper"
 )
 
 // NewHandler creates sdk.Msg type messages handler.
+// Validators add / remove / replace is only supported via multisig messages.
 func NewHandler(k keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		return nil, sdkErrors.Wrap(core.ErrOnlyMultisigMsgs, ModuleName)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case MsgHeartbeat:
+			return handleMsgHeartbeat(ctx, k, msg)
+		default:
+			return nil, sdkErrors.Wrap(core.ErrOnlyMultisigMsgs, ModuleName)
+		}
+	}
+}
+
+// handleMsgHeartbeat handles MsgHeartbeat message.
+func handleMsgHeartbeat(ctx sdk.Context, k keeper.Keeper, msg MsgHeartbeat) (*sdk.Result, error) {
+	if err := k.Heartbeat(ctx, msg.Validator); err != nil {
+		return nil, err
 	}
+
+	ctx.EventManager().EmitEvent(dnTypes.NewModuleNameEvent(ModuleName))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
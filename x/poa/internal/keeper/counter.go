@@ -30,6 +30,31 @@ func (k Keeper) GetEnoughConfirmations(ctx sdk.Context) uint16 {
 	return k.GetValidatorAmount(ctx)/2 + 1
 }
 
+// GetValidatorWeight returns multisig vote weight of the validator (0 for non-validator address).
+func (k Keeper) GetValidatorWeight(ctx sdk.Context, address sdk.AccAddress) uint32 {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.HasValidator(ctx, address) {
+		return 0
+	}
+
+	return k.GetVoteWeights(ctx).Get(address)
+}
+
+// GetValidatorsTotalWeight returns sum of all validators multisig vote weights.
+func (k Keeper) GetValidatorsTotalWeight(ctx sdk.Context) uint64 {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	weights := k.GetVoteWeights(ctx)
+
+	total := uint64(0)
+	for _, v := range k.GetValidators(ctx) {
+		total += uint64(weights.Get(v.Address))
+	}
+
+	return total
+}
+
 // increaseValidatorsAmount increases current validators amount counter by 1.
 func (k Keeper) increaseValidatorsAmount(ctx sdk.Context) uint16 {
 	amount := k.GetValidatorAmount(ctx)
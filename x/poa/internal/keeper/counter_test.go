@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/poa/internal/types"
 )
 
 func TestPOAKeeper_ConfirmationsCounter(t *testing.T) {
@@ -77,3 +79,39 @@ func TestPOAKeeper_ConfirmationsCounter(t *testing.T) {
 		require.EqualValues(t, uint16(2), confirmations)
 	}
 }
+
+func TestPOAKeeper_ValidatorsWeight(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	require.NoError(t, keeper.AddValidator(ctx, sdkAddress1, ethAddress1))
+	require.NoError(t, keeper.AddValidator(ctx, sdkAddress2, ethAddress2))
+	require.NoError(t, keeper.AddValidator(ctx, sdkAddress3, ethAddress3))
+
+	// check default weights
+	{
+		require.EqualValues(t, types.DefaultValidatorWeight, keeper.GetValidatorWeight(ctx, sdkAddress1))
+		require.EqualValues(t, 3, keeper.GetValidatorsTotalWeight(ctx))
+	}
+
+	// check custom weights
+	{
+		params := keeper.GetParams(ctx)
+		params.VoteWeights = types.ValidatorWeights{
+			{Address: sdkAddress1, Weight: 3},
+			{Address: sdkAddress4, Weight: 10},
+		}
+		keeper.setParams(ctx, params)
+
+		require.EqualValues(t, 3, keeper.GetValidatorWeight(ctx, sdkAddress1))
+		require.EqualValues(t, 1, keeper.GetValidatorWeight(ctx, sdkAddress2))
+		require.EqualValues(t, 5, keeper.GetValidatorsTotalWeight(ctx))
+	}
+
+	// check non-validator weight (weight param is ignored)
+	{
+		require.EqualValues(t, 0, keeper.GetValidatorWeight(ctx, sdkAddress4))
+	}
+}
@@ -21,15 +21,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 			panic(err)
 		}
 	}
+	for _, l := range state.Liveness {
+		k.setValidatorLiveness(ctx, l)
+	}
 }
 
 // ExportGenesis exports module genesis state using current params state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	k.modulePerms.AutoCheck(types.PermRead)
 
+	validators := k.GetValidators(ctx)
+	liveness := make(types.ValidatorLivenesses, 0, len(validators))
+	for _, v := range validators {
+		l, err := k.GetValidatorLiveness(ctx, v.Address)
+		if err != nil {
+			panic(err)
+		}
+		liveness = append(liveness, l)
+	}
+
 	state := types.GenesisState{
 		Parameters: k.GetParams(ctx),
-		Validators: k.GetValidators(ctx),
+		Validators: validators,
+		Liveness:   liveness,
 	}
 
 	return k.cdc.MustMarshalJSON(state)
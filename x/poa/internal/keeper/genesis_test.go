@@ -64,3 +64,87 @@ func TestPOAKeeper_InitExportGenesis(t *testing.T) {
 		}
 	}
 }
+
+// Check genesis validators liveness import / export.
+func TestPOAKeeper_InitExportGenesis_Liveness(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx, cdc := input.target, input.ctx, input.cdc
+	ctx = ctx.WithBlockHeight(5)
+
+	state := types.GenesisState{
+		Parameters: types.DefaultParams(),
+		Validators: types.Validators{
+			types.NewValidator(sdkAddress1, ethAddress1),
+			types.NewValidator(sdkAddress2, ethAddress2),
+			types.NewValidator(sdkAddress3, ethAddress3),
+		},
+		Liveness: types.ValidatorLivenesses{
+			types.NewValidatorLiveness(sdkAddress2, 2),
+			types.NewValidatorLiveness(sdkAddress1, 1),
+			types.NewValidatorLiveness(sdkAddress3, 3),
+		},
+	}
+	require.NoError(t, state.Validate(false))
+
+	// init
+	{
+		keeper.InitGenesis(ctx, cdc.MustMarshalJSON(state))
+
+		for _, inLiveness := range state.Liveness {
+			outLiveness, err := keeper.GetValidatorLiveness(ctx, inLiveness.Address)
+			require.NoError(t, err)
+			require.Equal(t, inLiveness.LastHeartbeatHeight, outLiveness.LastHeartbeatHeight)
+		}
+	}
+
+	// export
+	{
+		ctx = ctx.WithBlockHeight(10)
+		require.NoError(t, keeper.Heartbeat(ctx, sdkAddress2))
+
+		var exportedState types.GenesisState
+		cdc.MustUnmarshalJSON(keeper.ExportGenesis(ctx), &exportedState)
+		require.NoError(t, exportedState.Validate(false))
+
+		require.Len(t, exportedState.Liveness, 3)
+		for i, v := range exportedState.Validators {
+			require.Equal(t, v.Address, exportedState.Liveness[i].Address)
+		}
+
+		expectedHeights := map[string]int64{
+			sdkAddress1.String(): 1,
+			sdkAddress2.String(): 10,
+			sdkAddress3.String(): 3,
+		}
+		for _, l := range exportedState.Liveness {
+			require.Equal(t, expectedHeights[l.Address.String()], l.LastHeartbeatHeight, l.Address.String())
+		}
+	}
+}
+
+// Check genesis without validators liveness initializes liveness with the genesis block height.
+func TestPOAKeeper_InitGenesis_NoLiveness(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx, cdc := input.target, input.ctx, input.cdc
+	ctx = ctx.WithBlockHeight(5)
+
+	state := types.GenesisState{
+		Parameters: types.DefaultParams(),
+		Validators: types.Validators{
+			types.NewValidator(sdkAddress1, ethAddress1),
+			types.NewValidator(sdkAddress2, ethAddress2),
+			types.NewValidator(sdkAddress3, ethAddress3),
+		},
+	}
+	keeper.InitGenesis(ctx, cdc.MustMarshalJSON(state))
+
+	for _, v := range state.Validators {
+		liveness, err := keeper.GetValidatorLiveness(ctx.WithBlockHeight(10), v.Address)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, liveness.LastHeartbeatHeight)
+	}
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dfinance/dnode/x/poa/internal/types"
+)
+
+// Heartbeat updates PoA validator liveness.
+func (k Keeper) Heartbeat(ctx sdk.Context, address sdk.AccAddress) error {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	if !k.HasValidator(ctx, address) {
+		return sdkErrors.Wrap(types.ErrValidatorNotExists, address.String())
+	}
+
+	k.setValidatorLiveness(ctx, types.NewValidatorLiveness(address, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(types.NewHeartbeatEvent(address))
+
+	return nil
+}
+
+// GetValidatorLiveness returns PoA validator liveness.
+func (k Keeper) GetValidatorLiveness(ctx sdk.Context, address sdk.AccAddress) (types.ValidatorLiveness, error) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	if !k.HasValidator(ctx, address) {
+		return types.ValidatorLiveness{}, sdkErrors.Wrap(types.ErrValidatorNotExists, address.String())
+	}
+
+	liveness, found := k.getValidatorLiveness(ctx, address)
+	if !found {
+		// validator added before liveness tracking was introduced
+		return types.NewValidatorLiveness(address, ctx.BlockHeight()), nil
+	}
+
+	return liveness, nil
+}
+
+// GetInactiveValidators returns liveness of validators silent for more than HeartbeatTimeout blocks (sorted by silence duration).
+func (k Keeper) GetInactiveValidators(ctx sdk.Context) types.ValidatorLivenesses {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	heartbeatTimeout := k.GetHeartbeatTimeout(ctx)
+
+	inactive := make(types.ValidatorLivenesses, 0)
+	for _, validator := range k.GetValidators(ctx) {
+		liveness, found := k.getValidatorLiveness(ctx, validator.Address)
+		if !found {
+			continue
+		}
+
+		if liveness.IsInactive(ctx.BlockHeight(), heartbeatTimeout) {
+			inactive = append(inactive, liveness)
+		}
+	}
+
+	sort.SliceStable(inactive, func(i, j int) bool {
+		return inactive[i].LastHeartbeatHeight < inactive[j].LastHeartbeatHeight
+	})
+
+	return inactive
+}
+
+// RemoveInactiveValidators removes inactive validators (the most silent first) keeping MinValidators limit.
+func (k Keeper) RemoveInactiveValidators(ctx sdk.Context) {
+	k.modulePerms.AutoCheck(types.PermWrite)
+
+	// start tracking validators added before liveness tracking was introduced
+	for _, validator := range k.GetValidators(ctx) {
+		if _, found := k.getValidatorLiveness(ctx, validator.Address); !found {
+			k.setValidatorLiveness(ctx, types.NewValidatorLiveness(validator.Address, ctx.BlockHeight()))
+		}
+	}
+
+	for _, liveness := range k.GetInactiveValidators(ctx) {
+		if err := k.removeValidator(ctx, liveness.Address, true); err != nil {
+			k.GetLogger(ctx).Info("inactive validator not removed", "address", liveness.Address.String(), "reason", err.Error())
+			return
+		}
+
+		ctx.EventManager().EmitEvent(types.NewInactiveValidatorRemovedEvent(liveness))
+	}
+}
+
+// getValidatorLiveness returns validator liveness from the storage.
+func (k Keeper) getValidatorLiveness(ctx sdk.Context, address sdk.AccAddress) (types.ValidatorLiveness, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLivenessKey(address))
+	if bz == nil {
+		return types.ValidatorLiveness{}, false
+	}
+
+	liveness := types.ValidatorLiveness{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &liveness)
+
+	return liveness, true
+}
+
+// setValidatorLiveness sets validator liveness to the storage.
+func (k Keeper) setValidatorLiveness(ctx sdk.Context, liveness types.ValidatorLiveness) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorLivenessKey(liveness.Address), k.cdc.MustMarshalBinaryBare(liveness))
+}
+
+// removeValidatorLiveness removes validator liveness from the storage.
+func (k Keeper) removeValidatorLiveness(ctx sdk.Context, address sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorLivenessKey(address))
+}
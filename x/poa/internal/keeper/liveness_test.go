@@ -0,0 +1,87 @@
+// +build unit
+
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/poa/internal/types"
+)
+
+// Check validators heartbeats, inactive validators listing and auto removal.
+func TestPOAKeeper_Liveness(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	params := types.NewParams(types.DefaultMaxValidators, 2, types.ValidatorWeights{}, 10)
+	keeper.setParams(ctx, params)
+
+	// add validators
+	{
+		ctx = ctx.WithBlockHeight(1)
+		require.NoError(t, keeper.AddValidator(ctx, sdkAddress1, ethAddress1))
+		require.NoError(t, keeper.AddValidator(ctx, sdkAddress2, ethAddress2))
+		ctx = ctx.WithBlockHeight(2)
+		require.NoError(t, keeper.AddValidator(ctx, sdkAddress3, ethAddress3))
+
+		liveness, err := keeper.GetValidatorLiveness(ctx, sdkAddress3)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, liveness.LastHeartbeatHeight)
+	}
+
+	// fail: heartbeat from non-validator
+	{
+		require.Error(t, keeper.Heartbeat(ctx, sdkAddress4))
+	}
+
+	// heartbeat
+	{
+		ctx = ctx.WithBlockHeight(5)
+		require.NoError(t, keeper.Heartbeat(ctx, sdkAddress1))
+
+		liveness, err := keeper.GetValidatorLiveness(ctx, sdkAddress1)
+		require.NoError(t, err)
+		require.EqualValues(t, 5, liveness.LastHeartbeatHeight)
+	}
+
+	// no inactive validators yet
+	{
+		ctx = ctx.WithBlockHeight(11)
+		require.Empty(t, keeper.GetInactiveValidators(ctx))
+	}
+
+	// inactive validators sorted by silence duration
+	{
+		ctx = ctx.WithBlockHeight(13)
+		inactive := keeper.GetInactiveValidators(ctx)
+		require.Len(t, inactive, 2)
+		require.Equal(t, sdkAddress2.String(), inactive[0].Address.String())
+		require.Equal(t, sdkAddress3.String(), inactive[1].Address.String())
+	}
+
+	// auto removal stops on MinValidators limit
+	{
+		keeper.RemoveInactiveValidators(ctx)
+
+		require.EqualValues(t, 2, keeper.GetValidatorAmount(ctx))
+		require.False(t, keeper.HasValidator(ctx, sdkAddress2))
+		require.True(t, keeper.HasValidator(ctx, sdkAddress3))
+
+		_, err := keeper.GetValidatorLiveness(ctx, sdkAddress2)
+		require.Error(t, err)
+	}
+
+	// auto removal disabled
+	{
+		params.MinValidators, params.HeartbeatTimeout = 1, 0
+		keeper.setParams(ctx, params)
+
+		require.Empty(t, keeper.GetInactiveValidators(ctx))
+		keeper.RemoveInactiveValidators(ctx)
+		require.EqualValues(t, 2, keeper.GetValidatorAmount(ctx))
+	}
+}
@@ -22,14 +22,34 @@ func (k Keeper) GetMinValidators(ctx sdk.Context) (res uint16) {
 	return
 }
 
+// GetVoteWeights returns voteWeights param.
+// Empty list is returned if param is not set (all validators have the equal DefaultValidatorWeight).
+func (k Keeper) GetVoteWeights(ctx sdk.Context) (res types.ValidatorWeights) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	k.paramStore.GetIfExists(ctx, types.ParamStoreKeyVoteWeights, &res)
+	return
+}
+
+// GetHeartbeatTimeout returns heartbeatTimeout param.
+// Zero is returned if param is not set (inactive validators removal is disabled).
+func (k Keeper) GetHeartbeatTimeout(ctx sdk.Context) (res uint32) {
+	k.modulePerms.AutoCheck(types.PermRead)
+
+	k.paramStore.GetIfExists(ctx, types.ParamStoreKeyHeartbeatTimeout, &res)
+	return
+}
+
 // GetParams returns keeper params.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	k.modulePerms.AutoCheck(types.PermRead)
 
 	min := k.GetMinValidators(ctx)
 	max := k.GetMaxValidators(ctx)
+	weights := k.GetVoteWeights(ctx)
+	heartbeatTimeout := k.GetHeartbeatTimeout(ctx)
 
-	return types.NewParams(max, min)
+	return types.NewParams(max, min, weights, heartbeatTimeout)
 }
 
 // setParams sets keeper params.
@@ -18,8 +18,9 @@ func TestPOAKeeper_Params(t *testing.T) {
 	keeper, ctx := input.target, input.ctx
 
 	inParams := types.Params{
-		MaxValidators: 10,
-		MinValidators: 5,
+		MaxValidators:    10,
+		MinValidators:    5,
+		HeartbeatTimeout: 100,
 	}
 
 	keeper.setParams(ctx, inParams)
@@ -27,7 +28,25 @@ func TestPOAKeeper_Params(t *testing.T) {
 	outParams := keeper.GetParams(ctx)
 	require.EqualValues(t, 10, outParams.MaxValidators)
 	require.EqualValues(t, 5, outParams.MinValidators)
+	require.EqualValues(t, 100, outParams.HeartbeatTimeout)
 
 	require.EqualValues(t, 10, keeper.GetMaxValidators(ctx))
 	require.EqualValues(t, 5, keeper.GetMinValidators(ctx))
+	require.EqualValues(t, 100, keeper.GetHeartbeatTimeout(ctx))
+}
+
+// Check optional params are not required to be set.
+func TestPOAKeeper_ParamsNotSet(t *testing.T) {
+	t.Parallel()
+
+	input := NewTestInput(t)
+	keeper, ctx := input.target, input.ctx
+
+	// voteWeights: equal weights
+	require.Empty(t, keeper.GetVoteWeights(ctx))
+	require.EqualValues(t, types.DefaultValidatorWeight, keeper.GetVoteWeights(ctx).Get(sdkAddress1))
+
+	// heartbeatTimeout: disabled
+	require.Zero(t, keeper.GetHeartbeatTimeout(ctx))
+	require.Empty(t, keeper.GetInactiveValidators(ctx))
 }
@@ -19,6 +19,8 @@ func NewQuerier(poaKeeper Keeper) sdk.Querier {
 			return queryGetValidator(poaKeeper, ctx, req)
 		case types.QueryMinMax:
 			return queryGetMinMax(poaKeeper, ctx)
+		case types.QueryInactive:
+			return queryGetInactive(poaKeeper, ctx)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -69,3 +71,13 @@ func queryGetMinMax(k Keeper, ctx sdk.Context) ([]byte, error) {
 
 	return bz, nil
 }
+
+// queryGetInactive handles getInactive query which returns inactive validators liveness.
+func queryGetInactive(k Keeper, ctx sdk.Context) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetInactiveValidators(ctx))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "validatorLivenesses marshal: %v", err)
+	}
+
+	return bz, nil
+}
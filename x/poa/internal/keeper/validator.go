@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -67,6 +69,10 @@ func (k Keeper) addValidator(ctx sdk.Context, address sdk.AccAddress, ethAddress
 		return sdkErrors.Wrap(types.ErrValidatorExists, address.String())
 	}
 
+	if existingValidator, found := k.getValidatorByEthAddress(ctx, ethAddress); found {
+		return sdkErrors.Wrapf(types.ErrEthAddressExists, "%s: used by %s", ethAddress, existingValidator.Address)
+	}
+
 	if checkLimits {
 		maxValidators := k.GetMaxValidators(ctx)
 		curValidators := k.GetValidatorAmount(ctx)
@@ -81,6 +87,7 @@ func (k Keeper) addValidator(ctx sdk.Context, address sdk.AccAddress, ethAddress
 
 	k.addValidatorToList(ctx, validator)
 	k.increaseValidatorsAmount(ctx)
+	k.setValidatorLiveness(ctx, types.NewValidatorLiveness(validator.Address, ctx.BlockHeight()))
 
 	ctx.EventManager().EmitEvent(types.NewValidatorAddedEvent(validator))
 
@@ -108,6 +115,7 @@ func (k Keeper) removeValidator(ctx sdk.Context, address sdk.AccAddress, checkLi
 
 	k.removeValidatorFromList(ctx, address)
 	k.decreaseValidatorsAmount(ctx)
+	k.removeValidatorLiveness(ctx, address)
 
 	ctx.EventManager().EmitEvent(types.NewValidatorRemovedEvent(validator))
 
@@ -124,3 +132,14 @@ func (k Keeper) getValidator(ctx sdk.Context, address sdk.AccAddress) types.Vali
 
 	return validator
 }
+
+// getValidatorByEthAddress returns validator with the specified Ethereum address (case insensitive).
+func (k Keeper) getValidatorByEthAddress(ctx sdk.Context, ethAddress string) (types.Validator, bool) {
+	for _, validator := range k.GetValidators(ctx) {
+		if strings.EqualFold(validator.EthAddress, ethAddress) {
+			return validator, true
+		}
+	}
+
+	return types.Validator{}, false
+}
@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -74,6 +75,13 @@ func TestPOAKeeper_AddValidator(t *testing.T) {
 		require.Error(t, err)
 	}
 
+	// fail: ethAddress used by another validator
+	{
+		err := keeper.AddValidator(ctx, sdkAddress2, strings.ToLower(ethAddress1))
+		require.Error(t, err)
+		require.True(t, types.ErrEthAddressExists.Is(err))
+	}
+
 	// fail: max validators reached
 	{
 		keeper.setParams(ctx, types.Params{
@@ -233,4 +241,11 @@ func TestPOAKeeper_ReplaceValidator(t *testing.T) {
 		err := keeper.ReplaceValidator(ctx, sdkAddress2, sdkAddress4, ethAddress4)
 		require.Error(t, err)
 	}
+
+	// fail: ethAddress used by another validator
+	{
+		err := keeper.ReplaceValidator(ctx, sdkAddress3, sdkAddress4, ethAddress1)
+		require.Error(t, err)
+		require.True(t, types.ErrEthAddressExists.Is(err))
+	}
 }
@@ -12,6 +12,7 @@ const (
 	CodecNameMsgAddValidator     = ModuleName + "/AddValidator"
 	CodecNameMsgRemoveValidator  = ModuleName + "/RemoveValidator"
 	CodecNameMsgReplaceValidator = ModuleName + "/ReplaceValidator"
+	CodecNameMsgHeartbeat        = ModuleName + "/Heartbeat"
 )
 
 var ModuleCdc *codec.Codec
@@ -21,6 +22,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddValidator{}, CodecNameMsgAddValidator, nil)
 	cdc.RegisterConcrete(MsgRemoveValidator{}, CodecNameMsgRemoveValidator, nil)
 	cdc.RegisterConcrete(MsgReplaceValidator{}, CodecNameMsgReplaceValidator, nil)
+	cdc.RegisterConcrete(MsgHeartbeat{}, CodecNameMsgHeartbeat, nil)
 }
 
 func init() {
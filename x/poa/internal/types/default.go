@@ -1,10 +1,17 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName        = "poa"
 	RouterKey         = ModuleName
 	StoreKey          = ModuleName
 	DefaultParamspace = ModuleName
+
+	// Validator Ethereum address ownership signature message prefix (domain separation)
+	ValidatorEthSignatureMsgPrefix = "dfinance PoA validator:"
 )
 
 var (
@@ -12,4 +19,18 @@ var (
 	ValidatorsCountKey = []byte("validatorsCount")
 	// Key for storing validator objects
 	ValidatorsListKey = []byte("validators")
+	// Prefix for storing validator liveness objects
+	ValidatorLivenessPrefix = []byte("liveness")
+	// Key delimiter
+	KeyDelimiter = []byte(":")
 )
+
+// GetValidatorLivenessKey returns key for storing validator liveness object.
+func GetValidatorLivenessKey(address sdk.AccAddress) []byte {
+	return append(GetValidatorLivenessPrefix(), address.Bytes()...)
+}
+
+// GetValidatorLivenessPrefix returns key prefix for validator liveness objects iteration.
+func GetValidatorLivenessPrefix() []byte {
+	return append(ValidatorLivenessPrefix, KeyDelimiter...)
+}
@@ -5,10 +5,12 @@ import (
 )
 
 var (
-	ErrInternal             = sdkErrors.Register(ModuleName, 100, "internal")
-	ErrWrongEthereumAddress = sdkErrors.Register(ModuleName, 101, "wrong ethereum address for validator")
-	ErrValidatorExists      = sdkErrors.Register(ModuleName, 102, "validator already exists")
-	ErrValidatorNotExists   = sdkErrors.Register(ModuleName, 103, "validator not found")
-	ErrMaxValidatorsReached = sdkErrors.Register(ModuleName, 104, "maximum number of validators reached")
-	ErrMinValidatorsReached = sdkErrors.Register(ModuleName, 105, "minimum number of validators reached")
+	ErrInternal               = sdkErrors.Register(ModuleName, 100, "internal")
+	ErrWrongEthereumAddress   = sdkErrors.Register(ModuleName, 101, "wrong ethereum address for validator")
+	ErrValidatorExists        = sdkErrors.Register(ModuleName, 102, "validator already exists")
+	ErrValidatorNotExists     = sdkErrors.Register(ModuleName, 103, "validator not found")
+	ErrMaxValidatorsReached   = sdkErrors.Register(ModuleName, 104, "maximum number of validators reached")
+	ErrMinValidatorsReached   = sdkErrors.Register(ModuleName, 105, "minimum number of validators reached")
+	ErrWrongEthereumSignature = sdkErrors.Register(ModuleName, 106, "wrong ethereum address ownership signature")
+	ErrEthAddressExists       = sdkErrors.Register(ModuleName, 107, "ethereum address is already used by another validator")
 )
@@ -1,13 +1,21 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	EventTypeAdd    = ModuleName + ".add"
 	EventTypeRemove = ModuleName + ".remove"
 	//
-	AttributeSdkAddress = "address"
-	AttributeEthAddress = "eth_address"
+	EventTypeHeartbeat      = ModuleName + ".heartbeat"
+	EventTypeInactiveRemove = ModuleName + ".inactive_remove"
+	//
+	AttributeSdkAddress          = "address"
+	AttributeEthAddress          = "eth_address"
+	AttributeLastHeartbeatHeight = "last_heartbeat_height"
 )
 
 // NewValidatorAddedEvent creates an Event on validator add (triggered on replace as well).
@@ -27,3 +35,20 @@ func NewValidatorRemovedEvent(validator Validator) sdk.Event {
 		sdk.Attribute{Key: AttributeEthAddress, Value: validator.EthAddress},
 	)
 }
+
+// NewHeartbeatEvent creates an Event on validator heartbeat.
+func NewHeartbeatEvent(address sdk.AccAddress) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeHeartbeat,
+		sdk.Attribute{Key: AttributeSdkAddress, Value: address.String()},
+	)
+}
+
+// NewInactiveValidatorRemovedEvent creates an Event on inactive validator auto removal (followed by the remove event).
+func NewInactiveValidatorRemovedEvent(liveness ValidatorLiveness) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeInactiveRemove,
+		sdk.Attribute{Key: AttributeSdkAddress, Value: liveness.Address.String()},
+		sdk.Attribute{Key: AttributeLastHeartbeatHeight, Value: strconv.FormatInt(liveness.LastHeartbeatHeight, 10)},
+	)
+}
@@ -2,12 +2,15 @@ package types
 
 import (
 	"fmt"
+	"strings"
 )
 
 // GenesisState is module's genesis (initial state).
 type GenesisState struct {
 	Parameters Params     `json:"parameters" yaml:"parameters"`
 	Validators Validators `json:"validators" yaml:"validators"`
+	// Validators liveness (optional, validators liveness is initialized with the genesis block height if not set)
+	Liveness ValidatorLivenesses `json:"liveness" yaml:"liveness"`
 }
 
 // Validate checks that genesis state is valid.
@@ -18,6 +21,7 @@ func (s GenesisState) Validate(skipCountValidation bool) error {
 	}
 
 	validatorsSet := make(map[string]bool, len(s.Validators))
+	ethAddressesSet := make(map[string]bool, len(s.Validators))
 	for i, v := range s.Validators {
 		if err := v.Validate(); err != nil {
 			return fmt.Errorf("validator [%d]: %w", i, err)
@@ -27,6 +31,34 @@ func (s GenesisState) Validate(skipCountValidation bool) error {
 			return fmt.Errorf("validator [%d]: is a duplicate", i)
 		}
 		validatorsSet[v.Address.String()] = true
+
+		ethAddress := strings.ToLower(v.EthAddress)
+		if ethAddressesSet[ethAddress] {
+			return fmt.Errorf("validator [%d]: ethAddress %s: is a duplicate", i, v.EthAddress)
+		}
+		ethAddressesSet[ethAddress] = true
+	}
+
+	if len(s.Liveness) > 0 {
+		if len(s.Liveness) != len(s.Validators) {
+			return fmt.Errorf("liveness: length mismatch: %d, validators: %d", len(s.Liveness), len(s.Validators))
+		}
+
+		livenessSet := make(map[string]bool, len(s.Liveness))
+		for i, l := range s.Liveness {
+			if err := l.Validate(); err != nil {
+				return fmt.Errorf("liveness [%d]: %w", i, err)
+			}
+
+			if !validatorsSet[l.Address.String()] {
+				return fmt.Errorf("liveness [%d]: validator %s: not found", i, l.Address)
+			}
+
+			if livenessSet[l.Address.String()] {
+				return fmt.Errorf("liveness [%d]: is a duplicate", i)
+			}
+			livenessSet[l.Address.String()] = true
+		}
 	}
 
 	if !skipCountValidation {
@@ -47,5 +79,6 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Parameters: DefaultParams(),
 		Validators: make(Validators, 0),
+		Liveness:   make(ValidatorLivenesses, 0),
 	}
 }
@@ -114,4 +114,85 @@ func TestPOA_Genesis_Validate(t *testing.T) {
 		}
 		require.Error(t, state.Validate(false))
 	}
+
+	// fail: duplicate ethAddresses
+	{
+		state := GenesisState{
+			Parameters: Params{
+				MaxValidators: DefaultMaxValidators,
+				MinValidators: DefaultMinValidators,
+			},
+			Validators: Validators{
+				NewValidator(sdk.AccAddress("addr1"), "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b71"),
+				NewValidator(sdk.AccAddress("addr2"), "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b72"),
+				NewValidator(sdk.AccAddress("addr3"), "0x6adaf04f4e2ba9cdde3ec143bdcf02ad830c1b71"),
+			},
+		}
+		require.Error(t, state.Validate(false))
+	}
+
+	// liveness
+	{
+		newState := func(liveness ValidatorLivenesses) GenesisState {
+			return GenesisState{
+				Parameters: Params{
+					MaxValidators: DefaultMaxValidators,
+					MinValidators: DefaultMinValidators,
+				},
+				Validators: Validators{
+					NewValidator(sdk.AccAddress("addr1"), "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b71"),
+					NewValidator(sdk.AccAddress("addr2"), "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b72"),
+					NewValidator(sdk.AccAddress("addr3"), "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b73"),
+				},
+				Liveness: liveness,
+			}
+		}
+
+		// ok
+		state := newState(ValidatorLivenesses{
+			NewValidatorLiveness(sdk.AccAddress("addr3"), 30),
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 10),
+			NewValidatorLiveness(sdk.AccAddress("addr2"), 0),
+		})
+		require.NoError(t, state.Validate(false))
+
+		// fail: length mismatch
+		state = newState(ValidatorLivenesses{
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 10),
+			NewValidatorLiveness(sdk.AccAddress("addr2"), 20),
+		})
+		require.Error(t, state.Validate(false))
+
+		// fail: empty address
+		state = newState(ValidatorLivenesses{
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 10),
+			NewValidatorLiveness(sdk.AccAddress("addr2"), 20),
+			NewValidatorLiveness(sdk.AccAddress(""), 30),
+		})
+		require.Error(t, state.Validate(false))
+
+		// fail: negative height
+		state = newState(ValidatorLivenesses{
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 10),
+			NewValidatorLiveness(sdk.AccAddress("addr2"), 20),
+			NewValidatorLiveness(sdk.AccAddress("addr3"), -1),
+		})
+		require.Error(t, state.Validate(false))
+
+		// fail: non-existing validator
+		state = newState(ValidatorLivenesses{
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 10),
+			NewValidatorLiveness(sdk.AccAddress("addr2"), 20),
+			NewValidatorLiveness(sdk.AccAddress("addr4"), 30),
+		})
+		require.Error(t, state.Validate(false))
+
+		// fail: duplicate
+		state = newState(ValidatorLivenesses{
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 10),
+			NewValidatorLiveness(sdk.AccAddress("addr2"), 20),
+			NewValidatorLiveness(sdk.AccAddress("addr1"), 30),
+		})
+		require.Error(t, state.Validate(false))
+	}
 }
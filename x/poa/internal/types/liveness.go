@@ -0,0 +1,91 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/olekukonko/tablewriter"
+)
+
+// ValidatorLiveness contains PoA validator heartbeat meta.
+type ValidatorLiveness struct {
+	// Validator SDK address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" example:"wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m"`
+	// Block height of the last validator heartbeat (validator add height if no heartbeats were sent)
+	LastHeartbeatHeight int64 `json:"last_heartbeat_height" yaml:"last_heartbeat_height" example:"100"`
+}
+
+// Validate checks ValidatorLiveness.
+func (l ValidatorLiveness) Validate() error {
+	if l.Address.Empty() {
+		return fmt.Errorf("address: empty")
+	}
+
+	if l.LastHeartbeatHeight < 0 {
+		return fmt.Errorf("lastHeartbeatHeight: negative")
+	}
+
+	return nil
+}
+
+// SilentBlocks returns number of blocks since the last heartbeat.
+func (l ValidatorLiveness) SilentBlocks(curBlockHeight int64) int64 {
+	if curBlockHeight < l.LastHeartbeatHeight {
+		return 0
+	}
+
+	return curBlockHeight - l.LastHeartbeatHeight
+}
+
+// IsInactive checks if validator hasn't sent a heartbeat for more than {heartbeatTimeout} blocks.
+// Zero {heartbeatTimeout} disables the check.
+func (l ValidatorLiveness) IsInactive(curBlockHeight int64, heartbeatTimeout uint32) bool {
+	if heartbeatTimeout == 0 {
+		return false
+	}
+
+	return l.SilentBlocks(curBlockHeight) > int64(heartbeatTimeout)
+}
+
+func (l ValidatorLiveness) String() string {
+	return fmt.Sprintf("ValidatorLiveness:\n"+
+		"Address: %s\n"+
+		"LastHeartbeatHeight: %d",
+		l.Address.String(),
+		l.LastHeartbeatHeight,
+	)
+}
+
+// NewValidatorLiveness creates a new ValidatorLiveness object.
+func NewValidatorLiveness(address sdk.AccAddress, lastHeartbeatHeight int64) ValidatorLiveness {
+	return ValidatorLiveness{
+		Address:             address,
+		LastHeartbeatHeight: lastHeartbeatHeight,
+	}
+}
+
+// ValidatorLiveness slice type.
+type ValidatorLivenesses []ValidatorLiveness
+
+// Strings returns multi-line text object representation.
+func (list ValidatorLivenesses) String() string {
+	var buf bytes.Buffer
+
+	t := tablewriter.NewWriter(&buf)
+	t.SetHeader([]string{
+		"VL.Address",
+		"VL.LastHeartbeatHeight",
+	})
+
+	for _, l := range list {
+		t.Append([]string{
+			l.Address.String(),
+			strconv.FormatInt(l.LastHeartbeatHeight, 10),
+		})
+	}
+	t.Render()
+
+	return buf.String()
+}
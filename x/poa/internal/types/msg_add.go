@@ -11,6 +11,8 @@ type MsgAddValidator struct {
 	Address sdk.AccAddress `json:"address" yaml:"address"`
 	// Validator Ethereum address
 	EthAddress string `json:"eth_address" yaml:"eth_address"`
+	// Ethereum signature over the ValidatorEthSignatureMsg proving EthAddress ownership
+	EthSignature string `json:"eth_signature" yaml:"eth_signature"`
 	// Message sender
 	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
 }
@@ -31,6 +33,9 @@ func (msg MsgAddValidator) ValidateBasic() error {
 	if err := v.Validate(); err != nil {
 		return err
 	}
+	if err := v.ValidateEthAddressOwnership(msg.EthSignature); err != nil {
+		return err
+	}
 
 	if msg.Sender.Empty() {
 		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "sender: empty")
@@ -50,10 +55,11 @@ func (msg MsgAddValidator) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgAddValidator creates a new MsgAddValidator message.
-func NewMsgAddValidator(address sdk.AccAddress, ethAddress string, sender sdk.AccAddress) MsgAddValidator {
+func NewMsgAddValidator(address sdk.AccAddress, ethAddress, ethSignature string, sender sdk.AccAddress) MsgAddValidator {
 	return MsgAddValidator{
-		Address:    address,
-		EthAddress: ethAddress,
-		Sender:     sender,
+		Address:      address,
+		EthAddress:   ethAddress,
+		EthSignature: ethSignature,
+		Sender:       sender,
 	}
 }
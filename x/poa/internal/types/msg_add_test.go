@@ -7,25 +7,66 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers"
 )
 
 func TestPOA_Msg_AddValidator_Validate(t *testing.T) {
 	t.Parallel()
 
 	sdkAddr := sdk.AccAddress("addr1")
-	ethAddr := "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b7d"
+	ethAddr, ethSignature := newEthSignature(t, sdkAddr)
 
 	// ok
 	{
 		senderAddr := sdk.AccAddress("sender1")
-		msg := NewMsgAddValidator(sdkAddr, ethAddr, senderAddr)
+		msg := NewMsgAddValidator(sdkAddr, ethAddr, ethSignature, senderAddr)
 		require.NoError(t, msg.ValidateBasic())
 	}
 
 	// fail: empty sender
 	{
 		senderAddr := sdk.AccAddress("")
-		msg := NewMsgAddValidator(sdkAddr, ethAddr, senderAddr)
+		msg := NewMsgAddValidator(sdkAddr, ethAddr, ethSignature, senderAddr)
+		require.Error(t, msg.ValidateBasic())
+	}
+
+	// fail: empty ethSignature
+	{
+		senderAddr := sdk.AccAddress("sender1")
+		msg := NewMsgAddValidator(sdkAddr, ethAddr, "", senderAddr)
 		require.Error(t, msg.ValidateBasic())
 	}
+
+	// fail: EIP-55 checksum mismatch
+	{
+		senderAddr := sdk.AccAddress("sender1")
+		// flip a single letter case to break the checksum
+		invalidEthAddr := []byte(ethAddr)
+		for i := 2; i < len(invalidEthAddr); i++ {
+			if c := invalidEthAddr[i]; c >= 'a' && c <= 'f' {
+				invalidEthAddr[i] = c - 'a' + 'A'
+				break
+			} else if c >= 'A' && c <= 'F' {
+				invalidEthAddr[i] = c - 'A' + 'a'
+				break
+			}
+		}
+
+		msg := NewMsgAddValidator(sdkAddr, string(invalidEthAddr), ethSignature, senderAddr)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+		require.True(t, ErrWrongEthereumAddress.Is(err))
+	}
+}
+
+// newEthSignature generates a new Ethereum key and signs the validator ownership message with it.
+func newEthSignature(t *testing.T, sdkAddr sdk.AccAddress) (ethAddress, ethSignature string) {
+	privKey, err := helpers.NewEthereumPrivateKey()
+	require.NoError(t, err)
+
+	ethSignature, ethAddress, err = helpers.SignEthereumMessage(privKey, ValidatorEthSignatureMsg(testChainID, sdkAddr))
+	require.NoError(t, err)
+
+	return
 }
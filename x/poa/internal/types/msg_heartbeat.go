@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Client message to report PoA validator liveness.
+type MsgHeartbeat struct {
+	// Validator SDK address
+	Validator sdk.AccAddress `json:"validator" yaml:"validator"`
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgHeartbeat) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgHeartbeat) Type() string {
+	return "heartbeat"
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgHeartbeat) ValidateBasic() error {
+	if msg.Validator.Empty() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "validator: empty")
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgHeartbeat) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgHeartbeat) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Validator}
+}
+
+// NewMsgHeartbeat creates a new MsgHeartbeat message.
+func NewMsgHeartbeat(validator sdk.AccAddress) MsgHeartbeat {
+	return MsgHeartbeat{
+		Validator: validator,
+	}
+}
@@ -0,0 +1,27 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPOA_Msg_Heartbeat_Validate(t *testing.T) {
+	t.Parallel()
+
+	// ok
+	{
+		msg := NewMsgHeartbeat(sdk.AccAddress("addr1"))
+		require.NoError(t, msg.ValidateBasic())
+		require.Len(t, msg.GetSigners(), 1)
+	}
+
+	// fail: empty validator
+	{
+		msg := NewMsgHeartbeat(sdk.AccAddress(""))
+		require.Error(t, msg.ValidateBasic())
+	}
+}
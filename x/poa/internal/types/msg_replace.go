@@ -13,6 +13,8 @@ type MsgReplaceValidator struct {
 	NewValidator sdk.AccAddress `json:"new_validator" yaml:"new_validator"`
 	// New validator Ethereum address
 	EthAddress string `json:"eth_address" yaml:"eth_address"`
+	// Ethereum signature over the new validator ValidatorEthSignatureMsg proving EthAddress ownership
+	EthSignature string `json:"eth_signature" yaml:"eth_signature"`
 	// Message sender
 	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
 }
@@ -37,6 +39,9 @@ func (msg MsgReplaceValidator) ValidateBasic() error {
 	if err := v.Validate(); err != nil {
 		return sdkErrors.Wrap(err, "newValidator")
 	}
+	if err := v.ValidateEthAddressOwnership(msg.EthSignature); err != nil {
+		return sdkErrors.Wrap(err, "newValidator")
+	}
 
 	if msg.OldValidator.Equals(msg.NewValidator) {
 		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "oldValidator / newValidator: equal")
@@ -60,11 +65,12 @@ func (msg MsgReplaceValidator) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgReplaceValidator creates a new MsgReplaceValidator message.
-func NewMsgReplaceValidator(oldValidator sdk.AccAddress, newValidator sdk.AccAddress, ethAddress string, sender sdk.AccAddress) MsgReplaceValidator {
+func NewMsgReplaceValidator(oldValidator sdk.AccAddress, newValidator sdk.AccAddress, ethAddress, ethSignature string, sender sdk.AccAddress) MsgReplaceValidator {
 	return MsgReplaceValidator{
 		OldValidator: oldValidator,
 		NewValidator: newValidator,
 		EthAddress:   ethAddress,
+		EthSignature: ethSignature,
 		Sender:       sender,
 	}
 }
@@ -16,9 +16,9 @@ func TestPOA_Msg_ReplaceValidator_Validate(t *testing.T) {
 	{
 		sdkAddrOld := sdk.AccAddress("addr1")
 		sdkAddrNew := sdk.AccAddress("addr2")
-		ethAddr := "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b7d"
+		ethAddr, ethSignature := newEthSignature(t, sdkAddrNew)
 		senderAddr := sdk.AccAddress("sender1")
-		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, senderAddr)
+		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, ethSignature, senderAddr)
 		require.NoError(t, msg.ValidateBasic())
 	}
 
@@ -26,9 +26,9 @@ func TestPOA_Msg_ReplaceValidator_Validate(t *testing.T) {
 	{
 		sdkAddrOld := sdk.AccAddress("")
 		sdkAddrNew := sdk.AccAddress("addr2")
-		ethAddr := "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b7d"
+		ethAddr, ethSignature := newEthSignature(t, sdkAddrNew)
 		senderAddr := sdk.AccAddress("sender1")
-		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, senderAddr)
+		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, ethSignature, senderAddr)
 		require.Error(t, msg.ValidateBasic())
 	}
 
@@ -36,9 +36,9 @@ func TestPOA_Msg_ReplaceValidator_Validate(t *testing.T) {
 	{
 		sdkAddrOld := sdk.AccAddress("addr2")
 		sdkAddrNew := sdk.AccAddress("addr2")
-		ethAddr := "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b7d"
+		ethAddr, ethSignature := newEthSignature(t, sdkAddrNew)
 		senderAddr := sdk.AccAddress("sender1")
-		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, senderAddr)
+		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, ethSignature, senderAddr)
 		require.Error(t, msg.ValidateBasic())
 	}
 
@@ -46,9 +46,9 @@ func TestPOA_Msg_ReplaceValidator_Validate(t *testing.T) {
 	{
 		sdkAddrOld := sdk.AccAddress("addr1")
 		sdkAddrNew := sdk.AccAddress("addr2")
-		ethAddr := "0x6adaF04f4E2BA9CDdE3ec143bdcF02AD830c1b7d"
+		ethAddr, ethSignature := newEthSignature(t, sdkAddrNew)
 		senderAddr := sdk.AccAddress("")
-		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, senderAddr)
+		msg := NewMsgReplaceValidator(sdkAddrOld, sdkAddrNew, ethAddr, ethSignature, senderAddr)
 		require.Error(t, msg.ValidateBasic())
 	}
 }
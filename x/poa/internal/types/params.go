@@ -10,12 +10,17 @@ import (
 const (
 	DefaultMaxValidators uint16 = 11
 	DefaultMinValidators uint16 = 1
+	// Inactive validators auto removal is disabled by default
+	DefaultHeartbeatTimeout uint32 = 0
 )
 
 // Parameter store key.
 var (
 	ParamStoreKeyMaxValidators = []byte("maxValidators")
 	ParamStoreKeyMinValidators = []byte("minValidators")
+	ParamStoreKeyVoteWeights   = []byte("voteWeights")
+	// Heartbeat timeout is changed via params change proposal
+	ParamStoreKeyHeartbeatTimeout = []byte("heartbeatTimeout")
 )
 
 // Params defines genesis params.
@@ -24,6 +29,10 @@ type Params struct {
 	MaxValidators uint16 `json:"max_validators" yaml:"max_validators"`
 	// Minimum number of validators allowed
 	MinValidators uint16 `json:"min_validators" yaml:"min_validators"`
+	// Validators multisig vote weights (validators not listed have the default weight of 1)
+	VoteWeights ValidatorWeights `json:"vote_weights" yaml:"vote_weights"`
+	// Number of blocks without a heartbeat after which validator is removed (0 - auto removal disabled) [blocks]
+	HeartbeatTimeout uint32 `json:"heartbeat_timeout" yaml:"heartbeat_timeout"`
 }
 
 // Implements subspace.ParamSet interface.
@@ -33,13 +42,25 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{Key: ParamStoreKeyMaxValidators, Value: &p.MaxValidators, ValidatorFn: nilValidator},
 		{Key: ParamStoreKeyMinValidators, Value: &p.MinValidators, ValidatorFn: nilValidator},
+		{Key: ParamStoreKeyVoteWeights, Value: &p.VoteWeights, ValidatorFn: validateVoteWeightsParam},
+		{Key: ParamStoreKeyHeartbeatTimeout, Value: &p.HeartbeatTimeout, ValidatorFn: validateHeartbeatTimeoutParam},
 	}
 }
 
 // Equal checks params equality.
 func (p *Params) Equal(p2 Params) bool {
+	if len(p.VoteWeights) != len(p2.VoteWeights) {
+		return false
+	}
+	for i := range p.VoteWeights {
+		if !p.VoteWeights[i].Address.Equals(p2.VoteWeights[i].Address) || p.VoteWeights[i].Weight != p2.VoteWeights[i].Weight {
+			return false
+		}
+	}
+
 	return p.MinValidators == p2.MinValidators &&
-		p.MaxValidators == p2.MaxValidators
+		p.MaxValidators == p2.MaxValidators &&
+		p.HeartbeatTimeout == p2.HeartbeatTimeout
 }
 
 // Validate validates params.
@@ -52,32 +73,61 @@ func (p Params) Validate() error {
 		return fmt.Errorf("maximum amount of validators should be not greater than %d", DefaultMaxValidators)
 	}
 
+	if err := p.VoteWeights.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (p Params) String() string {
 	return fmt.Sprintf("Params:\n"+
 		"  Max Validators: %d\n"+
-		"  Min Validators: %d",
+		"  Min Validators: %d\n"+
+		"  Vote Weights: %s\n"+
+		"  Heartbeat Timeout: %d",
 		p.MaxValidators,
 		p.MinValidators,
+		p.VoteWeights,
+		p.HeartbeatTimeout,
 	)
 }
 
 // NewParams creates a new module Params.
-func NewParams(maxValidators, minValidators uint16) Params {
+func NewParams(maxValidators, minValidators uint16, voteWeights ValidatorWeights, heartbeatTimeout uint32) Params {
 	return Params{
-		MaxValidators: maxValidators,
-		MinValidators: minValidators,
+		MaxValidators:    maxValidators,
+		MinValidators:    minValidators,
+		VoteWeights:      voteWeights,
+		HeartbeatTimeout: heartbeatTimeout,
 	}
 }
 
 // DefaultParams returns default module params.
 func DefaultParams() Params {
-	return NewParams(DefaultMaxValidators, DefaultMinValidators)
+	return NewParams(DefaultMaxValidators, DefaultMinValidators, ValidatorWeights{}, DefaultHeartbeatTimeout)
 }
 
 // ParamKeyTable returns Key declaration for parameters storage.
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
 }
+
+// validateVoteWeightsParam validates VoteWeights param (used on param change proposals).
+func validateVoteWeightsParam(value interface{}) error {
+	weights, ok := value.(ValidatorWeights)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	return weights.Validate()
+}
+
+// validateHeartbeatTimeoutParam validates HeartbeatTimeout param (used on param change proposals).
+func validateHeartbeatTimeoutParam(value interface{}) error {
+	if _, ok := value.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+
+	return nil
+}
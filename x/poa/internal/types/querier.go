@@ -10,6 +10,7 @@ const (
 	QueryValidators = "validators"
 	QueryValidator  = "validator"
 	QueryMinMax     = "minmax"
+	QueryInactive   = "inactive"
 )
 
 // Client request for validator by address.
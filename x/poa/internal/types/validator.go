@@ -34,6 +34,42 @@ func (v Validator) Validate() error {
 	return nil
 }
 
+// ValidateEthAddressOwnership checks EthAddress EIP-55 checksum and that ownership signature is set.
+// Stateless part of the VerifyEthAddressOwnership check (chain ID is not available).
+// Contract: validator passed Validate check.
+func (v Validator) ValidateEthAddressOwnership(signature string) error {
+	if !helpers.IsEthereumAddressChecksumValid(v.EthAddress) {
+		return sdkErrors.Wrapf(ErrWrongEthereumAddress, "ethAddress: EIP-55 checksum mismatch %s for %s", v.EthAddress, v.Address.String())
+	}
+
+	if len(signature) == 0 {
+		return sdkErrors.Wrap(ErrWrongEthereumSignature, "ethSignature: empty")
+	}
+
+	return nil
+}
+
+// VerifyEthAddressOwnership checks EthAddress EIP-55 checksum and that signature over the ValidatorEthSignatureMsg
+// is made by the EthAddress owner.
+// Signature is an Ethereum "personal_sign" signature (hex string of [R || S || V] bytes).
+// Contract: validator passed Validate check.
+func (v Validator) VerifyEthAddressOwnership(chainID, signature string) error {
+	if err := v.ValidateEthAddressOwnership(signature); err != nil {
+		return err
+	}
+
+	signerAddress, err := helpers.RecoverEthereumAddress(ValidatorEthSignatureMsg(chainID, v.Address), signature)
+	if err != nil {
+		return sdkErrors.Wrapf(ErrWrongEthereumSignature, "ethSignature: %v", err)
+	}
+
+	if !strings.EqualFold(signerAddress, v.EthAddress) {
+		return sdkErrors.Wrapf(ErrWrongEthereumSignature, "ethSignature: signed by %s, not by %s", signerAddress, v.EthAddress)
+	}
+
+	return nil
+}
+
 func (v Validator) String() string {
 	return fmt.Sprintf("Validator:\n"+
 		"Address: %s\n"+
@@ -43,6 +79,12 @@ func (v Validator) String() string {
 	)
 }
 
+// ValidatorEthSignatureMsg returns a message signed by the validator Ethereum key.
+// Message is domain separated by the purpose prefix and the chain ID: "{prefix}{chainID}:{validator SDK address Bech32}".
+func ValidatorEthSignatureMsg(chainID string, address sdk.AccAddress) []byte {
+	return []byte(ValidatorEthSignatureMsgPrefix + chainID + ":" + address.String())
+}
+
 // NewValidator creates a new Validator.
 func NewValidator(address sdk.AccAddress, ethAddress string) Validator {
 	return Validator{
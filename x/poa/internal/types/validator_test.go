@@ -7,8 +7,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers"
 )
 
+const testChainID = "test-chain"
+
 func TestPoa_Validator_Validate(t *testing.T) {
 	t.Parallel()
 
@@ -44,3 +48,58 @@ func TestPoa_Validator_Validate(t *testing.T) {
 		require.Error(t, v.Validate())
 	}
 }
+
+func TestPoa_Validator_VerifyEthAddressOwnership(t *testing.T) {
+	t.Parallel()
+
+	sdkAddr := sdk.AccAddress("addr1")
+	ethAddr, ethSignature := newEthSignature(t, sdkAddr)
+	v := NewValidator(sdkAddr, ethAddr)
+
+	// ok
+	{
+		require.NoError(t, v.VerifyEthAddressOwnership(testChainID, ethSignature))
+	}
+
+	// fail: empty ethSignature
+	{
+		err := v.VerifyEthAddressOwnership(testChainID, "")
+		require.Error(t, err)
+		require.True(t, ErrWrongEthereumSignature.Is(err))
+	}
+
+	// fail: ethSignature for other chain ID
+	{
+		err := v.VerifyEthAddressOwnership("other-chain", ethSignature)
+		require.Error(t, err)
+		require.True(t, ErrWrongEthereumSignature.Is(err))
+	}
+
+	// fail: ethSignature for other SDK address
+	{
+		err := NewValidator(sdk.AccAddress("addr2"), ethAddr).VerifyEthAddressOwnership(testChainID, ethSignature)
+		require.Error(t, err)
+		require.True(t, ErrWrongEthereumSignature.Is(err))
+	}
+
+	// fail: ethSignature by other Ethereum key
+	{
+		_, otherEthSignature := newEthSignature(t, sdkAddr)
+		err := v.VerifyEthAddressOwnership(testChainID, otherEthSignature)
+		require.Error(t, err)
+		require.True(t, ErrWrongEthereumSignature.Is(err))
+	}
+
+	// fail: ethSignature over the SDK address only (no domain separation)
+	{
+		privKey, err := helpers.NewEthereumPrivateKey()
+		require.NoError(t, err)
+
+		rawSignature, rawEthAddr, err := helpers.SignEthereumMessage(privKey, []byte(sdkAddr.String()))
+		require.NoError(t, err)
+
+		err = NewValidator(sdkAddr, rawEthAddr).VerifyEthAddressOwnership(testChainID, rawSignature)
+		require.Error(t, err)
+		require.True(t, ErrWrongEthereumSignature.Is(err))
+	}
+}
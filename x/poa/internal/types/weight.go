@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Vote weight used for validators without an explicit weight.
+const DefaultValidatorWeight uint32 = 1
+
+// ValidatorWeight is a PoA validator multisig vote weight.
+type ValidatorWeight struct {
+	// Validator SDK address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m"`
+	// Vote weight
+	Weight uint32 `json:"weight" yaml:"weight" example:"2"`
+}
+
+func (w ValidatorWeight) String() string {
+	return fmt.Sprintf("%s: %d", w.Address, w.Weight)
+}
+
+// Slice of ValidatorWeight objects.
+type ValidatorWeights []ValidatorWeight
+
+// Validate checks ValidatorWeights.
+func (list ValidatorWeights) Validate() error {
+	addressSet := make(map[string]bool, len(list))
+	for i, w := range list {
+		if w.Address.Empty() {
+			return fmt.Errorf("vote_weights[%d]: address: empty", i)
+		}
+		if w.Weight == 0 {
+			return fmt.Errorf("vote_weights[%d]: weight: must be GT 0", i)
+		}
+
+		if addressSet[w.Address.String()] {
+			return fmt.Errorf("vote_weights[%d]: address %s: duplicated", i, w.Address)
+		}
+		addressSet[w.Address.String()] = true
+	}
+
+	return nil
+}
+
+// Get returns vote weight for the address (DefaultValidatorWeight if not set).
+func (list ValidatorWeights) Get(address sdk.AccAddress) uint32 {
+	for _, w := range list {
+		if w.Address.Equals(address) {
+			return w.Weight
+		}
+	}
+
+	return DefaultValidatorWeight
+}
+
+func (list ValidatorWeights) String() string {
+	strs := make([]string, 0, len(list))
+	for _, w := range list {
+		strs = append(strs, w.String())
+	}
+
+	return "[" + strings.Join(strs, ", ") + "]"
+}
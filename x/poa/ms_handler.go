@@ -26,7 +26,12 @@ func NewMsHandler(k keeper.Keeper) msmodule.MsHandler {
 }
 
 // handleMsMsgAddValidator hanldes MsgAddValidator multisig message.
+// Ethereum address ownership signature is verified here as it depends on the chain ID.
 func handleMsMsgAddValidator(ctx sdk.Context, k keeper.Keeper, msg MsgAddValidator) error {
+	if err := NewValidator(msg.Address, msg.EthAddress).VerifyEthAddressOwnership(ctx.ChainID(), msg.EthSignature); err != nil {
+		return err
+	}
+
 	if err := k.AddValidator(ctx, msg.Address, msg.EthAddress); err != nil {
 		return err
 	}
@@ -49,7 +54,12 @@ func handleMsMsgRemoveValidator(ctx sdk.Context, k keeper.Keeper, msg MsgRemoveV
 }
 
 // handleMsMsgReplaceValidator hanldes MsgReplaceValidator multisig message.
+// Ethereum address ownership signature is verified here as it depends on the chain ID.
 func handleMsMsgReplaceValidator(ctx sdk.Context, k keeper.Keeper, msg MsgReplaceValidator) error {
+	if err := NewValidator(msg.NewValidator, msg.EthAddress).VerifyEthAddressOwnership(ctx.ChainID(), msg.EthSignature); err != nil {
+		return sdkErrors.Wrap(err, "newValidator")
+	}
+
 	if err := k.ReplaceValidator(ctx, msg.OldValidator, msg.NewValidator, msg.EthAddress); err != nil {
 		return err
 	}
@@ -43,7 +43,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper, _ abci.RequestBeginBlock) {
 // handleStdlibUpdateProposalExecution requests DVM to update stdlib.
 func handleStdlibUpdateProposalExecution(ctx sdk.Context, k Keeper, proposal StdlibUpdateProposal) error {
 	msg, _ := getStdlibUpdateMsg(proposal)
-	if err := k.DeployContract(ctx, msg); err != nil {
+	if err := k.DeployContractByGov(ctx, msg); err != nil {
 		return err
 	}
 
@@ -11,18 +11,37 @@ type (
 	GenesisState = types.GenesisState
 	//
 	ScriptArg        = types.ScriptArg
+	StructTypeTag    = types.StructTypeTag
+	TypeTag          = types.TypeTag
 	MsgDeployModule  = types.MsgDeployModule
 	MsgExecuteScript = types.MsgExecuteScript
 	//
-	QueryAccessPath = types.ValueReq
-	QueryValueResp  = types.ValueResp
+	MsgExecuteScriptMultiSigner = types.MsgExecuteScriptMultiSigner
+	MsgRegisterEventLayouts     = types.MsgRegisterEventLayouts
+	EventLayout                 = types.EventLayout
+	EventLayouts                = types.EventLayouts
+	ViewerRequest               = types.ViewerRequest
+	ViewerItem                  = types.ViewerItem
+	//
+	QueryAccessPath         = types.ValueReq
+	QueryValueResp          = types.ValueResp
+	QueryResourcesReq       = types.ResourcesReq
+	QueryResourceHoldersReq = types.ResourceHoldersReq
+	Resource                = types.Resource
+	Resources               = types.Resources
+	QueryModulesReq         = types.ModulesReq
+	QueryModuleReq          = types.ModuleReq
+	ModuleInfo              = types.ModuleInfo
+	ModuleInfos             = types.ModuleInfos
+	ModuleUpgradePolicy     = types.ModuleUpgradePolicy
 	//
 	CurrentTimestamp = middlewares.CurrentTimestamp
 	BlockHeader      = middlewares.BlockHeader
 	//
-	PlannedProposal      = types.PlannedProposal
-	TestProposal         = types.TestProposal
-	StdlibUpdateProposal = types.StdlibUpdateProposal
+	PlannedProposal              = types.PlannedProposal
+	TestProposal                 = types.TestProposal
+	StdlibUpdateProposal         = types.StdlibUpdateProposal
+	RegisterEventLayoutsProposal = types.RegisterEventLayoutsProposal
 	//
 	Contract = types.Contract
 )
@@ -36,6 +55,8 @@ const (
 	// Event types, attribute types and values
 	EventTypeContractStatus = types.EventTypeContractStatus
 	EventTypeMoveEvent      = types.EventTypeMoveEvent
+	EventTypeEventLayout    = types.EventTypeEventLayout
+	EventTypeModulePublish  = types.EventTypeModulePublish
 	//
 	AttributeStatus      = types.AttributeStatus
 	AttributeMajorStatus = types.AttributeErrMajorStatus
@@ -45,11 +66,17 @@ const (
 	AttributeSender      = types.AttributeVmEventSender
 	AttributeSource      = types.AttributeVmEventSource
 	AttributeData        = types.AttributeVmEventData
+	AttributeDataDecoded = types.AttributeVmEventDataDecoded
 	//
 	AttributeValueStatusDiscard = types.AttributeValueStatusDiscard
 	AttributeValueStatusKeep    = types.AttributeValueStatusKeep
 	AttributeValueStatusError   = types.AttributeValueStatusError
 	AttributeValueSourceScript  = types.AttributeValueSourceScript
+	//
+	// Module upgrade policies
+	ModuleUpgradePolicyImmutable        = types.ModuleUpgradePolicyImmutable
+	ModuleUpgradePolicyCompatible       = types.ModuleUpgradePolicyCompatible
+	ModuleUpgradePolicyOwnerUpgradeable = types.ModuleUpgradePolicyOwnerUpgradeable
 )
 
 var (
@@ -57,13 +84,27 @@ var (
 	ModuleCdc            = types.ModuleCdc
 	AvailablePermissions = types.AvailablePermissions
 	// function aliases
-	RegisterCodec       = types.RegisterCodec
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	DefaultGenesisState = types.DefaultGenesisState
-	NewMsgDeployModule  = types.NewMsgDeployModule
+	RegisterCodec                   = types.RegisterCodec
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	DefaultGenesisState             = types.DefaultGenesisState
+	NewMsgDeployModule              = types.NewMsgDeployModule
+	NewMsgExecuteScript             = types.NewMsgExecuteScript
+	NewMsgExecuteScriptMultiSigner  = types.NewMsgExecuteScriptMultiSigner
+	NewMsgRegisterEventLayouts      = types.NewMsgRegisterEventLayouts
+	NewRegisterEventLayoutsProposal = types.NewRegisterEventLayoutsProposal
+	NewEventLayout                  = types.NewEventLayout
+	ParseStructTypeTag              = types.ParseStructTypeTag
+	ParseTypeTag                    = types.ParseTypeTag
+	ParseModuleMeta                 = types.ParseModuleMeta
 	// error aliases
-	ErrInternal           = types.ErrInternal
-	ErrVMCrashed          = types.ErrVMCrashed
-	ErrGovInvalidProposal = types.ErrGovInvalidProposal
+	ErrInternal            = types.ErrInternal
+	ErrVMCrashed           = types.ErrVMCrashed
+	ErrWrongTypeArg        = types.ErrWrongTypeArg
+	ErrWrongEventLayout    = types.ErrWrongEventLayout
+	ErrWrongStructTag      = types.ErrWrongStructTag
+	ErrWrongUpgradePolicy  = types.ErrWrongUpgradePolicy
+	ErrModuleUpgradeDenied = types.ErrModuleUpgradeDenied
+	ErrWrongModuleCode     = types.ErrWrongModuleCode
+	ErrGovInvalidProposal  = types.ErrGovInvalidProposal
 )
@@ -21,6 +21,14 @@ import (
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
+const (
+	flagEventsType       = "type"
+	flagEventsSender     = "sender"
+	flagEventsFromHeight = "from-height"
+	flagEventsToHeight   = "to-height"
+	flagModulesAddress   = "address"
+)
+
 // GetData returns query command that returns writeSet for VM accessPath.
 func GetData(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -196,6 +204,277 @@ func Compile(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetResources returns query command that lists account resources.
+func GetResources(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resources [address]",
+		Short:   "Get account resources (data is decoded if struct layout is registered)",
+		Example: "resources wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 --page=1 --limit=10",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			address, err := types.ParseVMAddress(args[0])
+			if err != nil {
+				return helpers.BuildError("address", args[0], helpers.ParamTypeCliArg, err.Error())
+			}
+
+			page, limit, err := helpers.ParsePaginationParams(viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.ResourcesReq{
+				Address: address,
+				Page:    page,
+				Limit:   limit,
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryResources), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Resources
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"VM address (0x1 / Bech32 / HEX string)",
+	})
+	helpers.AddPaginationCmdFlags(cmd)
+
+	return cmd
+}
+
+// GetResourceHolders returns query command that lists struct type resources of all accounts.
+func GetResourceHolders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resource-holders [structType]",
+		Short:   "Get resources of the struct type for all accounts (data is decoded if struct layout is registered)",
+		Example: "resource-holders '0x1::Account::Balance<0x1::XFI::T>' --page=1 --limit=10",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			structTag, err := types.ParseStructTypeTag(args[0])
+			if err != nil {
+				return helpers.BuildError("structType", args[0], helpers.ParamTypeCliArg, err.Error())
+			}
+
+			page, limit, err := helpers.ParsePaginationParams(viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			// prepare request
+			req := types.ResourceHoldersReq{
+				StructType: structTag.String(),
+				Page:       page,
+				Limit:      limit,
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryResourceHolders), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Resources
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"Move struct type (0x1::Account::Balance<0x1::XFI::T>)",
+	})
+	helpers.AddPaginationCmdFlags(cmd)
+
+	return cmd
+}
+
+// GetModules returns query command that lists published modules.
+func GetModules(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modules",
+		Short:   "Get published Move modules registry entries (optionally filtered by address)",
+		Example: "modules --address=wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 --page=1 --limit=10",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			req := types.ModulesReq{}
+			if addressStr := viper.GetString(flagModulesAddress); addressStr != "" {
+				address, err := types.ParseVMAddress(addressStr)
+				if err != nil {
+					return helpers.BuildError(flagModulesAddress, addressStr, helpers.ParamTypeCliFlag, err.Error())
+				}
+				req.Address = address
+			}
+
+			page, limit, err := helpers.ParsePaginationParams(viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+			req.Page, req.Limit = page, limit
+
+			// prepare request
+			bz, err := cliCtx.Codec.MarshalJSON(req)
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryModules), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ModuleInfos
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().String(flagModulesAddress, "", "(optional) filter modules by VM address (0x1 / Bech32 / HEX string)")
+	helpers.AddPaginationCmdFlags(cmd)
+
+	return cmd
+}
+
+// GetModule returns query command that returns published module registry entry.
+func GetModule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module [address] [name]",
+		Short:   "Get published Move module registry entry (code hash, publisher, upgrade policy)",
+		Example: "module 0x1 XFI",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			address, err := types.ParseVMAddress(args[0])
+			if err != nil {
+				return helpers.BuildError("address", args[0], helpers.ParamTypeCliArg, err.Error())
+			}
+
+			// prepare request
+			bz, err := cliCtx.Codec.MarshalJSON(types.ModuleReq{
+				Address: address,
+				Name:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryModule), bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.ModuleInfo
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"VM address (0x1 / Bech32 / HEX string)",
+		"module name",
+	})
+
+	return cmd
+}
+
+// GetEventLayouts returns query command that lists all registered Move event layouts.
+func GetEventLayouts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "event-layouts",
+		Short:   "Get registered Move event layouts (used to decode events data)",
+		Example: "event-layouts",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// query and parse the result
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEventLayouts), nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.EventLayouts
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	return cmd
+}
+
+// SearchEvents returns query command that searches past Move events by type / sender over a height range.
+func SearchEvents(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "events",
+		Short:   "Search past Move events by event type and / or sender (tx search is used, pagination is applied to txs)",
+		Example: "events --type=0x1::Account::SentPaymentEvent --sender=wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 --from-height=100 --to-height=200 --page=1 --limit=10",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse inputs
+			page, limit, err := helpers.ParsePaginationParams(viper.GetString(flags.FlagPage), viper.GetString(flags.FlagLimit), helpers.ParamTypeCliFlag)
+			if err != nil {
+				return err
+			}
+
+			filter, err := types.NewMoveEventsFilter(
+				viper.GetString(flagEventsType),
+				viper.GetString(flagEventsSender),
+				viper.GetInt64(flagEventsFromHeight),
+				viper.GetInt64(flagEventsToHeight),
+			)
+			if err != nil {
+				return fmt.Errorf("filter flags: %w", err)
+			}
+
+			// search
+			events, err := vm_client.SearchMoveEvents(cliCtx, filter, int(page.Uint64()), int(limit.Uint64()))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(events)
+		},
+	}
+	helpers.AddPaginationCmdFlags(cmd)
+	cmd.Flags().String(flagEventsType, "", "(optional) Move event type (exact match)")
+	cmd.Flags().String(flagEventsSender, "", "(optional) Move event sender address (0x1 / Bech32)")
+	cmd.Flags().Int64(flagEventsFromHeight, 0, "(optional) search range start block height (inclusive)")
+	cmd.Flags().Int64(flagEventsToHeight, 0, "(optional) search range end block height (inclusive)")
+
+	return cmd
+}
+
 // GetTxVMStatus returns query command that returns transaction VM status.
 func GetTxVMStatus(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
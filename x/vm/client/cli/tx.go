@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/gov"
@@ -15,18 +16,24 @@ import (
 	codec "github.com/tendermint/go-amino"
 
 	"github.com/dfinance/dnode/helpers"
+	"github.com/dfinance/dnode/x/common_vm"
 	"github.com/dfinance/dnode/x/vm/client/vm_client"
 	"github.com/dfinance/dnode/x/vm/internal/types"
 )
 
-const argName = "moveFile"
+const (
+	argName           = "moveFile"
+	flagTypeArgs      = "type-arg"
+	flagFromModule    = "from-module"
+	flagUpgradePolicy = "upgrade-policy"
+)
 
 // ExecuteScript returns tx command which executed VM script.
 func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "execute [moveFile] [arg1,arg2,arg3,..]",
 		Short:   "Execute Move script",
-		Example: "execute ./script.move.json wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 100 true \"my string\" \"68656c6c6f2c20776f726c6421\" #\"XFI_ETH\" --from my_account --fees 10000xfi --gas 500000",
+		Example: "execute ./script.move.json wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8 100 true \"my string\" \"68656c6c6f2c20776f726c6421\" #\"XFI_ETH\" --type-arg 0x1::XFI::T --type-arg 0x1::Coins::ETH --from my_account --fees 10000xfi --gas 500000",
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
@@ -43,22 +50,68 @@ func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			strArgs := args[1:]
-			typedArgs, err := vm_client.ExtractArguments(compilerAddr, code[0].ByteCode)
+			_, typeArgs, scriptArgs, err := parseScriptInputs(cmd, compilerAddr, code[0].ByteCode, args[1:])
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			msg := types.NewMsgExecuteScript(fromAddr, code[0].ByteCode, typeArgs, scriptArgs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"path to compiled Mode file containing bytecode",
+		"space separated VM script arguments (optional)",
+	})
+	cmd.Flags().StringArray(flagTypeArgs, nil, "(optional) script generic type argument in the {address}::{module}::{name} form (repeat the flag for multiple type arguments)")
+
+	return cmd
+}
+
+// ExecuteMultiSignerScript returns tx command which executes VM script with multiple signers.
+func ExecuteMultiSignerScript(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execute-multi-signer [moveFile] [signer1,signer2,..] [arg1,arg2,arg3,..]",
+		Short:   "Execute Move script with multiple signers (offline tx generation for multiple parties)",
+		Example: "execute-multi-signer ./swap.move.json wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8,wallet1a7280dyzp487r7wghr99f6r3h2h2z4gk4d740m 100 --from my_account --fees 10000xfi --gas 500000 --generate-only > unsigned.json",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+			compilerAddr := viper.GetString(vm_client.FlagCompilerAddr)
+
+			// parse inputs
+			code, err := getMoveCodeFromFileArg(args[0], true)
+			if err != nil {
+				return err
+			}
+
+			signers, err := helpers.ParseSdkAddressesParams("signers", args[1], helpers.ParamTypeCliArg)
 			if err != nil {
-				return fmt.Errorf("extracting typed args from the code: %w", err)
+				return err
 			}
 
-			scriptArgs, err := vm_client.ConvertStringScriptArguments(strArgs, typedArgs)
+			signersCount, typeArgs, scriptArgs, err := parseScriptInputs(cmd, compilerAddr, code[0].ByteCode, args[2:])
 			if err != nil {
-				return fmt.Errorf("converting input args to typed args: %w", err)
+				return err
 			}
-			if len(scriptArgs) == 0 {
-				scriptArgs = nil
+			if int(signersCount) != len(signers) {
+				return fmt.Errorf("signers / script signers count mismatch: %d / %d", len(signers), signersCount)
+			}
+
+			// tx can't be broadcasted directly as it requires all signers signatures
+			if len(signers) > 1 && !cliCtx.GenerateOnly {
+				return fmt.Errorf("multiple signers tx requires the %s flag: collect signatures with the \"tx sign\" command (in the signers order) and broadcast the result", flags.FlagGenerateOnly)
 			}
 
 			// prepare and send message
-			msg := types.NewMsgExecuteScript(fromAddr, code[0].ByteCode, scriptArgs)
+			msg := types.NewMsgExecuteScriptMultiSigner(signers, code[0].ByteCode, typeArgs, scriptArgs)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -70,8 +123,10 @@ func ExecuteScript(cdc *codec.Codec) *cobra.Command {
 	}
 	helpers.BuildCmdHelp(cmd, []string{
 		"path to compiled Mode file containing bytecode",
+		"comma separated script signers addresses (order defines &signer arguments order, first signer pays the fees)",
 		"space separated VM script arguments (optional)",
 	})
+	cmd.Flags().StringArray(flagTypeArgs, nil, "(optional) script generic type argument in the {address}::{module}::{name} form (repeat the flag for multiple type arguments)")
 
 	return cmd
 }
@@ -98,7 +153,7 @@ func DeployContract(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// prepare and send message
-			msg := types.NewMsgDeployModule(fromAddr, getContractsFromCompiledItems(code))
+			msg := types.NewMsgDeployModule(fromAddr, getContractsFromCompiledItems(code), types.ModuleUpgradePolicy(viper.GetString(flagUpgradePolicy)))
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -111,6 +166,96 @@ func DeployContract(cdc *codec.Codec) *cobra.Command {
 	helpers.BuildCmdHelp(cmd, []string{
 		"path to compiled Mode file containing bytecode",
 	})
+	cmd.Flags().String(flagUpgradePolicy, "", fmt.Sprintf("(optional) module upgrade policy [%s, %s, %s] (current policy is kept if not set, %q is used on the first deploy)", types.ModuleUpgradePolicyImmutable, types.ModuleUpgradePolicyCompatible, types.ModuleUpgradePolicyOwnerUpgradeable, types.DefaultModuleUpgradePolicy))
+
+	return cmd
+}
+
+// RegisterEventLayouts returns tx command which registers Move event layouts.
+func RegisterEventLayouts(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-event-layouts [layoutsFile]",
+		Short:   "Register Move event layouts used to decode events data (only events of modules published by the signer)",
+		Example: "register-event-layouts ./module.move.json --from-module --from my_account --fees 10000xfi",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			layouts, err := getEventLayoutsFromFileArg(args[0], fromAddr)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			msg := types.NewMsgRegisterEventLayouts(fromAddr, layouts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			cliCtx.WithOutput(os.Stdout)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"path to JSON file containing event layouts list (or compiled Move module file with the --from-module flag)",
+	})
+	cmd.Flags().Bool(flagFromModule, false, "(optional) build layouts from the compiled Move module metadata (non-generic structs)")
+
+	return cmd
+}
+
+// RegisterEventLayoutsProposal returns tx command which sends governance register stdlib Move event layouts proposal.
+func RegisterEventLayoutsProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-event-layouts-proposal [layoutsFile]",
+		Short:   "Submit a DVM stdlib (0x1) Move event layouts registration proposal",
+		Example: "register-event-layouts-proposal ./stdlib.move.json --from-module --deposit 10000xfi --from my_account --fees 10000xfi",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, txBuilder := helpers.GetTxCmdCtx(cdc, cmd.InOrStdin())
+
+			// parse inputs
+			fromAddr, err := helpers.ParseFromFlag(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := helpers.ParseDepositFlag(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			layouts, err := getEventLayoutsFromFileArg(args[0], common_vm.StdLibAddress)
+			if err != nil {
+				return err
+			}
+
+			// prepare and send message
+			content := types.NewRegisterEventLayoutsProposal(layouts)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, deposit, fromAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBuilder, []sdk.Msg{msg})
+		},
+	}
+	helpers.BuildCmdHelp(cmd, []string{
+		"path to JSON file containing stdlib event layouts list (or compiled stdlib Move module file with the --from-module flag)",
+	})
+	cmd.Flags().Bool(flagFromModule, false, "(optional) build layouts from the compiled Move module metadata (non-generic structs)")
+	cmd.Flags().String(govCli.FlagDeposit, "", "deposit of proposal")
 
 	return cmd
 }
@@ -173,6 +318,42 @@ func UpdateStdlibProposal(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// getEventLayoutsFromFileArg reads layouts JSON file or builds layouts from the compiled module file (--from-module flag).
+func getEventLayoutsFromFileArg(argValue string, moduleAddress sdk.AccAddress) (types.EventLayouts, error) {
+	var layouts types.EventLayouts
+	if viper.GetBool(flagFromModule) {
+		code, err := getMoveCodeFromFileArg(argValue, false)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range code {
+			if item.CodeType != vm_client.CodeTypeModule {
+				return nil, fmt.Errorf("%s: module code type expected: %s", item.Name, item.CodeType)
+			}
+
+			moduleLayouts, skipped := vm_client.BuildEventLayouts(moduleAddress, item.Name, item.Types)
+			for _, reason := range skipped {
+				fmt.Fprintf(os.Stderr, "%s::%s (skipped)\n", item.Name, reason)
+			}
+			layouts = append(layouts, moduleLayouts...)
+		}
+
+		return layouts, nil
+	}
+
+	jsonContent, err := helpers.ParseFilePath("layoutsFile", argValue, helpers.ParamTypeCliArg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(jsonContent, &layouts); err != nil {
+		return nil, helpers.BuildError("layoutsFile", argValue, helpers.ParamTypeCliArg, fmt.Sprintf("layouts JSON unmarshal: %v", err))
+	}
+
+	return layouts, nil
+}
+
 // getMoveCodeFromFileArg reads .move file and converts its code field.
 func getMoveCodeFromFileArg(argValue string, oneItem bool) (items vm_client.CompiledItems, retErr error) {
 	jsonContent, err := helpers.ParseFilePath(argName, argValue, helpers.ParamTypeCliArg)
@@ -237,3 +418,35 @@ func getContractsFromCompiledItems(items vm_client.CompiledItems) []types.Contra
 
 	return contracts
 }
+
+// parseScriptInputs parses script type arguments (flag) and arguments using script metadata.
+// Returns script signers count, type args and args.
+func parseScriptInputs(cmd *cobra.Command, compilerAddr string, code []byte, strArgs []string) (uint32, []types.StructTypeTag, []types.ScriptArg, error) {
+	strTypeArgs, err := cmd.Flags().GetStringArray(flagTypeArgs)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("%s flag: %w", flagTypeArgs, err)
+	}
+
+	meta, err := vm_client.ExtractScriptMeta(compilerAddr, code)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("extracting typed args from the code: %w", err)
+	}
+
+	typeArgs, err := vm_client.ConvertStringScriptTypeArguments(strTypeArgs, meta.TypeParameters)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("converting input type args to struct type tags: %w", err)
+	}
+	if len(typeArgs) == 0 {
+		typeArgs = nil
+	}
+
+	scriptArgs, err := vm_client.ConvertStringScriptArguments(strArgs, meta.Arguments)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("converting input args to typed args: %w", err)
+	}
+	if len(scriptArgs) == 0 {
+		scriptArgs = nil
+	}
+
+	return meta.SignersCount, typeArgs, scriptArgs, nil
+}
@@ -30,6 +30,12 @@ func GetQueryCmd(cdc *amino.Codec) *cobra.Command {
 		cli.GetData(types.ModuleName, cdc),
 		cli.GetLcsView(types.ModuleName, cdc),
 		cli.GetTxVMStatus(cdc),
+		cli.GetResources(types.ModuleName, cdc),
+		cli.GetResourceHolders(types.ModuleName, cdc),
+		cli.GetModules(types.ModuleName, cdc),
+		cli.GetModule(types.ModuleName, cdc),
+		cli.GetEventLayouts(types.ModuleName, cdc),
+		cli.SearchEvents(cdc),
 	)
 	commands = append(commands, compileCommands...)
 
@@ -47,6 +53,7 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 
 	compileCommands := sdkClient.PostCommands(
 		cli.ExecuteScript(cdc),
+		cli.ExecuteMultiSignerScript(cdc),
 	)
 	for _, cmd := range compileCommands {
 		cmd.Flags().String(vm_client.FlagCompilerAddr, config.DefaultCompilerAddr, vm_client.FlagCompilerUsage)
@@ -55,8 +62,10 @@ func GetTxCmd(cdc *amino.Codec) *cobra.Command {
 
 	commands := sdkClient.PostCommands(
 		cli.DeployContract(cdc),
+		cli.RegisterEventLayouts(cdc),
 		sdkClient.LineBreak,
 		cli.UpdateStdlibProposal(cdc),
+		cli.RegisterEventLayoutsProposal(cdc),
 	)
 	commands = append(commands, compileCommands...)
 
@@ -25,6 +25,14 @@ const (
 	accountAddrName = "accountAddr"
 	vmPathName      = "vmPath"
 	txHash          = "txHash"
+	moduleNameName  = "moduleName"
+
+	structTypeName       = "struct_type"
+	eventsTypeName       = "type"
+	eventsSenderName     = "sender"
+	eventsFromHeightName = "from_height"
+	eventsToHeightName   = "to_height"
+	modulesAddressName   = "address"
 )
 
 type CompileReq struct {
@@ -40,12 +48,16 @@ type ExecuteScriptReq struct {
 	MoveCode string `json:"move_code" yaml:"move_code" format:"HEX encoded byte code"`
 	// Script arguments
 	MoveArgs []string `json:"move_args" yaml:"move_args" example:"true"`
+	// Script generic type arguments
+	MoveTypeArgs []string `json:"move_type_args" yaml:"move_type_args" example:"0x1::XFI::T"`
 }
 
 type PublishModuleReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	// Compiled Move code
 	MoveCode []string `json:"move_code" yaml:"move_code" format:"HEX encoded byte code array"`
+	// Module upgrade policy (optional, current policy is kept if empty)
+	UpgradePolicy string `json:"upgrade_policy" yaml:"upgrade_policy" example:"compatible"`
 }
 
 type LcsViewReq struct {
@@ -67,6 +79,12 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/data/{%s}/{%s}", types.ModuleName, accountAddrName, vmPathName), getData(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/view", types.ModuleName), lcsView(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/tx/{%s}", types.ModuleName, txHash), getTxVMStatus(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/resources/{%s}", types.ModuleName, accountAddrName), getResources(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/resource_holders", types.ModuleName), getResourceHolders(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/modules", types.ModuleName), getModules(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/modules/{%s}/{%s}", types.ModuleName, accountAddrName, moduleNameName), getModule(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/event_layouts", types.ModuleName), getEventLayouts(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/events", types.ModuleName), searchEvents(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/execute", types.ModuleName), executeScript(cliCtx)).Methods("PUT")
 	r.HandleFunc(fmt.Sprintf("/%s/publish", types.ModuleName), deployModule(cliCtx)).Methods("PUT")
 }
@@ -295,6 +313,319 @@ func getTxVMStatus(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// GetResources godoc
+// @Tags VM
+// @Summary Get account resources
+// @Description Get account resources with pagination (data is decoded if struct layout is registered)
+// @ID vmGetResources
+// @Accept  json
+// @Produce json
+// @Param accountAddr path string true "account address (0x1 / Libra HEX / Bech32)"
+// @Param page query uint false "page number (first page: 1)"
+// @Param limit query uint false "items per page (default: 100)"
+// @Success 200 {object} VmRespResources
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/resources/{accountAddr} [get]
+func getResources(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		address, err := types.ParseVMAddress(vars[accountAddrName])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, helpers.BuildError(accountAddrName, vars[accountAddrName], helpers.ParamTypeRestPath, err.Error()).Error())
+			return
+		}
+
+		page, limit, err := helpers.ParsePaginationParams(r.URL.Query().Get("page"), r.URL.Query().Get("limit"), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.ResourcesReq{
+			Address: address,
+			Page:    page,
+			Limit:   limit,
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryResources), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetResourceHolders godoc
+// @Tags VM
+// @Summary Get struct type resource holders
+// @Description Get resources of the struct type for all accounts with pagination (data is decoded if struct layout is registered)
+// @ID vmGetResourceHolders
+// @Accept  json
+// @Produce json
+// @Param struct_type query string true "Move struct type (0x1::Account::Balance<0x1::XFI::T>)"
+// @Param page query uint false "page number (first page: 1)"
+// @Param limit query uint false "items per page (default: 100)"
+// @Success 200 {object} VmRespResources
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/resource_holders [get]
+func getResourceHolders(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		structTypeStr := r.URL.Query().Get(structTypeName)
+		structTag, err := types.ParseStructTypeTag(structTypeStr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, helpers.BuildError(structTypeName, structTypeStr, helpers.ParamTypeRestQuery, err.Error()).Error())
+			return
+		}
+
+		page, limit, err := helpers.ParsePaginationParams(r.URL.Query().Get("page"), r.URL.Query().Get("limit"), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// prepare request
+		req := types.ResourceHoldersReq{
+			StructType: structTag.String(),
+			Page:       page,
+			Limit:      limit,
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryResourceHolders), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetModules godoc
+// @Tags VM
+// @Summary Get published modules
+// @Description Get published Move modules registry entries with pagination (optionally filtered by address)
+// @ID vmGetModules
+// @Accept  json
+// @Produce json
+// @Param address query string false "modules address filter (0x1 / Libra HEX / Bech32)"
+// @Param page query uint false "page number (first page: 1)"
+// @Param limit query uint false "items per page (default: 100)"
+// @Success 200 {object} VmRespModuleInfos
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/modules [get]
+func getModules(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		req := types.ModulesReq{}
+		if addressStr := r.URL.Query().Get(modulesAddressName); addressStr != "" {
+			address, err := types.ParseVMAddress(addressStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, helpers.BuildError(modulesAddressName, addressStr, helpers.ParamTypeRestQuery, err.Error()).Error())
+				return
+			}
+			req.Address = address
+		}
+
+		page, limit, err := helpers.ParsePaginationParams(r.URL.Query().Get("page"), r.URL.Query().Get("limit"), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		req.Page, req.Limit = page, limit
+
+		// prepare request
+		bz, err := cliCtx.Codec.MarshalJSON(req)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryModules), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetModule godoc
+// @Tags VM
+// @Summary Get published module
+// @Description Get published Move module registry entry (code hash, publisher, upgrade policy)
+// @ID vmGetModule
+// @Accept  json
+// @Produce json
+// @Param accountAddr path string true "module address (0x1 / Libra HEX / Bech32)"
+// @Param moduleName path string true "module name"
+// @Success 200 {object} VmRespModuleInfo
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/modules/{accountAddr}/{moduleName} [get]
+func getModule(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		address, err := types.ParseVMAddress(vars[accountAddrName])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, helpers.BuildError(accountAddrName, vars[accountAddrName], helpers.ParamTypeRestPath, err.Error()).Error())
+			return
+		}
+
+		// prepare request
+		bz, err := cliCtx.Codec.MarshalJSON(types.ModuleReq{
+			Address: address,
+			Name:    vars[moduleNameName],
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryModule), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// GetEventLayouts godoc
+// @Tags VM
+// @Summary Get Move event layouts
+// @Description Get registered Move event layouts used to decode events data
+// @ID vmGetEventLayouts
+// @Accept  json
+// @Produce json
+// @Success 200 {object} VmRespEventLayouts
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/event_layouts [get]
+func getEventLayouts(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// send request and process response
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryEventLayouts), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// SearchEvents godoc
+// @Tags VM
+// @Summary Search Move events
+// @Description Search past Move events by event type and / or sender over a block height range (pagination is applied to txs)
+// @ID vmSearchEvents
+// @Accept  json
+// @Produce json
+// @Param type query string false "Move event type (exact match)"
+// @Param sender query string false "Move event sender address (Libra HEX / Bech32)"
+// @Param from_height query int false "search range start block height (inclusive)"
+// @Param to_height query int false "search range end block height (inclusive)"
+// @Param page query uint false "page number (first page: 1)"
+// @Param limit query uint false "items per page (default: 100)"
+// @Success 200 {object} VmRespMoveEvents
+// @Failure 400 {object} rest.ErrorResponse "Returned if the request doesn't have valid query params"
+// @Failure 500 {object} rest.ErrorResponse "Returned on server error"
+// @Router /vm/events [get]
+func searchEvents(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// parse inputs
+		query := r.URL.Query()
+		page, limit, err := helpers.ParsePaginationParams(query.Get("page"), query.Get("limit"), helpers.ParamTypeRestQuery)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var fromHeight, toHeight int64
+		if str := query.Get(eventsFromHeightName); str != "" {
+			fromHeight, err = helpers.ParseInt64Param(eventsFromHeightName, str, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if str := query.Get(eventsToHeightName); str != "" {
+			toHeight, err = helpers.ParseInt64Param(eventsToHeightName, str, helpers.ParamTypeRestQuery)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		filter, err := types.NewMoveEventsFilter(query.Get(eventsTypeName), query.Get(eventsSenderName), fromHeight, toHeight)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// search and process response
+		events, err := vm_client.SearchMoveEvents(cliCtx, filter, int(page.Uint64()), int(limit.Uint64()))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, events)
+	}
+}
+
 // GetIssue godoc
 // @Tags VM
 // @Summary Execute Move script
@@ -335,7 +666,7 @@ func executeScript(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		typedArgs, err := vm_client.ExtractArguments(compilerAddr, code)
+		typedArgs, typeParams, err := vm_client.ExtractArguments(compilerAddr, code)
 		if err != nil {
 			retErr := helpers.BuildError(
 				"move_args",
@@ -362,8 +693,23 @@ func executeScript(cliCtx context.CLIContext) http.HandlerFunc {
 			scriptArgs = nil
 		}
 
+		typeArgs, err := vm_client.ConvertStringScriptTypeArguments(req.MoveTypeArgs, typeParams)
+		if err != nil {
+			retErr := helpers.BuildError(
+				"move_type_args",
+				strings.Join(req.MoveTypeArgs, ", "),
+				helpers.ParamTypeRestRequest,
+				fmt.Sprintf("converting input type args to struct type tags: %v", err),
+			)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, retErr.Error())
+			return
+		}
+		if len(typeArgs) == 0 {
+			typeArgs = nil
+		}
+
 		// create the message
-		msg := types.NewMsgExecuteScript(fromAddr, code, scriptArgs)
+		msg := types.NewMsgExecuteScript(fromAddr, code, typeArgs, scriptArgs)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -415,7 +761,7 @@ func deployModule(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// create the message
-		msg := types.NewMsgDeployModule(fromAddr, contracts)
+		msg := types.NewMsgDeployModule(fromAddr, contracts, types.ModuleUpgradePolicy(req.UpgradePolicy))
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
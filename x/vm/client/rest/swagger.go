@@ -33,4 +33,29 @@ type (
 		Height int64       `json:"height"`
 		Result LcsViewResp `json:"result"`
 	}
+
+	VmRespResources struct {
+		Height int64           `json:"height"`
+		Result types.Resources `json:"result"`
+	}
+
+	VmRespModuleInfos struct {
+		Height int64             `json:"height"`
+		Result types.ModuleInfos `json:"result"`
+	}
+
+	VmRespModuleInfo struct {
+		Height int64            `json:"height"`
+		Result types.ModuleInfo `json:"result"`
+	}
+
+	VmRespEventLayouts struct {
+		Height int64              `json:"height"`
+		Result types.EventLayouts `json:"result"`
+	}
+
+	VmRespMoveEvents struct {
+		Height int64              `json:"height"`
+		Result types.TxMoveEvents `json:"result"`
+	}
 )
@@ -217,3 +217,21 @@ func ConvertStringScriptArguments(argStrs []string, argTypes []types_grpc.VMType
 
 	return scriptArgs, nil
 }
+
+// ConvertStringScriptTypeArguments convert string client type arguments to StructTypeTags using compiler meta data (script type parameters).
+func ConvertStringScriptTypeArguments(typeArgStrs []string, typeParams []string) ([]types.StructTypeTag, error) {
+	if len(typeArgStrs) != len(typeParams) {
+		return nil, fmt.Errorf("strTypeArgs / scriptTypeParams length mismatch: %d / %d", len(typeArgStrs), len(typeParams))
+	}
+
+	typeArgs := make([]types.StructTypeTag, len(typeArgStrs))
+	for argIdx, argStr := range typeArgStrs {
+		typeArg, err := types.ParseStructTypeTag(argStr)
+		if err != nil {
+			return nil, fmt.Errorf("type argument[%d] (%s): %w", argIdx, typeParams[argIdx], err)
+		}
+		typeArgs[argIdx] = typeArg
+	}
+
+	return typeArgs, nil
+}
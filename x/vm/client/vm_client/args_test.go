@@ -216,3 +216,34 @@ func Test_NewVectorScriptArg(t *testing.T) {
 		require.Error(t, err)
 	}
 }
+
+func Test_ConvertStringScriptTypeArguments(t *testing.T) {
+	// ok
+	{
+		typeArgs, err := ConvertStringScriptTypeArguments([]string{"0x1::Coins::ETH", "0x1::XFI::T"}, []string{"Coin1", "Coin2"})
+		require.NoError(t, err)
+		require.Len(t, typeArgs, 2)
+		require.Equal(t, common_vm.StdLibAddress, common_vm.Bech32ToLibra(typeArgs[0].Address))
+		require.Equal(t, "Coins", typeArgs[0].Module)
+		require.Equal(t, "T", typeArgs[1].Name)
+	}
+
+	// ok: no type params
+	{
+		typeArgs, err := ConvertStringScriptTypeArguments(nil, nil)
+		require.NoError(t, err)
+		require.Empty(t, typeArgs)
+	}
+
+	// length mismatch
+	{
+		_, err := ConvertStringScriptTypeArguments([]string{"0x1::Coins::ETH"}, nil)
+		require.Error(t, err)
+	}
+
+	// invalid: non-struct type
+	{
+		_, err := ConvertStringScriptTypeArguments([]string{"u64"}, []string{"Coin"})
+		require.Error(t, err)
+	}
+}
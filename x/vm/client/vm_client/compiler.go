@@ -26,8 +26,18 @@ func CreateConnection(addr string) (*grpc.ClientConn, error) {
 	return helpers.GetGRpcClientConnection(addr, 0)
 }
 
-// Extract arguments from bytecode with compiler.
-func ExtractArguments(addr string, bytecode []byte) ([]types_grpc.VMTypeTag, error) {
+// Extract arguments and generic type parameters from bytecode with compiler.
+func ExtractArguments(addr string, bytecode []byte) ([]types_grpc.VMTypeTag, []string, error) {
+	meta, err := ExtractScriptMeta(addr, bytecode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return meta.Arguments, meta.TypeParameters, nil
+}
+
+// Extract script metadata (signers count, arguments, generic type parameters) from bytecode with compiler.
+func ExtractScriptMeta(addr string, bytecode []byte) (*metadata_grpc.ScriptMeta, error) {
 	conn, err := CreateConnection(addr)
 	if err != nil {
 		return nil, fmt.Errorf("Can't extract contract metadata because of error during connection to VM: %s\n", err.Error())
@@ -46,7 +56,7 @@ func ExtractArguments(addr string, bytecode []byte) ([]types_grpc.VMTypeTag, err
 		return nil, fmt.Errorf("can't extract contract metadata, received not script bytecode")
 	}
 
-	return res.GetScript().Arguments, nil
+	return res.GetScript(), nil
 }
 
 // Compile script via grpc compiler.
@@ -0,0 +1,150 @@
+package vm_client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// BuildEventLayouts builds Move struct layouts for module struct types (MatchProtoFields output).
+// Layouts are used to decode both events and resources data.
+// Generic structs are skipped, struct fields could refer to primitives, vectors and
+// other structs of the same module. Returns built layouts and skipped struct names with reasons.
+func BuildEventLayouts(address sdk.AccAddress, moduleName string, moduleTypes []ModuleType) (types.EventLayouts, []string) {
+	typesMap := make(map[string]ModuleType, len(moduleTypes))
+	for _, moduleType := range moduleTypes {
+		typesMap[moduleType.Name] = moduleType
+	}
+
+	layouts := make(types.EventLayouts, 0, len(moduleTypes))
+	var skipped []string
+	for _, moduleType := range moduleTypes {
+		if len(moduleType.TypeParameters) > 0 {
+			skipped = append(skipped, fmt.Sprintf("%s: generic struct", moduleType.Name))
+			continue
+		}
+
+		structTag := types.StructTypeTag{Address: address, Module: moduleName, Name: moduleType.Name}
+		request, err := buildStructViewerRequest(moduleName, moduleType, typesMap, 0)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", moduleType.Name, err))
+			continue
+		}
+
+		layout := types.NewEventLayout(structTag.String(), request)
+		if err := layout.Validate(); err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", moduleType.Name, err))
+			continue
+		}
+		layouts = append(layouts, layout)
+	}
+
+	return layouts, skipped
+}
+
+// buildStructViewerRequest builds LCS viewer request for the module struct.
+func buildStructViewerRequest(moduleName string, moduleType ModuleType, typesMap map[string]ModuleType, depth int) (types.ViewerRequest, error) {
+	if depth > types.ViewerRequestMaxDepth {
+		return nil, fmt.Errorf("nesting level exceeds %d", types.ViewerRequestMaxDepth)
+	}
+
+	request := make(types.ViewerRequest, 0, len(moduleType.Field))
+	for _, field := range moduleType.Field {
+		item, err := buildFieldViewerItem(moduleName, field.Name, field.Type, typesMap, depth)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", field.Name, err)
+		}
+		request = append(request, item)
+	}
+
+	return request, nil
+}
+
+// buildFieldViewerItem builds LCS viewer item for the Move type string (u64, vector<u8>, Self::Inner).
+func buildFieldViewerItem(moduleName, fieldName, fieldType string, typesMap map[string]ModuleType, depth int) (types.ViewerItem, error) {
+	fieldType = strings.TrimSpace(fieldType)
+	item := types.ViewerItem{Name: fieldName}
+
+	switch fieldType {
+	case "u8":
+		item.Type = types.ViewerTypeU8
+		return item, nil
+	case "u64":
+		item.Type = types.ViewerTypeU64
+		return item, nil
+	case "u128":
+		item.Type = types.ViewerTypeU128
+		return item, nil
+	case "bool":
+		item.Type = types.ViewerTypeBool
+		return item, nil
+	case "address":
+		item.Type = types.ViewerTypeAddress
+		return item, nil
+	}
+
+	if strings.HasPrefix(fieldType, "vector<") && strings.HasSuffix(fieldType, ">") {
+		innerType := fieldType[len("vector<") : len(fieldType)-1]
+		innerItem, err := buildFieldViewerItem(moduleName, "", innerType, typesMap, depth+1)
+		if err != nil {
+			return types.ViewerItem{}, fmt.Errorf("vector: %w", err)
+		}
+
+		item.Type = types.ViewerTypeVector
+		item.InnerItem = &types.ViewerRequest{innerItem}
+
+		return item, nil
+	}
+
+	// struct: Name, Self::Name, {module}::Name or {address}::{module}::Name of the same module
+	pathItems := strings.Split(fieldType, "::")
+	structName := pathItems[len(pathItems)-1]
+	if len(pathItems) > 1 {
+		structModule := pathItems[len(pathItems)-2]
+		if structModule != "Self" && structModule != moduleName {
+			return types.ViewerItem{}, fmt.Errorf("type %q: external module structs are not supported", fieldType)
+		}
+	}
+
+	structType, ok := typesMap[structName]
+	if !ok {
+		return types.ViewerItem{}, fmt.Errorf("type %q: unsupported", fieldType)
+	}
+	if len(structType.TypeParameters) > 0 {
+		return types.ViewerItem{}, fmt.Errorf("type %q: generic structs are not supported", fieldType)
+	}
+
+	innerRequest, err := buildStructViewerRequest(moduleName, structType, typesMap, depth+1)
+	if err != nil {
+		return types.ViewerItem{}, fmt.Errorf("struct %q: %w", structName, err)
+	}
+	item.Type = types.ViewerTypeStruct
+	item.InnerItem = &innerRequest
+
+	return item, nil
+}
+
+// SearchMoveEvents searches past Move events using Tendermint tx search.
+// Pagination is applied to txs (tx might contain multiple events).
+func SearchMoveEvents(cliCtx context.CLIContext, filter types.MoveEventsFilter, page, limit int) (types.TxMoveEvents, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	searchResult, err := utils.QueryTxsByEvents(cliCtx, filter.TxSearchEvents(), page, limit)
+	if err != nil {
+		return nil, fmt.Errorf("tx search: %w", err)
+	}
+
+	events := make(types.TxMoveEvents, 0)
+	for _, tx := range searchResult.Txs {
+		events = append(events, types.NewTxMoveEventsFromABCILogs(tx, filter)...)
+	}
+
+	return events, nil
+}
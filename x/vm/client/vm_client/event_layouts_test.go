@@ -0,0 +1,83 @@
+// +build unit
+
+package vm_client
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test event layouts building from module metadata.
+func TestVMClient_BuildEventLayouts(t *testing.T) {
+	t.Parallel()
+
+	addr := make(sdk.AccAddress, common_vm.VMAddressLength)
+	addr[0] = 0x2
+
+	moduleTypes := []ModuleType{
+		{
+			Name: "Inner",
+			Field: []ModuleTypeField{
+				{Name: "flag", Type: "bool"},
+			},
+		},
+		{
+			Name: "PaymentEvent",
+			Field: []ModuleTypeField{
+				{Name: "amount", Type: "u128"},
+				{Name: "payee", Type: "address"},
+				{Name: "metadata", Type: "vector<u8>"},
+				{Name: "inner", Type: "Self::Inner"},
+				{Name: "inners", Type: "vector<Bank::Inner>"},
+			},
+		},
+		{
+			Name:       "Balance",
+			IsResource: true,
+			Field: []ModuleTypeField{
+				{Name: "value", Type: "u64"},
+			},
+		},
+		{
+			Name:           "Generic",
+			TypeParameters: []string{"T"},
+			Field: []ModuleTypeField{
+				{Name: "value", Type: "T"},
+			},
+		},
+		{
+			Name: "External",
+			Field: []ModuleTypeField{
+				{Name: "coin", Type: "0x1::Dfinance::T"},
+			},
+		},
+	}
+
+	layouts, skipped := BuildEventLayouts(addr, "Bank", moduleTypes)
+	require.Len(t, skipped, 2)
+	require.Len(t, layouts, 3)
+	require.NoError(t, layouts.Validate())
+
+	require.Equal(t, addr.String()+"::Bank::Inner", layouts[0].EventType)
+	require.Equal(t, types.ViewerRequest{{Name: "flag", Type: types.ViewerTypeBool}}, layouts[0].Layout)
+
+	layout := layouts[1]
+	require.Equal(t, addr.String()+"::Bank::PaymentEvent", layout.EventType)
+	require.Len(t, layout.Layout, 5)
+	require.Equal(t, types.ViewerTypeU128, layout.Layout[0].Type)
+	require.Equal(t, types.ViewerTypeAddress, layout.Layout[1].Type)
+	require.Equal(t, types.ViewerTypeVector, layout.Layout[2].Type)
+	require.Equal(t, types.ViewerTypeU8, (*layout.Layout[2].InnerItem)[0].Type)
+	require.Equal(t, types.ViewerTypeStruct, layout.Layout[3].Type)
+	require.Equal(t, layouts[0].Layout, *layout.Layout[3].InnerItem)
+	require.Equal(t, types.ViewerTypeVector, layout.Layout[4].Type)
+	require.Equal(t, types.ViewerTypeStruct, (*layout.Layout[4].InnerItem)[0].Type)
+
+	require.Equal(t, addr.String()+"::Bank::Balance", layouts[2].EventType)
+	require.Equal(t, types.ViewerRequest{{Name: "value", Type: types.ViewerTypeU64}}, layouts[2].Layout)
+}
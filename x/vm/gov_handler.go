@@ -20,6 +20,8 @@ func NewGovHandler(k Keeper) gov.Handler {
 		switch p := c.(type) {
 		case StdlibUpdateProposal:
 			return handleUpdateStdlibProposalDryRun(ctx, k, p)
+		case RegisterEventLayoutsProposal:
+			return handleRegisterEventLayoutsProposal(ctx, k, p)
 		default:
 			return fmt.Errorf("unsupported proposal content type %q for module %q", c.ProposalType(), ModuleName)
 		}
@@ -49,9 +51,18 @@ func handleUpdateStdlibProposalDryRun(ctx sdk.Context, k Keeper, proposal Stdlib
 	return nil
 }
 
+// handleRegisterEventLayoutsProposal handles stdlib Move event layouts registration proposal.
+func handleRegisterEventLayoutsProposal(ctx sdk.Context, k Keeper, proposal RegisterEventLayoutsProposal) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.RegisterEventLayouts(ctx, proposal.Layouts)
+}
+
 // getStdlibUpdateMsg returns deploy message for stdlib update.
 func getStdlibUpdateMsg(proposal StdlibUpdateProposal) (MsgDeployModule, error) {
-	msg := NewMsgDeployModule(common_vm.StdLibAddress, []Contract{proposal.Code})
+	msg := NewMsgDeployModule(common_vm.StdLibAddress, []Contract{proposal.Code}, "")
 	if err := msg.ValidateBasic(); err != nil {
 		return MsgDeployModule{}, fmt.Errorf("deploy message validation failed: %w", err)
 	}
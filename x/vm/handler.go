@@ -20,6 +20,12 @@ func NewHandler(k Keeper) sdk.Handler {
 		case MsgExecuteScript:
 			return handleMsgScript(ctx, k, msg)
 
+		case MsgExecuteScriptMultiSigner:
+			return handleMsgMultiSignerScript(ctx, k, msg)
+
+		case MsgRegisterEventLayouts:
+			return handleMsgRegisterEventLayouts(ctx, k, msg)
+
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized vm msg type: %v", msg.Type())
 		}
@@ -35,6 +41,15 @@ func handleMsgScript(ctx sdk.Context, k Keeper, msg MsgExecuteScript) (*sdk.Resu
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+// handleMsgMultiSignerScript handles MsgExecuteScriptMultiSigner message.
+func handleMsgMultiSignerScript(ctx sdk.Context, k Keeper, msg MsgExecuteScriptMultiSigner) (*sdk.Result, error) {
+	if err := k.ExecuteMultiSignerScript(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 // handleMsgDeploy handles MsgDeployModule message.
 func handleMsgDeploy(ctx sdk.Context, k Keeper, msg MsgDeployModule) (*sdk.Result, error) {
 	if err := k.DeployContract(ctx, msg); err != nil {
@@ -43,3 +58,12 @@ func handleMsgDeploy(ctx sdk.Context, k Keeper, msg MsgDeployModule) (*sdk.Resul
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// handleMsgRegisterEventLayouts handles MsgRegisterEventLayouts message.
+func handleMsgRegisterEventLayouts(ctx sdk.Context, k Keeper, msg MsgRegisterEventLayouts) (*sdk.Result, error) {
+	if err := k.RegisterEventLayouts(ctx, msg.Layouts); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
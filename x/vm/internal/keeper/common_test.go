@@ -51,6 +51,9 @@ const (
 	movePath   = "00070b2b1ef472990ed03aa068408da8905c5a176639db1d35dc496d4f70c3c94a"
 	value      = "68656c6c6f2c20776f726c6421"
 
+	// Stdlib 0x1::XFI module (struct T { dummy_field: bool })
+	moveModuleCode = "a11ceb0b01000000050100020202040706120818140a2c050000000102000358464901540b64756d6d795f6669656c640000000000000000000000000000000000000001000201020100"
+
 	DefaultMockVMAddress  = "127.0.0.1:60051" // Default virtual machine address to connect from Cosmos SDK.
 	DefaultMockDataListen = "127.0.0.1:60052" // Default data server address to listen for connections from VM.
 	FlagVMMockAddress     = "vm.mock.address"
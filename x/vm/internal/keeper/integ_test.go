@@ -367,7 +367,7 @@ func TestVMKeeper_DeployContractTransfer(t *testing.T) {
 	}
 
 	t.Logf("Execute send script")
-	msgScript := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, args)
+	msgScript := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, args)
 	err = input.vk.ExecuteScript(input.ctx, msgScript)
 	require.NoError(t, err)
 
@@ -427,7 +427,7 @@ func TestVMKeeper_DeployModule(t *testing.T) {
 	require.NoErrorf(t, err, "can't get code for math module: %v", err)
 	require.Len(t, bytecodeModule, 1)
 
-	msg := types.NewMsgDeployModule(addr1, []types.Contract{bytecodeModule[0].ByteCode})
+	msg := types.NewMsgDeployModule(addr1, []types.Contract{bytecodeModule[0].ByteCode}, "")
 	err = msg.ValidateBasic()
 	require.NoErrorf(t, err, "can't validate err: %v", err)
 
@@ -465,7 +465,7 @@ func TestVMKeeper_DeployModule(t *testing.T) {
 	}
 
 	ctx, _ = input.ctx.CacheContext()
-	msgScript := types.NewMsgExecuteScript(addr1, bytecodeScript[0].ByteCode, args)
+	msgScript := types.NewMsgExecuteScript(addr1, bytecodeScript[0].ByteCode, nil, args)
 	err = input.vk.ExecuteScript(ctx, msgScript)
 	require.NoError(t, err)
 
@@ -583,7 +583,7 @@ func TestVMKeeper_DeployModuleTwice(t *testing.T) {
 	}
 
 	checkMsgCreate := func(msg string, contracts []types.Contract) types.MsgDeployModule {
-		deployMsg := types.NewMsgDeployModule(addr1, contracts)
+		deployMsg := types.NewMsgDeployModule(addr1, contracts, "")
 		require.NoError(t, deployMsg.ValidateBasic(), "%s: can't validate err: %v", msg)
 		return deployMsg
 	}
@@ -652,7 +652,7 @@ func TestVMKeeper_DeployModuleTwice(t *testing.T) {
 
 	checkScriptExecuteOK := func(msg string, byteCode []byte, args []types.ScriptArg) {
 		ctx, _ := input.ctx.CacheContext()
-		executeMsg := types.NewMsgExecuteScript(addr1, byteCode, args)
+		executeMsg := types.NewMsgExecuteScript(addr1, byteCode, nil, args)
 		err := input.vk.ExecuteScript(ctx, executeMsg)
 		require.NoError(t, err, "%s: can't execute script", msg)
 	}
@@ -823,7 +823,7 @@ func TestVMKeeper_ScriptOracle(t *testing.T) {
 	}
 
 	input.ok.SetParams(input.ctx, okInitParams)
-	_, _ = input.ok.SetPrice(input.ctx, addr1, assetCode, price, price, time.Now())
+	_, _ = input.ok.SetPrice(input.ctx, addr1, assetCode, price, price, sdk.ZeroInt(), time.Now())
 	_ = input.ok.SetCurrentPrices(input.ctx)
 
 	gs := getGenesis(t)
@@ -846,7 +846,7 @@ func TestVMKeeper_ScriptOracle(t *testing.T) {
 		require.NoErrorf(t, err, "can't get code for oracle direct asset script: %v", err)
 		require.Len(t, bytecodeScript, 1)
 
-		msgScript := types.NewMsgExecuteScript(addr1, bytecodeScript[0].ByteCode, nil)
+		msgScript := types.NewMsgExecuteScript(addr1, bytecodeScript[0].ByteCode, nil, nil)
 		err = input.vk.ExecuteScript(input.ctx, msgScript)
 		require.NoError(t, err)
 
@@ -899,7 +899,7 @@ func TestVMKeeper_ScriptOracle(t *testing.T) {
 		})
 		require.NoErrorf(t, err, "can't get code for oracle reverse asset script: %v", err)
 
-		msgScript := types.NewMsgExecuteScript(addr1, bytecodeReverseScript[0].ByteCode, nil)
+		msgScript := types.NewMsgExecuteScript(addr1, bytecodeReverseScript[0].ByteCode, nil, nil)
 		err = input.vk.ExecuteScript(input.ctx, msgScript)
 		require.NoError(t, err)
 
@@ -990,7 +990,7 @@ func TestVMKeeper_ErrorScript(t *testing.T) {
 		args = append(args, arg)
 	}
 
-	msgScript := types.NewMsgExecuteScript(addr1, bytecodeScript[0].ByteCode, args)
+	msgScript := types.NewMsgExecuteScript(addr1, bytecodeScript[0].ByteCode, nil, args)
 	err = input.vk.ExecuteScript(input.ctx, msgScript)
 	require.NoError(t, err)
 
@@ -1080,7 +1080,7 @@ func TestVMKeeper_AllArgsTypes(t *testing.T) {
 		args = append(args, arg)
 	}
 
-	scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, args)
+	scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, args)
 	require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "script execute error")
 
 	checkNoEventErrors(input.ctx.EventManager().Events(), t)
@@ -1145,7 +1145,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1178,7 +1178,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1212,7 +1212,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1246,7 +1246,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1280,7 +1280,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1314,7 +1314,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1348,7 +1348,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1382,7 +1382,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1416,7 +1416,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1450,7 +1450,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, bytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, bytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1481,7 +1481,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, moduleBytecode, 1)
 
 		t.Logf("%s: module deploy", testID)
-		moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode})
+		moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode}, "")
 		require.NoErrorf(t, moduleMsg.ValidateBasic(), "%s: module deploy message validation failed", testID)
 		ctx, writeCtx := input.ctx.CacheContext()
 		require.NoErrorf(t, input.vk.DeployContract(ctx, moduleMsg), "%s: module deploy error", testID)
@@ -1514,7 +1514,7 @@ func TestVMKeeper_Path(t *testing.T) {
 		require.Len(t, scriptBytecode, 1)
 
 		t.Logf("%s: script execute", testID)
-		scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil)
+		scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil, nil)
 		require.NoErrorf(t, input.vk.ExecuteScript(input.ctx, scriptMsg), "%s: script execute error", testID)
 
 		t.Logf("%s: checking script events", testID)
@@ -1597,7 +1597,7 @@ func TestVMKeeper_EventTypeSerialization(t *testing.T) {
 	require.NoErrorf(t, err, "module compile error")
 	require.Len(t, moduleBytecode, 1)
 
-	moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode})
+	moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode}, "")
 	require.NoErrorf(t, moduleMsg.ValidateBasic(), "module deploy message validation failed")
 	ctx, writeCtx := input.ctx.CacheContext()
 	require.NoErrorf(t, input.vk.DeployContract(ctx, moduleMsg), "module deploy error")
@@ -1620,7 +1620,7 @@ func TestVMKeeper_EventTypeSerialization(t *testing.T) {
 	require.NoErrorf(t, err, "script compile error")
 	require.Len(t, scriptBytecode, 1)
 
-	scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil)
+	scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil, nil)
 	resp, err := input.vk.ExecuteScriptNoProcessing(input.ctx, scriptMsg)
 	require.NoErrorf(t, err, "script execute error")
 
@@ -1723,7 +1723,7 @@ func TestVMKeeper_EventTypeSerializationGas(t *testing.T) {
 	require.NoErrorf(t, err, "module compile error")
 	require.Len(t, moduleBytecode, 1)
 
-	moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode})
+	moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode}, "")
 	require.NoErrorf(t, moduleMsg.ValidateBasic(), "module deploy message validation failed")
 	ctx, writeCtx := input.ctx.CacheContext()
 	require.NoErrorf(t, input.vk.DeployContract(ctx, moduleMsg), "module deploy error")
@@ -1747,7 +1747,7 @@ func TestVMKeeper_EventTypeSerializationGas(t *testing.T) {
 	require.Len(t, scriptBytecode, 1)
 
 	gasMeter := sdk.NewGasMeter(100000)
-	scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil)
+	scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil, nil)
 	scriptErr := input.vk.ExecuteScript(input.ctx.WithGasMeter(gasMeter), scriptMsg)
 	require.NoError(t, scriptErr, "script execute error")
 
@@ -1866,7 +1866,7 @@ func TestVMKeeper_EventTypeSerializationOutOfGas(t *testing.T) {
 	require.NoErrorf(t, err, "module compile error")
 	require.Len(t, moduleBytecode, 1)
 
-	moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode})
+	moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode}, "")
 	require.NoErrorf(t, moduleMsg.ValidateBasic(), "module deploy message validation failed")
 	ctx, writeCtx := input.ctx.CacheContext()
 	require.NoErrorf(t, input.vk.DeployContract(ctx, moduleMsg), "module deploy error")
@@ -1889,7 +1889,7 @@ func TestVMKeeper_EventTypeSerializationOutOfGas(t *testing.T) {
 	require.NoErrorf(t, err, "script compile error")
 	require.Len(t, scriptBytecode, 1)
 
-	scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil)
+	scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil, nil)
 
 	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "event type processing"}, func() {
 		_ = input.vk.ExecuteScript(input.ctx.WithGasMeter(sdk.NewGasMeter(100000)), scriptMsg)
@@ -1954,7 +1954,7 @@ func TestResearch_LCS(t *testing.T) {
 
 		// publish
 		{
-			moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode})
+			moduleMsg := types.NewMsgDeployModule(addr1, []types.Contract{moduleBytecode[0].ByteCode}, "")
 			require.NoErrorf(t, moduleMsg.ValidateBasic(), "module deploy message validation failed")
 
 			cacheCtx, writeCtx := input.ctx.CacheContext()
@@ -1980,7 +1980,7 @@ func TestResearch_LCS(t *testing.T) {
 
 		// execute
 		{
-			scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil)
+			scriptMsg := types.NewMsgExecuteScript(addr1, scriptBytecode[0].ByteCode, nil, nil)
 			require.NoErrorf(t, scriptMsg.ValidateBasic(), "script execute message validation failed")
 
 			cacheCtx, writeCtx := input.ctx.CacheContext()
@@ -55,14 +55,20 @@ func (k Keeper) ExecuteScript(ctx sdk.Context, msg types.MsgExecuteScript) error
 	if sdkErr != nil {
 		return sdkErr
 	}
+	k.executeScript(ctx, req)
 
-	exec, err := k.sendExecuteReq(ctx, nil, req)
-	if err != nil {
-		k.GetLogger(ctx).Error(fmt.Sprintf("grpc error: %s", err.Error()))
-		panic(sdkErrors.Wrap(types.ErrVMCrashed, err.Error()))
-	}
+	return nil
+}
 
-	k.processExecution(ctx, exec)
+// ExecuteMultiSignerScript executes Move script with multiple signers and processes execution results (events, writeSets).
+func (k Keeper) ExecuteMultiSignerScript(ctx sdk.Context, msg types.MsgExecuteScriptMultiSigner) error {
+	k.modulePerms.AutoCheck(types.PermVmExec)
+
+	req, sdkErr := NewMultiSignerExecuteRequest(ctx, msg)
+	if sdkErr != nil {
+		return sdkErr
+	}
+	k.executeScript(ctx, req)
 
 	return nil
 }
@@ -85,9 +91,36 @@ func (k Keeper) ExecuteScriptNoProcessing(ctx sdk.Context, msg types.MsgExecuteS
 }
 
 // DeployContract deploys Move module (contract) and processes execution results (events, writeSets).
+// Already published modules upgrade policies are checked, successfully published modules are registered.
 func (k Keeper) DeployContract(ctx sdk.Context, msg types.MsgDeployModule) error {
 	k.modulePerms.AutoCheck(types.PermVmExec)
 
+	return k.deployContract(ctx, msg, true)
+}
+
+// DeployContractByGov is a DeployContract alternative for governance proposals (upgrade policies are not checked).
+func (k Keeper) DeployContractByGov(ctx sdk.Context, msg types.MsgDeployModule) error {
+	k.modulePerms.AutoCheck(types.PermVmExec)
+
+	return k.deployContract(ctx, msg, false)
+}
+
+// deployContract builds modules registry entries, deploys modules and processes execution results.
+func (k Keeper) deployContract(ctx sdk.Context, msg types.MsgDeployModule, checkPolicy bool) error {
+	infoList := make([]types.ModuleInfo, len(msg.Module))
+	namesSet := make(map[string]bool, len(msg.Module))
+	for i, contract := range msg.Module {
+		info, err := k.buildModuleInfo(ctx, msg.Signer, contract, msg.UpgradePolicy, checkPolicy)
+		if err != nil {
+			return sdkErrors.Wrapf(err, "module[%d]", i)
+		}
+		if namesSet[info.Name] {
+			return sdkErrors.Wrapf(types.ErrWrongModuleCode, "module[%d]: module %q: duplicated", i, info.Name)
+		}
+		namesSet[info.Name] = true
+		infoList[i] = info
+	}
+
 	execList := make([]*vm_grpc.VMExecuteResponse, len(msg.Module))
 	var intErr error
 
@@ -101,8 +134,13 @@ func (k Keeper) DeployContract(ctx sdk.Context, msg types.MsgDeployModule) error
 		}
 	}
 
-	for _, exec := range execList {
+	for i, exec := range execList {
 		k.processExecution(ctx, exec)
+
+		if exec.GetStatus().GetError() == nil {
+			k.setModuleInfo(ctx, infoList[i])
+			ctx.EventManager().EmitEvent(types.NewModulePublishedEvent(infoList[i]))
+		}
 	}
 
 	return nil
@@ -112,7 +150,11 @@ func (k Keeper) DeployContract(ctx sdk.Context, msg types.MsgDeployModule) error
 func (k Keeper) DeployContractDryRun(ctx sdk.Context, msg types.MsgDeployModule) error {
 	k.modulePerms.AutoCheck(types.PermVmExec)
 
-	for _, contact := range msg.Module {
+	for i, contact := range msg.Module {
+		if _, err := k.parseModuleMeta(ctx, contact); err != nil {
+			return sdkErrors.Wrapf(types.ErrWrongModuleCode, "module[%d]: %v", i, err)
+		}
+
 		req := NewDeployRequest(ctx, msg.Signer, contact)
 		exec, dvmErr := k.sendExecuteReq(ctx, req, nil)
 		if dvmErr != nil {
@@ -130,6 +172,18 @@ func (k Keeper) DeployContractDryRun(ctx sdk.Context, msg types.MsgDeployModule)
 	return nil
 }
 
+// executeScript sends script execution request to VM and processes execution results.
+// VM connection failure breaks consensus: panic.
+func (k Keeper) executeScript(ctx sdk.Context, req *vm_grpc.VMExecuteScript) {
+	exec, err := k.sendExecuteReq(ctx, nil, req)
+	if err != nil {
+		k.GetLogger(ctx).Error(fmt.Sprintf("grpc error: %s", err.Error()))
+		panic(sdkErrors.Wrap(types.ErrVMCrashed, err.Error()))
+	}
+
+	k.processExecution(ctx, exec)
+}
+
 // Create new currency keeper.
 func NewKeeper(
 	cdc *amino.Codec,
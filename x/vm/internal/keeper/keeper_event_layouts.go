@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// RegisterEventLayouts registers (overrides) Move event layouts.
+// Layouts ownership is checked by the msg / gov proposal ValidateBasic.
+func (k Keeper) RegisterEventLayouts(ctx sdk.Context, layouts types.EventLayouts) error {
+	k.modulePerms.AutoCheck(types.PermStorageWrite)
+
+	for _, layout := range layouts {
+		k.setEventLayout(ctx, layout)
+		ctx.EventManager().EmitEvent(types.NewEventLayoutRegisteredEvent(layout))
+	}
+
+	return nil
+}
+
+// GetEventLayout returns Move event layout by event type.
+// Exact event type match is checked first, than event type without generic params.
+func (k Keeper) GetEventLayout(ctx sdk.Context, eventType string) (types.EventLayout, bool) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	return k.getEventLayout(ctx, eventType)
+}
+
+// GetEventLayouts returns all registered Move event layouts.
+func (k Keeper) GetEventLayouts(ctx sdk.Context) types.EventLayouts {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	layouts := make(types.EventLayouts, 0)
+	k.iterateOverEventLayouts(ctx, func(layout types.EventLayout) bool {
+		layouts = append(layouts, layout)
+		return true
+	})
+
+	return layouts
+}
+
+// DecodeMoveEventData decodes Move event LCS data to JSON using registered event layout.
+// Returns false if layout is not registered, decoding failed or decoding limits are exceeded.
+// Decoding is an informational step, so no gas is charged (tx gas usage doesn't depend on registered layouts).
+// Unmetered work is bounded by the layout nesting level, the input data and the decoded data size limits.
+func (k Keeper) DecodeMoveEventData(ctx sdk.Context, eventType string, data []byte) (string, bool) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	if len(data) > types.EventDataDecodingMaxSize {
+		return "", false
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	layout, found := k.getEventLayout(ctx, eventType)
+	if !found {
+		return "", false
+	}
+
+	decodedData, err := decodeLCSData(layout.Layout, data)
+	if err != nil {
+		k.GetLogger(ctx).Debug(fmt.Sprintf("event %q data decoding: %v", eventType, err))
+		return "", false
+	}
+	if len(decodedData) > types.EventDataDecodedMaxSize {
+		k.GetLogger(ctx).Debug(fmt.Sprintf("event %q data decoding: decoded data size exceeds %d", eventType, types.EventDataDecodedMaxSize))
+		return "", false
+	}
+
+	return decodedData, true
+}
+
+// setEventLayout sets Move event layout to the storage.
+// Layout event type is also indexed by the resource access path (used to identify resources type).
+func (k Keeper) setEventLayout(ctx sdk.Context, layout types.EventLayout) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEventLayoutKey(layout.EventType), k.cdc.MustMarshalBinaryBare(layout))
+
+	if structTag, err := types.ParseStructTypeTag(layout.EventType); err == nil {
+		store.Set(types.GetEventLayoutResourcePathKey(structTag.ResourceAccessPath()), []byte(layout.EventType))
+	}
+}
+
+// getResourceLayout returns struct layout registered for the exact struct type by its resource access path.
+func (k Keeper) getResourceLayout(ctx sdk.Context, path []byte) (types.EventLayout, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	eventType := store.Get(types.GetEventLayoutResourcePathKey(path))
+	if eventType == nil {
+		return types.EventLayout{}, false
+	}
+
+	bz := store.Get(types.GetEventLayoutKey(string(eventType)))
+	if bz == nil {
+		return types.EventLayout{}, false
+	}
+
+	layout := types.EventLayout{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &layout)
+
+	return layout, true
+}
+
+// getEventLayout returns Move event layout from the storage (exact / base type match).
+func (k Keeper) getEventLayout(ctx sdk.Context, eventType string) (types.EventLayout, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetEventLayoutKey(eventType))
+	if bz == nil {
+		baseType := types.GetEventBaseType(eventType)
+		if baseType == eventType {
+			return types.EventLayout{}, false
+		}
+
+		bz = store.Get(types.GetEventLayoutKey(baseType))
+		if bz == nil {
+			return types.EventLayout{}, false
+		}
+	}
+
+	layout := types.EventLayout{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &layout)
+
+	return layout, true
+}
+
+// iterateOverEventLayouts iterates over all registered Move event layouts.
+func (k Keeper) iterateOverEventLayouts(ctx sdk.Context, handler func(layout types.EventLayout) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEventLayoutPrefixKey())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		layout := types.EventLayout{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &layout)
+
+		if !handler(layout) {
+			break
+		}
+	}
+}
+
+// decodeLCSData converts LCS data to compact JSON using the viewer request (panic safe).
+func decodeLCSData(request types.ViewerRequest, data []byte) (retData string, retErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			retErr = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	dataStr, err := StringifyLCSData(request, data)
+	if err != nil {
+		return "", err
+	}
+
+	buf := bytes.Buffer{}
+	if err := json.Compact(&buf, []byte(dataStr)); err != nil {
+		return "", fmt.Errorf("result JSON compact: %w", err)
+	}
+
+	return buf.String(), nil
+}
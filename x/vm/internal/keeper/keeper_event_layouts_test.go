@@ -0,0 +1,134 @@
+// +build unit
+
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test event layouts registration, lookup and event data decoding.
+func TestVMKeeper_EventLayouts(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+	ctx, keeper, cdc := input.ctx, input.vk, input.cdc
+
+	owner := sdk.AccAddress(randomValue(20))
+	request := types.ViewerRequest{
+		{Name: "value", Type: types.ViewerTypeU64},
+		{Name: "flag", Type: types.ViewerTypeBool},
+	}
+	baseLayout := types.NewEventLayout(owner.String()+"::Foo::Bar", request)
+	genericLayout := types.NewEventLayout(owner.String()+"::Foo::Bar<u8>", types.ViewerRequest{{Name: "value", Type: types.ViewerTypeU64}})
+
+	// register
+	{
+		msg := types.NewMsgRegisterEventLayouts(owner, types.EventLayouts{baseLayout, genericLayout})
+		require.NoError(t, msg.ValidateBasic())
+		require.NoError(t, keeper.RegisterEventLayouts(ctx, msg.Layouts))
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 2)
+		require.Equal(t, types.EventTypeEventLayout, events[0].Type)
+	}
+
+	// get: exact and base type match
+	{
+		layout, found := keeper.GetEventLayout(ctx, baseLayout.EventType)
+		require.True(t, found)
+		require.Equal(t, baseLayout, layout)
+
+		layout, found = keeper.GetEventLayout(ctx, genericLayout.EventType)
+		require.True(t, found)
+		require.Equal(t, genericLayout, layout)
+
+		layout, found = keeper.GetEventLayout(ctx, owner.String()+"::Foo::Bar<u64>")
+		require.True(t, found)
+		require.Equal(t, baseLayout, layout)
+
+		_, found = keeper.GetEventLayout(ctx, owner.String()+"::Foo::Baz")
+		require.False(t, found)
+	}
+
+	// list
+	{
+		layouts := keeper.GetEventLayouts(ctx)
+		require.ElementsMatch(t, types.EventLayouts{baseLayout, genericLayout}, layouts)
+	}
+
+	// decode
+	{
+		data, err := lcs.Marshal(struct {
+			Value uint64
+			Flag  bool
+		}{Value: 100, Flag: true})
+		require.NoError(t, err)
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+		decoded, ok := keeper.DecodeMoveEventData(ctx, owner.String()+"::Foo::Bar<u64>", data)
+		require.True(t, ok)
+		require.Equal(t, `{"Value":100,"Flag":true}`, decoded)
+		require.Equal(t, gasBefore, ctx.GasMeter().GasConsumed())
+
+		// layout not found
+		_, ok = keeper.DecodeMoveEventData(ctx, owner.String()+"::Foo::Baz", data)
+		require.False(t, ok)
+
+		// invalid data
+		_, ok = keeper.DecodeMoveEventData(ctx, baseLayout.EventType, data[:2])
+		require.False(t, ok)
+	}
+
+	// decode: limits
+	{
+		longName := "field_" + strings.Repeat("x", 50)
+		bigLayout := types.NewEventLayout(owner.String()+"::Foo::Big", types.ViewerRequest{
+			{Name: "items", Type: types.ViewerTypeVector, InnerItem: &types.ViewerRequest{
+				{Name: "item", Type: types.ViewerTypeStruct, InnerItem: &types.ViewerRequest{
+					{Name: longName, Type: types.ViewerTypeBool},
+				}},
+			}},
+		})
+		require.NoError(t, bigLayout.Validate())
+		require.NoError(t, keeper.RegisterEventLayouts(ctx, types.EventLayouts{bigLayout}))
+
+		buildData := func(itemsCount int) []byte {
+			data, err := lcs.Marshal(struct {
+				Items []bool
+			}{Items: make([]bool, itemsCount)})
+			require.NoError(t, err)
+			return data
+		}
+
+		// ok
+		decoded, ok := keeper.DecodeMoveEventData(ctx, bigLayout.EventType, buildData(10))
+		require.True(t, ok)
+		require.LessOrEqual(t, len(decoded), types.EventDataDecodedMaxSize)
+
+		// fail: decoded data size limit
+		data := buildData(1000)
+		require.LessOrEqual(t, len(data), types.EventDataDecodingMaxSize)
+		_, ok = keeper.DecodeMoveEventData(ctx, bigLayout.EventType, data)
+		require.False(t, ok)
+
+		// fail: input data size limit
+		data = buildData(types.EventDataDecodingMaxSize + 1)
+		_, ok = keeper.DecodeMoveEventData(ctx, bigLayout.EventType, data)
+		require.False(t, ok)
+	}
+
+	// genesis export / import
+	{
+		var exportState types.GenesisState
+		cdc.MustUnmarshalJSON(keeper.ExportGenesis(ctx), &exportState)
+		require.Len(t, exportState.EventLayouts, 3)
+	}
+}
@@ -28,6 +28,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data json.RawMessage) {
 		k.setValue(ctx, accessPath, value)
 	}
 
+	for _, layout := range state.EventLayouts {
+		k.setEventLayout(ctx, layout)
+	}
+
+	for _, info := range state.ModuleInfos {
+		k.setModuleInfo(ctx, info)
+	}
+
+	// register genesis modules missing in the registry (stdlib, modules published before the registry introduction)
+	k.backfillModuleInfos(ctx)
+
 	// raise flag for DS server that genesis was inited
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyGenesisInit, []byte{0x1})
@@ -49,5 +60,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) json.RawMessage {
 		return true
 	})
 
+	k.iterateOverEventLayouts(ctx, func(layout types.EventLayout) bool {
+		state.EventLayouts = append(state.EventLayouts, layout)
+		return true
+	})
+
+	k.iterateOverModuleInfos(ctx, func(info types.ModuleInfo) bool {
+		state.ModuleInfos = append(state.ModuleInfos, info)
+		return true
+	})
+
 	return k.cdc.MustMarshalJSON(state)
 }
@@ -5,6 +5,8 @@ package keeper
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/glav"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dfinance/dnode/x/common_vm"
@@ -41,6 +43,24 @@ func TestVMKeeper_Genesis(t *testing.T) {
 			require.NotNil(t, getValue)
 			require.EqualValues(t, initValue, getValue)
 		}
+
+		// stdlib modules are registered with the default upgrade policy
+		modulesCnt := 0
+		for _, initWs := range initState.WriteSet {
+			initAccessPath, _, err := initWs.ToBytes()
+			require.NoError(t, err)
+			if initAccessPath.Path[0] == glav.ModuleTag {
+				modulesCnt++
+			}
+		}
+		require.NotZero(t, modulesCnt)
+
+		infos := keeper.GetModuleInfos(ctx, common_vm.StdLibAddress, sdk.NewUint(1), sdk.NewUint(types.QueryPaginationMaxLimit))
+		require.Len(t, infos, modulesCnt)
+		for _, info := range infos {
+			require.Equal(t, types.DefaultModuleUpgradePolicy, info.UpgradePolicy)
+			require.EqualValues(t, common_vm.StdLibAddress, info.Publisher)
+		}
 	}
 
 	// export
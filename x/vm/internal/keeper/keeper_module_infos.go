@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// GetModuleInfo returns published Move module registry entry.
+func (k Keeper) GetModuleInfo(ctx sdk.Context, address sdk.AccAddress, name string) (types.ModuleInfo, bool) {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	return k.getModuleInfo(ctx, address, name)
+}
+
+// GetModuleInfos returns published Move modules registry entries with pagination (first page: 1).
+// Entries are filtered by module address if {address} is not empty.
+func (k Keeper) GetModuleInfos(ctx sdk.Context, address sdk.AccAddress, page, limit sdk.Uint) types.ModuleInfos {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	prefix := types.GetModuleInfoPrefixKey()
+	if !address.Empty() {
+		prefix = types.GetModuleInfoAddressPrefixKey(address)
+	}
+
+	infos := make(types.ModuleInfos, 0)
+	skipCnt, takeCnt := getPaginationRange(page, limit)
+	if takeCnt == 0 {
+		return infos
+	}
+	k.iterateOverPrefixModuleInfos(ctx, prefix, func(info types.ModuleInfo) bool {
+		if skipCnt > 0 {
+			skipCnt--
+			return true
+		}
+		infos = append(infos, info)
+
+		return uint64(len(infos)) < takeCnt
+	})
+
+	return infos
+}
+
+// buildModuleInfo parses module bytecode and builds the registry entry for the module deploy.
+// Upgrade policy restrictions of already published module are checked if {checkPolicy} is set.
+func (k Keeper) buildModuleInfo(ctx sdk.Context, signer sdk.AccAddress, code []byte, policy types.ModuleUpgradePolicy, checkPolicy bool) (types.ModuleInfo, error) {
+	meta, err := k.parseModuleMeta(ctx, code)
+	if err != nil {
+		return types.ModuleInfo{}, sdkErrors.Wrap(types.ErrWrongModuleCode, err.Error())
+	}
+
+	info := types.NewModuleInfo(signer, meta.Name, code, signer, ctx.BlockHeight(), policy)
+
+	prevInfo, found := k.getModuleInfo(ctx, signer, meta.Name)
+	if !found {
+		if info.UpgradePolicy == "" {
+			info.UpgradePolicy = types.DefaultModuleUpgradePolicy
+		}
+		return info, nil
+	}
+
+	if info.UpgradePolicy == "" {
+		info.UpgradePolicy = prevInfo.UpgradePolicy
+	}
+	if !checkPolicy {
+		return info, nil
+	}
+
+	if !info.UpgradePolicy.IsStricterOrEqual(prevInfo.UpgradePolicy) {
+		return types.ModuleInfo{}, sdkErrors.Wrapf(types.ErrModuleUpgradeDenied, "module %q: policy %q can't be relaxed to %q", prevInfo.ID(), prevInfo.UpgradePolicy, info.UpgradePolicy)
+	}
+
+	switch prevInfo.UpgradePolicy {
+	case types.ModuleUpgradePolicyImmutable:
+		return types.ModuleInfo{}, sdkErrors.Wrapf(types.ErrModuleUpgradeDenied, "module %q: immutable", prevInfo.ID())
+	case types.ModuleUpgradePolicyCompatible:
+		var addrLibra [common_vm.VMAddressLength]byte
+		copy(addrLibra[:], common_vm.Bech32ToLibra(signer))
+
+		prevCode := k.getValue(ctx, &vm_grpc.VMAccessPath{
+			Address: addrLibra[:],
+			Path:    glav.ModuleAccessVector(addrLibra, meta.Name),
+		})
+		if prevCode == nil {
+			return types.ModuleInfo{}, sdkErrors.Wrapf(types.ErrModuleUpgradeDenied, "module %q: published bytecode not found", prevInfo.ID())
+		}
+
+		prevMeta, err := k.parseModuleMeta(ctx, prevCode)
+		if err != nil {
+			return types.ModuleInfo{}, sdkErrors.Wrapf(types.ErrModuleUpgradeDenied, "module %q: published bytecode: %v", prevInfo.ID(), err)
+		}
+
+		if err := prevMeta.CheckUpgradeCompatibility(meta); err != nil {
+			return types.ModuleInfo{}, sdkErrors.Wrapf(types.ErrModuleUpgradeDenied, "module %q: incompatible upgrade: %v", prevInfo.ID(), err)
+		}
+	}
+
+	return info, nil
+}
+
+// parseModuleMeta parses module bytecode metadata charging gas per bytecode byte.
+func (k Keeper) parseModuleMeta(ctx sdk.Context, code []byte) (types.ModuleMeta, error) {
+	ctx.GasMeter().ConsumeGas(types.ModuleMetaParsingGasPerByte*uint64(len(code)), "module metadata parsing")
+
+	return types.ParseModuleMeta(code)
+}
+
+// backfillModuleInfos registers VM storage modules missing in the registry with the default upgrade policy.
+// That covers modules published before the registry introduction (genesis stdlib and already deployed modules),
+// module address is used as the publisher.
+func (k Keeper) backfillModuleInfos(ctx sdk.Context) {
+	var missingInfos []types.ModuleInfo
+	k.iterateOverValues(ctx, func(accessPath *vm_grpc.VMAccessPath, value []byte) bool {
+		if len(accessPath.Path) == 0 || accessPath.Path[0] != glav.ModuleTag {
+			return true
+		}
+
+		meta, err := types.ParseModuleMeta(value)
+		if err != nil {
+			k.GetLogger(ctx).Info(fmt.Sprintf("module %s: registry backfill skipped: %v", hex.EncodeToString(accessPath.Path), err))
+			return true
+		}
+
+		address := sdk.AccAddress(accessPath.Address)
+		if _, found := k.getModuleInfo(ctx, address, meta.Name); !found {
+			missingInfos = append(missingInfos, types.NewModuleInfo(address, meta.Name, value, address, ctx.BlockHeight(), types.DefaultModuleUpgradePolicy))
+		}
+
+		return true
+	})
+
+	for _, info := range missingInfos {
+		k.setModuleInfo(ctx, info)
+	}
+}
+
+// setModuleInfo sets published Move module registry entry to the storage.
+func (k Keeper) setModuleInfo(ctx sdk.Context, info types.ModuleInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetModuleInfoKey(info.Address, info.Name), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// getModuleInfo returns published Move module registry entry from the storage.
+func (k Keeper) getModuleInfo(ctx sdk.Context, address sdk.AccAddress, name string) (types.ModuleInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetModuleInfoKey(address, name))
+	if bz == nil {
+		return types.ModuleInfo{}, false
+	}
+
+	info := types.ModuleInfo{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+
+	return info, true
+}
+
+// iterateOverModuleInfos iterates over all published Move modules registry entries.
+func (k Keeper) iterateOverModuleInfos(ctx sdk.Context, handler func(info types.ModuleInfo) bool) {
+	k.iterateOverPrefixModuleInfos(ctx, types.GetModuleInfoPrefixKey(), handler)
+}
+
+// iterateOverPrefixModuleInfos iterates over published Move modules registry entries with key prefix.
+func (k Keeper) iterateOverPrefixModuleInfos(ctx sdk.Context, prefix []byte, handler func(info types.ModuleInfo) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		info := types.ModuleInfo{}
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if !handler(info) {
+			break
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// +build unit
+
+package keeper
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/tests/utils"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Check modules registry and upgrade policies with mocked VM.
+func TestVMKeeper_ModuleInfos(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(true)
+	defer input.Stop()
+	ctx, keeper := input.ctx, input.vk
+
+	acc1 := sdk.AccAddress(randomValue(20))
+	acc2 := sdk.AccAddress(randomValue(20))
+
+	code, err := hex.DecodeString(moveModuleCode)
+	require.NoError(t, err)
+
+	// incompatible module version: struct T field type changed (bool -> u8)
+	incompatibleCode := make([]byte, len(code))
+	copy(incompatibleCode, code)
+	incompatibleCode[len(incompatibleCode)-2] = 0x02
+
+	deploy := func(signer sdk.AccAddress, code []byte, policy types.ModuleUpgradePolicy) error {
+		return keeper.DeployContract(ctx, types.NewMsgDeployModule(signer, []types.Contract{code}, policy))
+	}
+
+	checkInfo := func(address sdk.AccAddress, policy types.ModuleUpgradePolicy) {
+		info, found := keeper.GetModuleInfo(ctx, address, "XFI")
+		require.True(t, found)
+		require.NoError(t, info.Validate())
+		require.Equal(t, address, info.Address)
+		require.Equal(t, address, info.Publisher)
+		require.Equal(t, policy, info.UpgradePolicy)
+	}
+
+	// fail: invalid bytecode
+	{
+		utils.CheckExpectedErr(t, types.ErrWrongModuleCode, deploy(acc1, []byte{0x1, 0x2, 0x3}, ""))
+		_, found := keeper.GetModuleInfo(ctx, acc1, "XFI")
+		require.False(t, found)
+	}
+
+	// fail: duplicated modules
+	{
+		msg := types.NewMsgDeployModule(acc1, []types.Contract{code, code}, "")
+		utils.CheckExpectedErr(t, types.ErrWrongModuleCode, keeper.DeployContract(ctx, msg))
+	}
+
+	// ok: first deploy (default policy, metadata parsing gas is charged)
+	{
+		gasBefore := ctx.GasMeter().GasConsumed()
+		require.NoError(t, deploy(acc1, code, ""))
+		require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, types.ModuleMetaParsingGasPerByte*uint64(len(code)))
+		checkInfo(acc1, types.DefaultModuleUpgradePolicy)
+
+		info, _ := keeper.GetModuleInfo(ctx, acc1, "XFI")
+		require.Equal(t, types.GetModuleCodeHash(code), info.CodeHash)
+		require.Equal(t, ctx.BlockHeight(), info.Height)
+	}
+
+	// ok: redeploy with a stricter policy
+	{
+		require.NoError(t, deploy(acc1, code, types.ModuleUpgradePolicyCompatible))
+		checkInfo(acc1, types.ModuleUpgradePolicyCompatible)
+	}
+
+	// fail: policy relaxing
+	{
+		utils.CheckExpectedErr(t, types.ErrModuleUpgradeDenied, deploy(acc1, code, types.ModuleUpgradePolicyOwnerUpgradeable))
+		checkInfo(acc1, types.ModuleUpgradePolicyCompatible)
+	}
+
+	// mock VM doesn't store the module code: set it manually
+	var addrLibra [20]byte
+	copy(addrLibra[:], acc1)
+	keeper.SetValue(ctx, &vm_grpc.VMAccessPath{Address: acc1, Path: glav.ModuleAccessVector(addrLibra, "XFI")}, code)
+
+	// ok: compatible redeploy (policy is kept)
+	{
+		require.NoError(t, deploy(acc1, code, ""))
+		checkInfo(acc1, types.ModuleUpgradePolicyCompatible)
+	}
+
+	// fail: incompatible redeploy
+	{
+		utils.CheckExpectedErr(t, types.ErrModuleUpgradeDenied, deploy(acc1, incompatibleCode, ""))
+		info, _ := keeper.GetModuleInfo(ctx, acc1, "XFI")
+		require.Equal(t, types.GetModuleCodeHash(code), info.CodeHash)
+	}
+
+	// ok: incompatible redeploy by governance
+	{
+		msg := types.NewMsgDeployModule(acc1, []types.Contract{incompatibleCode}, "")
+		require.NoError(t, keeper.DeployContractByGov(ctx, msg))
+		checkInfo(acc1, types.ModuleUpgradePolicyCompatible)
+
+		info, _ := keeper.GetModuleInfo(ctx, acc1, "XFI")
+		require.Equal(t, types.GetModuleCodeHash(incompatibleCode), info.CodeHash)
+	}
+
+	// ok: immutable
+	{
+		require.NoError(t, deploy(acc2, code, types.ModuleUpgradePolicyImmutable))
+		checkInfo(acc2, types.ModuleUpgradePolicyImmutable)
+	}
+
+	// fail: immutable redeploy
+	{
+		utils.CheckExpectedErr(t, types.ErrModuleUpgradeDenied, deploy(acc2, code, ""))
+		utils.CheckExpectedErr(t, types.ErrModuleUpgradeDenied, deploy(acc2, code, types.ModuleUpgradePolicyImmutable))
+	}
+
+	// check list
+	{
+		infos := keeper.GetModuleInfos(ctx, nil, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, infos, 2)
+		require.NoError(t, infos.Validate())
+
+		infos = keeper.GetModuleInfos(ctx, acc2, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, infos, 1)
+		require.Equal(t, acc2, infos[0].Address)
+
+		page1 := keeper.GetModuleInfos(ctx, nil, sdk.NewUint(1), sdk.NewUint(1))
+		page2 := keeper.GetModuleInfos(ctx, nil, sdk.NewUint(2), sdk.NewUint(1))
+		require.Len(t, page1, 1)
+		require.Len(t, page2, 1)
+		require.NotEqual(t, page1[0].Address, page2[0].Address)
+
+		require.Empty(t, keeper.GetModuleInfos(ctx, nil, sdk.NewUint(3), sdk.NewUint(1)))
+		require.Empty(t, keeper.GetModuleInfos(ctx, nil, sdk.NewUint(1), sdk.NewUint(0)))
+	}
+
+	// check genesis import / export (mock VM writeSets can't be exported: fresh keeper is used)
+	{
+		infos := keeper.GetModuleInfos(ctx, nil, sdk.NewUint(1), sdk.NewUint(10))
+		initState := types.GenesisState{ModuleInfos: infos}
+		require.NoError(t, initState.Validate())
+
+		newInput := newTestInput(false)
+		newInput.vk.InitGenesis(newInput.ctx, newInput.cdc.MustMarshalJSON(initState))
+		require.ElementsMatch(t, infos, newInput.vk.GetModuleInfos(newInput.ctx, nil, sdk.NewUint(1), sdk.NewUint(10)))
+
+		var exportState types.GenesisState
+		newInput.cdc.MustUnmarshalJSON(newInput.vk.ExportGenesis(newInput.ctx), &exportState)
+		require.ElementsMatch(t, infos, exportState.ModuleInfos)
+	}
+
+	// check genesis modules registry backfill (writeSet module without registry entry)
+	{
+		acc3 := sdk.AccAddress(randomValue(20))
+		var acc3Libra [20]byte
+		copy(acc3Libra[:], acc3)
+
+		initState := types.GenesisState{
+			WriteSet: []types.GenesisWriteOp{
+				{
+					Address: hex.EncodeToString(acc1),
+					Path:    hex.EncodeToString(glav.ModuleAccessVector(addrLibra, "XFI")),
+					Value:   hex.EncodeToString(code),
+				},
+				{
+					Address: hex.EncodeToString(acc3),
+					Path:    hex.EncodeToString(glav.ModuleAccessVector(acc3Libra, "XFI")),
+					Value:   hex.EncodeToString(code),
+				},
+			},
+			ModuleInfos: types.ModuleInfos{
+				types.NewModuleInfo(acc1, "XFI", code, acc2, 1, types.ModuleUpgradePolicyImmutable),
+			},
+		}
+
+		newInput := newTestInput(false)
+		newInput.vk.InitGenesis(newInput.ctx, newInput.cdc.MustMarshalJSON(initState))
+
+		// existing entry is kept
+		info, found := newInput.vk.GetModuleInfo(newInput.ctx, acc1, "XFI")
+		require.True(t, found)
+		require.Equal(t, initState.ModuleInfos[0], info)
+
+		// missing entry is registered
+		info, found = newInput.vk.GetModuleInfo(newInput.ctx, acc3, "XFI")
+		require.True(t, found)
+		require.NoError(t, info.Validate())
+		require.Equal(t, acc3, info.Address)
+		require.Equal(t, acc3, info.Publisher)
+		require.Equal(t, types.GetModuleCodeHash(code), info.CodeHash)
+		require.Equal(t, types.DefaultModuleUpgradePolicy, info.UpgradePolicy)
+	}
+}
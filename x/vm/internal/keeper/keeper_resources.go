@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// GetResources returns account resources with pagination (first page: 1).
+// Resource type is identified and data is decoded if struct layout is registered for the exact struct type.
+func (k Keeper) GetResources(ctx sdk.Context, address sdk.AccAddress, page, limit sdk.Uint) types.Resources {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	resources := make(types.Resources, 0)
+	skipCnt, takeCnt := getPaginationRange(page, limit)
+	if takeCnt == 0 {
+		return resources
+	}
+	k.iterateOverAddressValues(ctx, common_vm.Bech32ToLibra(address), func(accessPath *vm_grpc.VMAccessPath, value []byte) bool {
+		if !types.IsResourceAccessPath(accessPath.Path) {
+			return true
+		}
+		if skipCnt > 0 {
+			skipCnt--
+			return true
+		}
+
+		resource := types.NewResource(accessPath.Address, accessPath.Path, value)
+		if layout, found := k.getResourceLayout(ctx, accessPath.Path); found {
+			resource.Type = layout.EventType
+			resource.DecodedValue = k.decodeResourceValue(ctx, layout, value)
+		}
+		resources = append(resources, resource)
+
+		return uint64(len(resources)) < takeCnt
+	})
+
+	return resources
+}
+
+// GetResourceHolders returns resources of the struct type for all accounts with pagination (first page: 1).
+// Resource data is decoded if struct layout is registered.
+func (k Keeper) GetResourceHolders(ctx sdk.Context, structTag types.StructTypeTag, page, limit sdk.Uint) types.Resources {
+	k.modulePerms.AutoCheck(types.PermStorageRead)
+
+	structType := structTag.String()
+	resourcePath := structTag.ResourceAccessPath()
+	layout, layoutFound := k.getEventLayout(ctx, structType)
+
+	resources := make(types.Resources, 0)
+	skipCnt, takeCnt := getPaginationRange(page, limit)
+	if takeCnt == 0 {
+		return resources
+	}
+	k.iterateOverValues(ctx, func(accessPath *vm_grpc.VMAccessPath, value []byte) bool {
+		if !bytes.Equal(accessPath.Path, resourcePath) {
+			return true
+		}
+		if skipCnt > 0 {
+			skipCnt--
+			return true
+		}
+
+		resource := types.NewResource(accessPath.Address, accessPath.Path, value)
+		resource.Type = structType
+		if layoutFound {
+			resource.DecodedValue = k.decodeResourceValue(ctx, layout, value)
+		}
+		resources = append(resources, resource)
+
+		return uint64(len(resources)) < takeCnt
+	})
+
+	return resources
+}
+
+// decodeResourceValue decodes resource LCS data to JSON, returns empty string on failure.
+func (k Keeper) decodeResourceValue(ctx sdk.Context, layout types.EventLayout, value []byte) string {
+	decodedValue, err := decodeLCSData(layout.Layout, value)
+	if err != nil {
+		k.GetLogger(ctx).Debug(fmt.Sprintf("resource %q data decoding: %v", layout.EventType, err))
+		return ""
+	}
+
+	return decodedValue
+}
+
+// getPaginationRange converts pagination params to the number of items to skip and to take.
+// Contract: params are checked with the checkPaginationParams (no overflow).
+func getPaginationRange(page, limit sdk.Uint) (skipCnt, takeCnt uint64) {
+	if page.GT(sdk.ZeroUint()) {
+		page = page.SubUint64(1)
+	}
+
+	return page.Mul(limit).Uint64(), limit.Uint64()
+}
+
+// checkPaginationParams checks query pagination params: limit is capped and the number of items to skip must fit uint64.
+func checkPaginationParams(page, limit sdk.Uint) error {
+	if reflect.DeepEqual(page, sdk.Uint{}) || page.IsZero() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "pagination: page: must be GT 0")
+	}
+	if reflect.DeepEqual(limit, sdk.Uint{}) || limit.IsZero() || limit.GT(sdk.NewUint(types.QueryPaginationMaxLimit)) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "pagination: limit: must be in [1, %d] range", types.QueryPaginationMaxLimit)
+	}
+
+	maxPaginationValue := sdk.NewUint(math.MaxUint64)
+	if page.GT(maxPaginationValue) || page.SubUint64(1).Mul(limit).GT(maxPaginationValue) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "pagination: page: (page - 1) * limit overflows %s", maxPaginationValue)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+// +build unit
+
+package keeper
+
+import (
+	"encoding/hex"
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/dfinance/lcs"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/dfinance/dnode/x/common_vm"
+	"github.com/dfinance/dnode/x/vm/internal/types"
+)
+
+// Test account resources and struct type resource holders listing.
+func TestVMKeeper_Resources(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+	ctx, keeper := input.ctx, input.vk
+
+	owner := sdk.AccAddress(randomValue(20))
+	holder1, holder2 := sdk.AccAddress(randomValue(20)), sdk.AccAddress(randomValue(20))
+
+	balanceTag, err := types.ParseStructTypeTag(owner.String() + "::Bank::Balance")
+	require.NoError(t, err)
+	infoTag, err := types.ParseStructTypeTag(owner.String() + "::Bank::Info<u64>")
+	require.NoError(t, err)
+
+	newValue := func(value uint64) []byte {
+		bz, err := lcs.Marshal(struct{ Value uint64 }{Value: value})
+		require.NoError(t, err)
+		return bz
+	}
+
+	// register Balance layout only
+	msg := types.NewMsgRegisterEventLayouts(owner, types.EventLayouts{
+		types.NewEventLayout(balanceTag.String(), types.ViewerRequest{{Name: "value", Type: types.ViewerTypeU64}}),
+	})
+	require.NoError(t, keeper.RegisterEventLayouts(ctx, msg.Layouts))
+
+	// set values
+	var ownerAddr [common_vm.VMAddressLength]byte
+	copy(ownerAddr[:], owner)
+	keeper.SetValue(ctx, &vm_grpc.VMAccessPath{Address: owner, Path: glav.ModuleAccessVector(ownerAddr, "Bank")}, randomValue(32))
+	keeper.SetValue(ctx, &vm_grpc.VMAccessPath{Address: holder1, Path: balanceTag.ResourceAccessPath()}, newValue(1))
+	keeper.SetValue(ctx, &vm_grpc.VMAccessPath{Address: holder1, Path: infoTag.ResourceAccessPath()}, newValue(2))
+	keeper.SetValue(ctx, &vm_grpc.VMAccessPath{Address: holder2, Path: balanceTag.ResourceAccessPath()}, newValue(3))
+
+	// account resources
+	{
+		resources := keeper.GetResources(ctx, holder1, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, resources, 2)
+
+		for _, resource := range resources {
+			require.Equal(t, holder1.String(), resource.Address)
+			switch resource.Path {
+			case hex.EncodeToString(balanceTag.ResourceAccessPath()):
+				require.Equal(t, balanceTag.String(), resource.Type)
+				require.Equal(t, hex.EncodeToString(newValue(1)), resource.Value)
+				require.Equal(t, `{"Value":1}`, resource.DecodedValue)
+			case hex.EncodeToString(infoTag.ResourceAccessPath()):
+				require.Empty(t, resource.Type)
+				require.Empty(t, resource.DecodedValue)
+				require.Equal(t, hex.EncodeToString(newValue(2)), resource.Value)
+			default:
+				t.Fatalf("unexpected resource path: %s", resource.Path)
+			}
+		}
+
+		// modules are skipped
+		require.Empty(t, keeper.GetResources(ctx, owner, sdk.NewUint(1), sdk.NewUint(10)))
+	}
+
+	// account resources: pagination
+	{
+		page1 := keeper.GetResources(ctx, holder1, sdk.NewUint(1), sdk.NewUint(1))
+		require.Len(t, page1, 1)
+		page2 := keeper.GetResources(ctx, holder1, sdk.NewUint(2), sdk.NewUint(1))
+		require.Len(t, page2, 1)
+		require.NotEqual(t, page1[0].Path, page2[0].Path)
+		require.Empty(t, keeper.GetResources(ctx, holder1, sdk.NewUint(3), sdk.NewUint(1)))
+	}
+
+	// resource holders
+	{
+		resources := keeper.GetResourceHolders(ctx, balanceTag, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, resources, 2)
+		require.ElementsMatch(t, []string{holder1.String(), holder2.String()}, []string{resources[0].Address, resources[1].Address})
+		for _, resource := range resources {
+			require.Equal(t, balanceTag.String(), resource.Type)
+			require.NotEmpty(t, resource.DecodedValue)
+		}
+
+		resources = keeper.GetResourceHolders(ctx, infoTag, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, resources, 1)
+		require.Equal(t, holder1.String(), resources[0].Address)
+		require.Equal(t, infoTag.String(), resources[0].Type)
+		require.Empty(t, resources[0].DecodedValue)
+	}
+
+	// resource holders: pagination
+	{
+		require.Len(t, keeper.GetResourceHolders(ctx, balanceTag, sdk.NewUint(2), sdk.NewUint(1)), 1)
+		require.Empty(t, keeper.GetResourceHolders(ctx, balanceTag, sdk.NewUint(3), sdk.NewUint(1)))
+	}
+
+	// account resources: base type layout is not used for the generic struct resource
+	{
+		baseInfoLayout := types.NewEventLayout(types.GetEventBaseType(infoTag.String()), types.ViewerRequest{{Name: "value", Type: types.ViewerTypeU64}})
+		require.NoError(t, keeper.RegisterEventLayouts(ctx, types.EventLayouts{baseInfoLayout}))
+
+		resources := keeper.GetResources(ctx, holder1, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, resources, 2)
+		for _, resource := range resources {
+			if resource.Path == hex.EncodeToString(infoTag.ResourceAccessPath()) {
+				require.Empty(t, resource.Type)
+				require.Empty(t, resource.DecodedValue)
+			}
+		}
+	}
+
+	// account resources: exact type layout
+	{
+		infoLayout := types.NewEventLayout(infoTag.String(), types.ViewerRequest{{Name: "value", Type: types.ViewerTypeU64}})
+		require.NoError(t, keeper.RegisterEventLayouts(ctx, types.EventLayouts{infoLayout}))
+
+		resources := keeper.GetResources(ctx, holder1, sdk.NewUint(1), sdk.NewUint(10))
+		require.Len(t, resources, 2)
+		for _, resource := range resources {
+			if resource.Path == hex.EncodeToString(infoTag.ResourceAccessPath()) {
+				require.Equal(t, infoTag.String(), resource.Type)
+				require.Equal(t, `{"Value":2}`, resource.DecodedValue)
+			}
+		}
+	}
+}
+
+// Test resources / modules queries pagination params check.
+func TestVMKeeper_QueryPagination(t *testing.T) {
+	t.Parallel()
+
+	input := newTestInput(false)
+	defer input.Stop()
+	ctx, keeper := input.ctx, input.vk
+
+	maxLimit := sdk.NewUint(types.QueryPaginationMaxLimit)
+
+	// ok
+	{
+		require.NoError(t, checkPaginationParams(sdk.NewUint(1), sdk.NewUint(1)))
+		require.NoError(t, checkPaginationParams(sdk.NewUint(1), maxLimit))
+		require.NoError(t, checkPaginationParams(sdk.NewUint(math.MaxUint64), sdk.NewUint(1)))
+	}
+
+	// fail: page
+	{
+		require.Error(t, checkPaginationParams(sdk.Uint{}, sdk.NewUint(1)))
+		require.Error(t, checkPaginationParams(sdk.ZeroUint(), sdk.NewUint(1)))
+		require.Error(t, checkPaginationParams(sdk.NewUint(math.MaxUint64).AddUint64(2), sdk.NewUint(1)))
+	}
+
+	// fail: limit
+	{
+		require.Error(t, checkPaginationParams(sdk.NewUint(1), sdk.Uint{}))
+		require.Error(t, checkPaginationParams(sdk.NewUint(1), sdk.ZeroUint()))
+		require.Error(t, checkPaginationParams(sdk.NewUint(1), maxLimit.AddUint64(1)))
+	}
+
+	// fail: page * limit overflow
+	{
+		require.Error(t, checkPaginationParams(sdk.NewUint(math.MaxUint64), sdk.NewUint(2)))
+		require.Error(t, checkPaginationParams(sdk.NewUint(math.MaxUint64/2+2), sdk.NewUint(2)))
+	}
+
+	// querier
+	{
+		querier := NewQuerier(keeper)
+		address := sdk.AccAddress(randomValue(20))
+
+		bz := types.ModuleCdc.MustMarshalJSON(types.ResourcesReq{Address: address, Page: sdk.NewUint(math.MaxUint64), Limit: maxLimit})
+		_, err := querier(ctx, []string{types.QueryResources}, abci.RequestQuery{Data: bz})
+		require.True(t, sdkErrors.ErrInvalidRequest.Is(err))
+
+		bz = types.ModuleCdc.MustMarshalJSON(types.ResourceHoldersReq{StructType: "0x1::Foo::Bar", Page: sdk.NewUint(1), Limit: maxLimit.AddUint64(1)})
+		_, err = querier(ctx, []string{types.QueryResourceHolders}, abci.RequestQuery{Data: bz})
+		require.True(t, sdkErrors.ErrInvalidRequest.Is(err))
+
+		bz = types.ModuleCdc.MustMarshalJSON(types.ModulesReq{Page: sdk.NewUint(1)})
+		_, err = querier(ctx, []string{types.QueryModules}, abci.RequestQuery{Data: bz})
+		require.True(t, sdkErrors.ErrInvalidRequest.Is(err))
+
+		bz = types.ModuleCdc.MustMarshalJSON(types.ResourcesReq{Address: address, Page: sdk.NewUint(1), Limit: maxLimit})
+		_, err = querier(ctx, []string{types.QueryResources}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+	}
+}
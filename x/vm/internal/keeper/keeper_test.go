@@ -21,12 +21,12 @@ func TestVMKeeper_DeployContractMock(t *testing.T) {
 
 	acc := sdk.AccAddress(randomValue(20))
 
-	codeBytes, err := hex.DecodeString(moveCode)
+	codeBytes, err := hex.DecodeString(moveModuleCode)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	msg := types.NewMsgDeployModule(acc, []types.Contract{codeBytes})
+	msg := types.NewMsgDeployModule(acc, []types.Contract{codeBytes}, "")
 
 	err = input.vk.DeployContract(input.ctx, msg)
 	if err != nil {
@@ -35,7 +35,7 @@ func TestVMKeeper_DeployContractMock(t *testing.T) {
 
 	events := input.ctx.EventManager().Events()
 
-	require.Len(t, events, 2)
+	require.Len(t, events, 3)
 
 	require.EqualValues(t, sdk.EventTypeMessage, events[0].Type)
 	require.EqualValues(t, sdk.AttributeKeyModule, events[0].Attributes[0].Key)
@@ -44,6 +44,10 @@ func TestVMKeeper_DeployContractMock(t *testing.T) {
 	require.EqualValues(t, types.EventTypeContractStatus, events[1].Type)
 	require.EqualValues(t, types.AttributeStatus, events[1].Attributes[0].Key)
 	require.EqualValues(t, types.AttributeValueStatusKeep, events[1].Attributes[0].Value)
+
+	require.EqualValues(t, types.EventTypeModulePublish, events[2].Type)
+	require.EqualValues(t, types.AttributeModuleName, events[2].Attributes[1].Key)
+	require.EqualValues(t, "XFI", events[2].Attributes[1].Value)
 }
 
 // Deploy script execute with mocked VM.
@@ -60,7 +64,7 @@ func TestVMKeeper_ExecuteScriptMock(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	msg := types.NewMsgExecuteScript(acc, codeBytes, nil)
+	msg := types.NewMsgExecuteScript(acc, codeBytes, nil, nil)
 
 	err = input.vk.ExecuteScript(input.ctx, msg)
 	if err != nil {
@@ -101,8 +101,14 @@ func (k Keeper) processExecution(ctx sdk.Context, exec *vm_grpc.VMExecuteRespons
 		k.processWriteSet(ctx, exec.WriteSet)
 
 		// emit VM events (panic on "out of gas", emitted events stays in the EventManager)
+		// event data is decoded if event layout is registered
 		for _, vmEvent := range exec.Events {
-			ctx.EventManager().EmitEvent(types.NewMoveEvent(ctx.GasMeter(), vmEvent))
+			event := types.NewMoveEvent(ctx.GasMeter(), vmEvent)
+			if decodedData, ok := k.DecodeMoveEventData(ctx, types.GetMoveEventType(event), vmEvent.EventData); ok {
+				event = types.NewMoveEventWithDecodedData(event, decodedData)
+			}
+
+			ctx.EventManager().EmitEvent(event)
 		}
 	}
 }
@@ -126,8 +132,18 @@ func (k Keeper) processWriteSet(ctx sdk.Context, writeSet []*vm_grpc.VMValue) {
 
 // iterateOverValues iterates over all VM values and processes them with handler (stop when handler returns false).
 func (k Keeper) iterateOverValues(ctx sdk.Context, handler func(accessPath *vm_grpc.VMAccessPath, value []byte) bool) {
+	k.iterateOverPrefixValues(ctx, common_vm.GetPathPrefixKey(), handler)
+}
+
+// iterateOverAddressValues iterates over VM values of the address and processes them with handler (stop when handler returns false).
+func (k Keeper) iterateOverAddressValues(ctx sdk.Context, address []byte, handler func(accessPath *vm_grpc.VMAccessPath, value []byte) bool) {
+	k.iterateOverPrefixValues(ctx, common_vm.GetPathAddressPrefixKey(address), handler)
+}
+
+// iterateOverPrefixValues iterates over VM values with storage key prefix.
+func (k Keeper) iterateOverPrefixValues(ctx sdk.Context, prefix []byte, handler func(accessPath *vm_grpc.VMAccessPath, value []byte) bool) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, common_vm.GetPathPrefixKey())
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dfinance/dvm-proto/go/vm_grpc"
@@ -21,6 +22,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetValue(ctx, k, req)
 		case types.QueryLcsView:
 			return queryLcsView(ctx, k, req)
+		case types.QueryEventLayouts:
+			return queryEventLayouts(ctx, k)
+		case types.QueryResources:
+			return queryResources(ctx, k, req)
+		case types.QueryResourceHolders:
+			return queryResourceHolders(ctx, k, req)
+		case types.QueryModules:
+			return queryModules(ctx, k, req)
+		case types.QueryModule:
+			return queryModule(ctx, k, req)
 		default:
 			return nil, sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unsupported query endpoint %q for module %q", path[0], types.ModuleName)
 		}
@@ -72,3 +83,92 @@ func queryLcsView(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, err
 
 	return []byte(resp), nil
 }
+
+// queryEventLayouts handles eventLayouts query which return all registered Move event layouts.
+func queryEventLayouts(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetEventLayouts(ctx))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "result marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryResources handles resources query which return account resources with pagination.
+func queryResources(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.ResourcesReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+	if err := checkPaginationParams(params.Page, params.Limit); err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetResources(ctx, params.Address, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "result marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryResourceHolders handles resourceHolders query which return struct type resources of all accounts with pagination.
+func queryResourceHolders(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.ResourceHoldersReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+	if err := checkPaginationParams(params.Page, params.Limit); err != nil {
+		return nil, err
+	}
+
+	structTag, err := types.ParseStructTypeTag(params.StructType)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrWrongStructTag, "struct_type %q: %v", params.StructType, err)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetResourceHolders(ctx, structTag, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "result marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryModules handles modules query which return published modules registry entries with pagination.
+func queryModules(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.ModulesReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+	if err := checkPaginationParams(params.Page, params.Limit); err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, k.GetModuleInfos(ctx, params.Address, params.Page, params.Limit))
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "result marshal: %v", err)
+	}
+
+	return res, nil
+}
+
+// queryModule handles module query which return published module registry entry.
+func queryModule(ctx sdk.Context, k Keeper, req abci.RequestQuery) ([]byte, error) {
+	var params types.ModuleReq
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "failed to parse params: %v", err)
+	}
+
+	info, found := k.GetModuleInfo(ctx, params.Address, params.Name)
+	if !found {
+		return nil, sdkErrors.Wrapf(types.ErrNotFound, "module %s::%s", types.StringifySenderAddress(params.Address), params.Name)
+	}
+
+	res, err := codec.MarshalJSONIndent(k.cdc, info)
+	if err != nil {
+		return nil, sdkErrors.Wrapf(types.ErrInternal, "result marshal: %v", err)
+	}
+
+	return res, nil
+}
@@ -59,7 +59,18 @@ func NewDeployContract(address sdk.AccAddress, maxGas sdk.Gas, code []byte) *vm_
 }
 
 // NewExecuteContract creates an object used for script execute requests.
-func NewExecuteContract(address sdk.AccAddress, maxGas sdk.Gas, code []byte, args []types.ScriptArg) (*vm_grpc.VMExecuteScript, error) {
+// Senders order defines the script &signer arguments order.
+func NewExecuteContract(senders []sdk.AccAddress, maxGas sdk.Gas, code []byte, typeArgs []types.StructTypeTag, args []types.ScriptArg) (*vm_grpc.VMExecuteScript, error) {
+	var vmTypeParams []*vm_grpc.StructIdent
+	for _, typeArg := range typeArgs {
+		vmTypeParams = append(vmTypeParams, typeArg.ToVMStructIdent())
+	}
+
+	vmSenders := make([][]byte, len(senders))
+	for senderIdx, sender := range senders {
+		vmSenders[senderIdx] = common_vm.Bech32ToLibra(sender)
+	}
+
 	vmArgs := make([]*vm_grpc.VMArgs, len(args))
 	for argIdx, arg := range args {
 		vmArgs[argIdx] = &vm_grpc.VMArgs{
@@ -69,11 +80,11 @@ func NewExecuteContract(address sdk.AccAddress, maxGas sdk.Gas, code []byte, arg
 	}
 
 	return &vm_grpc.VMExecuteScript{
-		Senders:      [][]byte{common_vm.Bech32ToLibra(address)},
+		Senders:      vmSenders,
 		MaxGasAmount: getVMLimitedGas(maxGas),
 		GasUnitPrice: types.VmGasPrice,
 		Code:         code,
-		TypeParams:   nil,
+		TypeParams:   vmTypeParams,
 		Args:         vmArgs,
 	}, nil
 }
@@ -85,7 +96,17 @@ func NewDeployRequest(ctx sdk.Context, signer sdk.AccAddress, contract types.Con
 
 // NewExecuteRequest is a NewExecuteContract wrapper: create execute request.
 func NewExecuteRequest(ctx sdk.Context, msg types.MsgExecuteScript) (*vm_grpc.VMExecuteScript, error) {
-	contract, err := NewExecuteContract(msg.Signer, GetFreeGas(ctx), msg.Script, msg.Args)
+	contract, err := NewExecuteContract([]sdk.AccAddress{msg.Signer}, GetFreeGas(ctx), msg.Script, msg.TypeArgs, msg.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// NewMultiSignerExecuteRequest is a NewExecuteContract wrapper: create multi signer execute request.
+func NewMultiSignerExecuteRequest(ctx sdk.Context, msg types.MsgExecuteScriptMultiSigner) (*vm_grpc.VMExecuteScript, error) {
+	contract, err := NewExecuteContract(msg.Signers, GetFreeGas(ctx), msg.Script, msg.TypeArgs, msg.Args)
 	if err != nil {
 		return nil, err
 	}
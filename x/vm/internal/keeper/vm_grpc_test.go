@@ -110,7 +110,10 @@ func TestVMKeeper_NewContract(t *testing.T) {
 	require.Equal(t, uint64(types.VmGasPrice), contractModule.GasUnitPrice)
 	require.Equal(t, code, contractModule.Code)
 
-	contractScript, err := NewExecuteContract(addr, maxGas, code, argInputs)
+	typeArg, err := types.ParseStructTypeTag("0x1::Coins::ETH")
+	require.NoError(t, err)
+
+	contractScript, err := NewExecuteContract([]sdk.AccAddress{addr}, maxGas, code, []types.StructTypeTag{typeArg}, argInputs)
 	require.NoError(t, err)
 	require.Equal(t, [][]byte{common_vm.Bech32ToLibra(addr)}, contractScript.Senders)
 	require.Equal(t, maxGas, contractScript.MaxGasAmount)
@@ -121,6 +124,17 @@ func TestVMKeeper_NewContract(t *testing.T) {
 		require.Equal(t, argInputs[i].Type, contractArg.Type)
 		require.Equal(t, argInputs[i].Value, contractArg.Value)
 	}
+	require.Len(t, contractScript.TypeParams, 1)
+	require.Equal(t, common_vm.StdLibAddress, contractScript.TypeParams[0].Address)
+	require.Equal(t, "Coins", contractScript.TypeParams[0].Module)
+	require.Equal(t, "ETH", contractScript.TypeParams[0].Name)
+	require.Empty(t, contractScript.TypeParams[0].TypeParams)
+
+	// multiple senders order is preserved
+	addr2 := sdk.AccAddress(randomValue(common_vm.VMAddressLength))
+	contractScript, err = NewExecuteContract([]sdk.AccAddress{addr2, addr}, maxGas, code, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{common_vm.Bech32ToLibra(addr2), common_vm.Bech32ToLibra(addr)}, contractScript.Senders)
 }
 
 // Create new deploy request.
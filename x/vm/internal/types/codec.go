@@ -11,10 +11,13 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDeployModule{}, ModuleName+"/MsgDeployModule", nil)
 	cdc.RegisterConcrete(MsgExecuteScript{}, ModuleName+"/MsgExecuteScript", nil)
+	cdc.RegisterConcrete(MsgExecuteScriptMultiSigner{}, ModuleName+"/MsgExecuteScriptMultiSigner", nil)
+	cdc.RegisterConcrete(MsgRegisterEventLayouts{}, ModuleName+"/MsgRegisterEventLayouts", nil)
 
 	cdc.RegisterInterface((*PlannedProposal)(nil), nil)
 	cdc.RegisterConcrete(TestProposal{}, ModuleName+"/TestProposal", nil)
 	cdc.RegisterConcrete(StdlibUpdateProposal{}, ModuleName+"/StdlibUpdateProposal", nil)
+	cdc.RegisterConcrete(RegisterEventLayoutsProposal{}, ModuleName+"/RegisterEventLayoutsProposal", nil)
 }
 
 func init() {
@@ -24,4 +27,6 @@ func init() {
 
 	gov.RegisterProposalType(ProposalTypeStdlibUpdate)
 	gov.RegisterProposalTypeCodec(StdlibUpdateProposal{}, GovRouterKey+"/StdlibUpdateProposal")
+	gov.RegisterProposalType(ProposalTypeRegisterEventLayouts)
+	gov.RegisterProposalTypeCodec(RegisterEventLayoutsProposal{}, GovRouterKey+"/RegisterEventLayoutsProposal")
 }
@@ -11,6 +11,11 @@ const (
 	// VM Event to sdk.Event conversion params
 	EventTypeProcessingGas = 10000 // initial gas for processing event type.
 	EventTypeNoGasLevels   = 2     // defines number of nesting levels that do not charge gas
+	// Move event data decoding limits (decoding is skipped if exceeded)
+	EventDataDecodingMaxSize = 2048 // max event LCS data size [bytes]
+	EventDataDecodedMaxSize  = 8192 // max decoded event data JSON size [bytes]
+	// Module bytecode metadata parsing params
+	ModuleMetaParsingGasPerByte = 10 // gas charged per module bytecode byte for metadata parsing on deploy
 )
 
 var (
@@ -13,6 +13,12 @@ var (
 	ErrWrongArgTypeTag        = sdkErrors.Register(ModuleName, 200, "invalid argument type")
 	ErrWrongArgValue          = sdkErrors.Register(ModuleName, 201, "invalid argument value")
 	ErrWrongExecutionResponse = sdkErrors.Register(ModuleName, 202, "wrong execution response from VM")
+	ErrWrongTypeArg           = sdkErrors.Register(ModuleName, 203, "invalid type argument")
+	ErrWrongEventLayout       = sdkErrors.Register(ModuleName, 204, "invalid event layout")
+	ErrWrongStructTag         = sdkErrors.Register(ModuleName, 205, "invalid struct type")
+	ErrWrongUpgradePolicy     = sdkErrors.Register(ModuleName, 206, "invalid module upgrade policy")
+	ErrModuleUpgradeDenied    = sdkErrors.Register(ModuleName, 207, "module upgrade denied")
+	ErrWrongModuleCode        = sdkErrors.Register(ModuleName, 208, "invalid module bytecode")
 
 	ErrGovInvalidProposal = sdkErrors.Register(ModuleName, 500, "invalid proposal")
 )
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	EventLayoutPrefix             = []byte("event_layout")
+	EventLayoutResourcePathPrefix = []byte("event_layout_resource")
+)
+
+// EventLayout defines Move event LCS data layout used to decode event data.
+type EventLayout struct {
+	// Move event struct type (0x1::Coins::DepositEvent, 0x1::Foo::Bar<u64>), generic parameters are optional
+	EventType string `json:"event_type" yaml:"event_type" example:"0x1::Account::SentPaymentEvent"`
+	// LCS viewer request describing the event struct fields
+	Layout ViewerRequest `json:"layout" yaml:"layout"`
+}
+
+// Validate checks that event layout is valid.
+func (l EventLayout) Validate() error {
+	structTag, err := ParseStructTypeTag(l.EventType)
+	if err != nil {
+		return fmt.Errorf("event_type: %w", err)
+	}
+	if structTag.String() != l.EventType {
+		return fmt.Errorf("event_type: %q: non-canonical form, expected %q", l.EventType, structTag.String())
+	}
+
+	if err := l.Layout.Validate(); err != nil {
+		return fmt.Errorf("layout: %w", err)
+	}
+
+	return nil
+}
+
+// Owner returns module address the event type belongs to.
+// Contract: EventLayout is valid.
+func (l EventLayout) Owner() sdk.AccAddress {
+	structTag, _ := ParseStructTypeTag(l.EventType)
+
+	return structTag.Address
+}
+
+func (l EventLayout) String() string {
+	return fmt.Sprintf("EventLayout:\n"+
+		"  EventType: %s\n"+
+		"  Fields:    %d",
+		l.EventType,
+		len(l.Layout),
+	)
+}
+
+// NewEventLayout creates a new EventLayout object.
+func NewEventLayout(eventType string, layout ViewerRequest) EventLayout {
+	return EventLayout{
+		EventType: eventType,
+		Layout:    layout,
+	}
+}
+
+// EventLayouts is a slice of EventLayout objects.
+type EventLayouts []EventLayout
+
+// Validate checks that all layouts are valid and unique.
+func (list EventLayouts) Validate() error {
+	typesSet := make(map[string]bool, len(list))
+	for layoutIdx, layout := range list {
+		if err := layout.Validate(); err != nil {
+			return fmt.Errorf("event_layouts[%d]: %w", layoutIdx, err)
+		}
+		if typesSet[layout.EventType] {
+			return fmt.Errorf("event_layouts[%d]: event_type %q: duplicated", layoutIdx, layout.EventType)
+		}
+		typesSet[layout.EventType] = true
+	}
+
+	return nil
+}
+
+func (list EventLayouts) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString("EventLayouts:\n")
+	for i, layout := range list {
+		strBuilder.WriteString(layout.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// GetEventLayoutKey returns storage key for the event layout.
+func GetEventLayoutKey(eventType string) []byte {
+	return bytes.Join(
+		[][]byte{
+			EventLayoutPrefix,
+			[]byte(eventType),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetEventLayoutPrefixKey returns storage key prefix for event layouts (used for iteration).
+func GetEventLayoutPrefixKey() []byte {
+	return append(EventLayoutPrefix, KeyDelimiter...)
+}
+
+// GetEventLayoutResourcePathKey returns storage key for the struct layout event type by its resource access path.
+func GetEventLayoutResourcePathKey(path []byte) []byte {
+	return bytes.Join(
+		[][]byte{
+			EventLayoutResourcePathPrefix,
+			path,
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetEventBaseType returns event type without generic parameters (0x1::Foo::Bar<u64> -> 0x1::Foo::Bar).
+func GetEventBaseType(eventType string) string {
+	if idx := strings.Index(eventType, "<"); idx >= 0 {
+		return eventType[:idx]
+	}
+
+	return eventType
+}
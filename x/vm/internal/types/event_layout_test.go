@@ -0,0 +1,184 @@
+// +build unit
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/tests/utils"
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// Test ViewerRequest validation.
+func TestVM_ViewerRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	// ok
+	{
+		req := ViewerRequest{
+			{Name: "amount", Type: ViewerTypeU128},
+			{Name: "payee", Type: ViewerTypeAddress},
+			{Name: "metadata", Type: ViewerTypeVector, InnerItem: &ViewerRequest{{Name: "item", Type: ViewerTypeU8}}},
+			{Name: "info", Type: ViewerTypeStruct, InnerItem: &ViewerRequest{{Name: "flag", Type: ViewerTypeBool}}},
+		}
+		require.NoError(t, req.Validate())
+	}
+
+	// fail: empty
+	require.Error(t, ViewerRequest{}.Validate())
+
+	// fail: invalid name
+	require.Error(t, ViewerRequest{{Name: "", Type: ViewerTypeU8}}.Validate())
+	require.Error(t, ViewerRequest{{Name: "1value", Type: ViewerTypeU8}}.Validate())
+
+	// fail: duplicated name (case insensitive)
+	require.Error(t, ViewerRequest{{Name: "value", Type: ViewerTypeU8}, {Name: "Value", Type: ViewerTypeU64}}.Validate())
+
+	// fail: unknown type
+	require.Error(t, ViewerRequest{{Name: "value", Type: "u32"}}.Validate())
+
+	// fail: inner item mismatch
+	require.Error(t, ViewerRequest{{Name: "value", Type: ViewerTypeU8, InnerItem: &ViewerRequest{{Name: "item", Type: ViewerTypeU8}}}}.Validate())
+	require.Error(t, ViewerRequest{{Name: "value", Type: ViewerTypeStruct}}.Validate())
+	require.Error(t, ViewerRequest{{Name: "value", Type: ViewerTypeVector}}.Validate())
+	require.Error(t, ViewerRequest{{Name: "value", Type: ViewerTypeVector, InnerItem: &ViewerRequest{
+		{Name: "item1", Type: ViewerTypeU8},
+		{Name: "item2", Type: ViewerTypeU8},
+	}}}.Validate())
+
+	// fail: nesting level exceeded
+	{
+		req := ViewerRequest{{Name: "value", Type: ViewerTypeU8}}
+		for i := 0; i <= ViewerRequestMaxDepth; i++ {
+			inner := req
+			req = ViewerRequest{{Name: "value", Type: ViewerTypeStruct, InnerItem: &inner}}
+		}
+		require.Error(t, req.Validate())
+	}
+}
+
+// Test EventLayout / EventLayouts validation and helpers.
+func TestVM_EventLayout_Validate(t *testing.T) {
+	t.Parallel()
+
+	layout := ViewerRequest{{Name: "value", Type: ViewerTypeU64}}
+
+	// ok
+	{
+		l := NewEventLayout("0x1::Foo::Bar<u64>", layout)
+		require.NoError(t, l.Validate())
+		require.Equal(t, sdk.AccAddress(common_vm.StdLibAddress), l.Owner())
+	}
+
+	// fail: invalid event type
+	require.Error(t, NewEventLayout("u64", layout).Validate())
+	require.Error(t, NewEventLayout("0x1::Foo", layout).Validate())
+
+	// fail: non-canonical event type
+	require.Error(t, NewEventLayout("0x1::Foo::Bar<u64,u8>", layout).Validate())
+
+	// fail: invalid layout
+	require.Error(t, NewEventLayout("0x1::Foo::Bar", nil).Validate())
+
+	// list: ok
+	{
+		list := EventLayouts{
+			NewEventLayout("0x1::Foo::Bar", layout),
+			NewEventLayout("0x1::Foo::Bar<u64>", layout),
+		}
+		require.NoError(t, list.Validate())
+	}
+
+	// list: fail (duplicated)
+	{
+		list := EventLayouts{
+			NewEventLayout("0x1::Foo::Bar", layout),
+			NewEventLayout("0x1::Foo::Bar", layout),
+		}
+		require.Error(t, list.Validate())
+	}
+
+	// base type
+	require.Equal(t, "0x1::Foo::Bar", GetEventBaseType("0x1::Foo::Bar<u64, vector<0x1::XFI::T>>"))
+	require.Equal(t, "0x1::Foo::Bar", GetEventBaseType("0x1::Foo::Bar"))
+
+	// storage keys
+	require.True(t, strings.HasPrefix(string(GetEventLayoutKey("0x1::Foo::Bar")), string(GetEventLayoutPrefixKey())))
+}
+
+// Test MsgRegisterEventLayouts.
+func TestVM_MsgRegisterEventLayouts(t *testing.T) {
+	t.Parallel()
+
+	owner := make(sdk.AccAddress, common_vm.VMAddressLength)
+	owner[0] = 0x1
+	nonOwner := make(sdk.AccAddress, common_vm.VMAddressLength)
+	nonOwner[0] = 0x2
+
+	layout := NewEventLayout(owner.String()+"::Foo::Bar", ViewerRequest{{Name: "value", Type: ViewerTypeU64}})
+
+	msg := NewMsgRegisterEventLayouts(owner, EventLayouts{layout})
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, MsgRegisterEventLayoutsType, msg.Type())
+	require.Equal(t, []sdk.AccAddress{owner}, msg.GetSigners())
+	require.Equal(t, getMsgSignBytes(t, msg), msg.GetSignBytes())
+
+	// empty signer
+	msg = NewMsgRegisterEventLayouts(sdk.AccAddress{}, EventLayouts{layout})
+	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
+
+	// empty layouts
+	msg = NewMsgRegisterEventLayouts(owner, nil)
+	utils.CheckExpectedErr(t, ErrWrongEventLayout, msg.ValidateBasic())
+
+	// invalid layout
+	msg = NewMsgRegisterEventLayouts(owner, EventLayouts{NewEventLayout(owner.String()+"::Foo::Bar", nil)})
+	utils.CheckExpectedErr(t, ErrWrongEventLayout, msg.ValidateBasic())
+
+	// signer is not the module owner
+	msg = NewMsgRegisterEventLayouts(nonOwner, EventLayouts{layout})
+	utils.CheckExpectedErr(t, sdkErrors.ErrUnauthorized, msg.ValidateBasic())
+}
+
+// Test RegisterEventLayoutsProposal.
+func TestVM_RegisterEventLayoutsProposal(t *testing.T) {
+	t.Parallel()
+
+	request := ViewerRequest{{Name: "value", Type: ViewerTypeU64}}
+	stdlibLayout := NewEventLayout("0x1::Foo::Bar", request)
+
+	// ok
+	{
+		p := NewRegisterEventLayoutsProposal(EventLayouts{stdlibLayout})
+		require.NoError(t, p.ValidateBasic())
+		require.Equal(t, GovRouterKey, p.ProposalRoute())
+		require.Equal(t, ProposalTypeRegisterEventLayouts, p.ProposalType())
+	}
+
+	// empty layouts
+	{
+		p := NewRegisterEventLayoutsProposal(nil)
+		utils.CheckExpectedErr(t, ErrGovInvalidProposal, p.ValidateBasic())
+	}
+
+	// invalid layout
+	{
+		p := NewRegisterEventLayoutsProposal(EventLayouts{NewEventLayout("0x1::Foo::Bar", nil)})
+		utils.CheckExpectedErr(t, ErrGovInvalidProposal, p.ValidateBasic())
+	}
+
+	// non-stdlib layout
+	{
+		owner := make(sdk.AccAddress, common_vm.VMAddressLength)
+		owner[0] = 0x2
+
+		p := NewRegisterEventLayoutsProposal(EventLayouts{stdlibLayout, NewEventLayout(owner.String()+"::Foo::Bar", request)})
+		utils.CheckExpectedErr(t, ErrGovInvalidProposal, p.ValidateBasic())
+	}
+}
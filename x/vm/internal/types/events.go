@@ -12,17 +12,24 @@ import (
 const (
 	EventTypeContractStatus = ModuleName + ".contract_status"
 	EventTypeMoveEvent      = ModuleName + ".contract_events"
+	EventTypeEventLayout    = ModuleName + ".event_layout"
+	EventTypeModulePublish  = ModuleName + ".module_published"
 	//
-	AttributeStatus             = "status"
-	AttributeErrMajorStatus     = "major_status"
-	AttributeErrSubStatus       = "sub_status"
-	AttributeErrMessage         = "message"
-	AttributeErrLocationAddress = "location_address"
-	AttributeErrLocationModule  = "location_module"
-	AttributeVmEventSender      = "sender_address"
-	AttributeVmEventSource      = "source"
-	AttributeVmEventType        = "type"
-	AttributeVmEventData        = "data"
+	AttributeStatus              = "status"
+	AttributeErrMajorStatus      = "major_status"
+	AttributeErrSubStatus        = "sub_status"
+	AttributeErrMessage          = "message"
+	AttributeErrLocationAddress  = "location_address"
+	AttributeErrLocationModule   = "location_module"
+	AttributeVmEventSender       = "sender_address"
+	AttributeVmEventSource       = "source"
+	AttributeVmEventType         = "type"
+	AttributeVmEventData         = "data"
+	AttributeVmEventDataDecoded  = "data_decoded"
+	AttributeModuleAddress       = "address"
+	AttributeModuleName          = "name"
+	AttributeModuleCodeHash      = "code_hash"
+	AttributeModuleUpgradePolicy = "upgrade_policy"
 	//
 	AttributeValueStatusKeep      = "keep"
 	AttributeValueStatusDiscard   = "discard"
@@ -109,3 +116,36 @@ func GetEventSourceAttribute(senderModule *vm_grpc.ModuleIdent) string {
 
 	return fmt.Sprintf(AttributeValueSourceModuleFmt, StringifySenderAddress(senderModule.Address), senderModule.Name)
 }
+
+// NewMoveEventWithDecodedData appends decoded (JSON) event data to the Move SDK event.
+func NewMoveEventWithDecodedData(event sdk.Event, decodedData string) sdk.Event {
+	return event.AppendAttributes(sdk.NewAttribute(AttributeVmEventDataDecoded, decodedData))
+}
+
+// GetMoveEventType returns Move SDK event type attribute value.
+func GetMoveEventType(event sdk.Event) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == AttributeVmEventType {
+			return string(attr.Value)
+		}
+	}
+
+	return ""
+}
+
+// NewEventLayoutRegisteredEvent creates an Event on Move event layout registration.
+func NewEventLayoutRegisteredEvent(layout EventLayout) sdk.Event {
+	return sdk.NewEvent(EventTypeEventLayout,
+		sdk.NewAttribute(AttributeVmEventType, layout.EventType),
+	)
+}
+
+// NewModulePublishedEvent creates an Event on Move module (re)deploy.
+func NewModulePublishedEvent(info ModuleInfo) sdk.Event {
+	return sdk.NewEvent(EventTypeModulePublish,
+		sdk.NewAttribute(AttributeModuleAddress, StringifySenderAddress(info.Address)),
+		sdk.NewAttribute(AttributeModuleName, info.Name),
+		sdk.NewAttribute(AttributeModuleCodeHash, info.CodeHash),
+		sdk.NewAttribute(AttributeModuleUpgradePolicy, string(info.UpgradePolicy)),
+	)
+}
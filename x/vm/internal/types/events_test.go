@@ -311,7 +311,7 @@ func TestVM_OutOfGasProcessEvent(t *testing.T) {
 		EventData: valBytes,
 	}
 
-	require.PanicsWithValue(t, sdk.ErrorOutOfGas{"event type processing"}, func() {
+	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "event type processing"}, func() {
 		NewMoveEvent(sdk.NewGasMeter(1000), &vmEvent)
 	})
 }
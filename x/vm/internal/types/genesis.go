@@ -11,7 +11,9 @@ import (
 
 // GenesisState is module's genesis (initial state).
 type GenesisState struct {
-	WriteSet []GenesisWriteOp `json:"write_set" yaml:"write_set"`
+	WriteSet     []GenesisWriteOp `json:"write_set" yaml:"write_set"`
+	EventLayouts EventLayouts     `json:"event_layouts,omitempty" yaml:"event_layouts,omitempty"`
+	ModuleInfos  ModuleInfos      `json:"module_infos,omitempty" yaml:"module_infos,omitempty"`
 }
 
 // Genesis writeSet operation.
@@ -77,6 +79,14 @@ func (s GenesisState) Validate() error {
 		writeOpsSet[writeOpId] = true
 	}
 
+	if err := s.EventLayouts.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.ModuleInfos.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	ProposalTypeRegisterEventLayouts = "RegisterEventLayouts"
+)
+
+var (
+	_ gov.Content = RegisterEventLayoutsProposal{}
+)
+
+// RegisterEventLayoutsProposal is a gov proposal used to register (override) stdlib Move event layouts.
+// Stdlib (0x1) modules have no owner to sign the MsgRegisterEventLayouts.
+type RegisterEventLayoutsProposal struct {
+	// Stdlib event layouts
+	Layouts EventLayouts `json:"layouts"`
+}
+
+func (p RegisterEventLayoutsProposal) GetTitle() string { return "Register stdlib event layouts" }
+func (p RegisterEventLayoutsProposal) GetDescription() string {
+	return "Registers DVM stdlib Move event layouts used to decode events data"
+}
+func (p RegisterEventLayoutsProposal) ProposalRoute() string { return GovRouterKey }
+func (p RegisterEventLayoutsProposal) ProposalType() string  { return ProposalTypeRegisterEventLayouts }
+
+func (p RegisterEventLayoutsProposal) ValidateBasic() error {
+	if len(p.Layouts) == 0 {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "layouts: empty")
+	}
+	if err := p.Layouts.Validate(); err != nil {
+		return sdkErrors.Wrapf(ErrGovInvalidProposal, "layouts: %v", err)
+	}
+
+	stdlibOwner := sdk.AccAddress(common_vm.StdLibAddress)
+	for layoutIdx, layout := range p.Layouts {
+		if !layout.Owner().Equals(stdlibOwner) {
+			return sdkErrors.Wrapf(ErrGovInvalidProposal, "layouts[%d]: event_type %q: stdlib module expected", layoutIdx, layout.EventType)
+		}
+	}
+
+	return nil
+}
+
+func (p RegisterEventLayoutsProposal) String() string {
+	b := strings.Builder{}
+	b.WriteString("Proposal:\n")
+	b.WriteString(fmt.Sprintf("  Title: %s\n", p.GetTitle()))
+	b.WriteString(fmt.Sprintf("  Description: %s\n", p.GetDescription()))
+	b.WriteString("  Event types:\n")
+	for _, layout := range p.Layouts {
+		b.WriteString(fmt.Sprintf("    %s\n", layout.EventType))
+	}
+
+	return b.String()
+}
+
+// NewRegisterEventLayoutsProposal creates a RegisterEventLayoutsProposal object.
+func NewRegisterEventLayoutsProposal(layouts EventLayouts) gov.Content {
+	return RegisterEventLayoutsProposal{
+		Layouts: layouts,
+	}
+}
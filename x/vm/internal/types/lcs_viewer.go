@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
 type ViewerType string
 
 const (
@@ -10,12 +16,87 @@ const (
 	ViewerTypeAddress ViewerType = "address"
 	ViewerTypeStruct  ViewerType = "struct"
 	ViewerTypeVector  ViewerType = "vector"
+	//
+	// Max nesting level for struct / vector view items
+	ViewerRequestMaxDepth = 8
+)
+
+var (
+	// Struct field name format (field name is converted to the exported Go struct field name)
+	viewerFieldNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
 )
 
 type ViewerRequest []ViewerItem
 
+// Validate checks that request could be used to build LCS viewer struct.
+func (r ViewerRequest) Validate() error {
+	return r.validate(0)
+}
+
+func (r ViewerRequest) validate(depth int) error {
+	if depth > ViewerRequestMaxDepth {
+		return fmt.Errorf("nesting level exceeds %d", ViewerRequestMaxDepth)
+	}
+	if len(r) == 0 {
+		return fmt.Errorf("empty")
+	}
+
+	fieldsSet := make(map[string]bool, len(r))
+	for itemIdx, item := range r {
+		if !viewerFieldNameRegexp.MatchString(item.Name) {
+			return fmt.Errorf("item[%d]: name %q: invalid (should match %s)", itemIdx, item.Name, viewerFieldNameRegexp.String())
+		}
+
+		// viewer converts field names to title case, so names must be unique case insensitive
+		fieldName := strings.ToLower(item.Name)
+		if fieldsSet[fieldName] {
+			return fmt.Errorf("item[%d]: name %q: duplicated", itemIdx, item.Name)
+		}
+		fieldsSet[fieldName] = true
+
+		if err := item.validate(depth); err != nil {
+			return fmt.Errorf("item[%d] (%s): %w", itemIdx, item.Name, err)
+		}
+	}
+
+	return nil
+}
+
 type ViewerItem struct {
 	Name      string         `json:"name"`
 	Type      ViewerType     `json:"type"`
 	InnerItem *ViewerRequest `json:"inner_item"`
 }
+
+func (i ViewerItem) validate(depth int) error {
+	switch i.Type {
+	case ViewerTypeU8, ViewerTypeU64, ViewerTypeU128, ViewerTypeBool, ViewerTypeAddress:
+		if i.InnerItem != nil {
+			return fmt.Errorf("inner_item: non-nil for %q type", i.Type)
+		}
+	case ViewerTypeStruct:
+		if i.InnerItem == nil {
+			return fmt.Errorf("inner_item: nil")
+		}
+		if err := i.InnerItem.validate(depth + 1); err != nil {
+			return fmt.Errorf("inner_item: %w", err)
+		}
+	case ViewerTypeVector:
+		if i.InnerItem == nil {
+			return fmt.Errorf("inner_item: nil")
+		}
+		if len(*i.InnerItem) != 1 {
+			return fmt.Errorf("inner_item: must contain one element")
+		}
+		if depth+1 > ViewerRequestMaxDepth {
+			return fmt.Errorf("nesting level exceeds %d", ViewerRequestMaxDepth)
+		}
+		if err := (*i.InnerItem)[0].validate(depth + 1); err != nil {
+			return fmt.Errorf("inner_item[0]: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown type %q", i.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	// Module can't be redeployed
+	ModuleUpgradePolicyImmutable ModuleUpgradePolicy = "immutable"
+	// Module can be redeployed by the owner if existing structs layouts and functions signatures are kept
+	ModuleUpgradePolicyCompatible ModuleUpgradePolicy = "compatible"
+	// Module can be redeployed by the owner without restrictions
+	ModuleUpgradePolicyOwnerUpgradeable ModuleUpgradePolicy = "owner_upgradeable"
+	//
+	// Policy used if not specified on the first module deploy
+	DefaultModuleUpgradePolicy = ModuleUpgradePolicyOwnerUpgradeable
+)
+
+var (
+	ModuleInfoPrefix = []byte("module_info")
+)
+
+// ModuleUpgradePolicy defines Move module redeploy policy.
+type ModuleUpgradePolicy string
+
+// IsValid checks that policy is known.
+func (p ModuleUpgradePolicy) IsValid() bool {
+	switch p {
+	case ModuleUpgradePolicyImmutable, ModuleUpgradePolicyCompatible, ModuleUpgradePolicyOwnerUpgradeable:
+		return true
+	}
+
+	return false
+}
+
+// IsStricterOrEqual checks if policy restrictions are the same or stricter than the other policy ones.
+// Contract: policies are valid.
+func (p ModuleUpgradePolicy) IsStricterOrEqual(other ModuleUpgradePolicy) bool {
+	return p.strictness() >= other.strictness()
+}
+
+func (p ModuleUpgradePolicy) strictness() int {
+	switch p {
+	case ModuleUpgradePolicyImmutable:
+		return 2
+	case ModuleUpgradePolicyCompatible:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ModuleInfo is a published Move module registry entry.
+type ModuleInfo struct {
+	// Module address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8"`
+	// Module name
+	Name string `json:"name" yaml:"name" example:"Bank"`
+	// Module bytecode SHA256 hash (HEX string)
+	CodeHash string `json:"code_hash" yaml:"code_hash"`
+	// Module (re)deploy tx signer
+	Publisher sdk.AccAddress `json:"publisher" yaml:"publisher" swaggertype:"string" format:"bech32" example:"wallet1jk4ld0uu6wdrj9t8u3gghm9jt583hxx7xp7he8"`
+	// Module (re)deploy block height
+	Height int64 `json:"height" yaml:"height"`
+	// Module upgrade policy
+	UpgradePolicy ModuleUpgradePolicy `json:"upgrade_policy" yaml:"upgrade_policy" example:"owner_upgradeable"`
+}
+
+// Validate checks that module info is valid.
+func (i ModuleInfo) Validate() error {
+	if len(i.Address) != common_vm.VMAddressLength {
+		return fmt.Errorf("address: length mismatch: %d / %d", len(i.Address), common_vm.VMAddressLength)
+	}
+	if !moveIdentifierRegexp.MatchString(i.Name) {
+		return fmt.Errorf("name %q: invalid identifier", i.Name)
+	}
+	if bz, err := hex.DecodeString(i.CodeHash); err != nil || len(bz) != sha256.Size {
+		return fmt.Errorf("code_hash %q: invalid SHA256 HEX string", i.CodeHash)
+	}
+	if i.Publisher.Empty() {
+		return fmt.Errorf("publisher: empty")
+	}
+	if i.Height < 0 {
+		return fmt.Errorf("height: negative")
+	}
+	if !i.UpgradePolicy.IsValid() {
+		return fmt.Errorf("upgrade_policy %q: unknown", i.UpgradePolicy)
+	}
+
+	return nil
+}
+
+// ID returns module Move identifier ({address}::{name}).
+func (i ModuleInfo) ID() string {
+	return fmt.Sprintf("%s::%s", StringifySenderAddress(i.Address), i.Name)
+}
+
+func (i ModuleInfo) String() string {
+	return fmt.Sprintf("ModuleInfo:\n"+
+		"  Address:       %s\n"+
+		"  Name:          %s\n"+
+		"  CodeHash:      %s\n"+
+		"  Publisher:     %s\n"+
+		"  Height:        %d\n"+
+		"  UpgradePolicy: %s",
+		i.Address,
+		i.Name,
+		i.CodeHash,
+		i.Publisher,
+		i.Height,
+		i.UpgradePolicy,
+	)
+}
+
+// NewModuleInfo creates a new ModuleInfo object.
+func NewModuleInfo(address sdk.AccAddress, name string, code []byte, publisher sdk.AccAddress, height int64, policy ModuleUpgradePolicy) ModuleInfo {
+	return ModuleInfo{
+		Address:       address,
+		Name:          name,
+		CodeHash:      GetModuleCodeHash(code),
+		Publisher:     publisher,
+		Height:        height,
+		UpgradePolicy: policy,
+	}
+}
+
+// ModuleInfos is a slice of ModuleInfo objects.
+type ModuleInfos []ModuleInfo
+
+// Validate checks that all module infos are valid and unique.
+func (list ModuleInfos) Validate() error {
+	idsSet := make(map[string]bool, len(list))
+	for infoIdx, info := range list {
+		if err := info.Validate(); err != nil {
+			return fmt.Errorf("module_infos[%d]: %w", infoIdx, err)
+		}
+		if idsSet[info.ID()] {
+			return fmt.Errorf("module_infos[%d]: module %q: duplicated", infoIdx, info.ID())
+		}
+		idsSet[info.ID()] = true
+	}
+
+	return nil
+}
+
+func (list ModuleInfos) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString("ModuleInfos:\n")
+	for i, info := range list {
+		strBuilder.WriteString(info.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// GetModuleCodeHash returns module bytecode SHA256 hash HEX string.
+func GetModuleCodeHash(code []byte) string {
+	hash := sha256.Sum256(code)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// GetModuleInfoKey returns storage key for the module info.
+func GetModuleInfoKey(address sdk.AccAddress, name string) []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleInfoPrefix,
+			address,
+			[]byte(name),
+		},
+		KeyDelimiter,
+	)
+}
+
+// GetModuleInfoPrefixKey returns storage key prefix for module infos (used for iteration).
+func GetModuleInfoPrefixKey() []byte {
+	return append(ModuleInfoPrefix, KeyDelimiter...)
+}
+
+// GetModuleInfoAddressPrefixKey returns storage key prefix for module infos of the address (used for iteration).
+func GetModuleInfoAddressPrefixKey(address sdk.AccAddress) []byte {
+	return bytes.Join(
+		[][]byte{
+			ModuleInfoPrefix,
+			address,
+			{},
+		},
+		KeyDelimiter,
+	)
+}
@@ -0,0 +1,97 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVM_ModuleUpgradePolicy(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, ModuleUpgradePolicyImmutable.IsValid())
+	require.True(t, ModuleUpgradePolicyCompatible.IsValid())
+	require.True(t, ModuleUpgradePolicyOwnerUpgradeable.IsValid())
+	require.False(t, ModuleUpgradePolicy("").IsValid())
+	require.False(t, ModuleUpgradePolicy("unknown").IsValid())
+
+	require.True(t, ModuleUpgradePolicyImmutable.IsStricterOrEqual(ModuleUpgradePolicyImmutable))
+	require.True(t, ModuleUpgradePolicyImmutable.IsStricterOrEqual(ModuleUpgradePolicyCompatible))
+	require.True(t, ModuleUpgradePolicyCompatible.IsStricterOrEqual(ModuleUpgradePolicyOwnerUpgradeable))
+	require.False(t, ModuleUpgradePolicyCompatible.IsStricterOrEqual(ModuleUpgradePolicyImmutable))
+	require.False(t, ModuleUpgradePolicyOwnerUpgradeable.IsStricterOrEqual(ModuleUpgradePolicyCompatible))
+}
+
+func TestVM_ModuleInfo_Validate(t *testing.T) {
+	t.Parallel()
+
+	address := sdk.AccAddress(make([]byte, 20))
+	newInfo := func() ModuleInfo {
+		return NewModuleInfo(address, "Module", []byte{0x1, 0x2}, address, 1, ModuleUpgradePolicyCompatible)
+	}
+
+	// ok
+	{
+		info := newInfo()
+		require.NoError(t, info.Validate())
+		require.Equal(t, GetModuleCodeHash([]byte{0x1, 0x2}), info.CodeHash)
+		require.Len(t, info.CodeHash, 64)
+	}
+
+	// fail: address
+	{
+		info := newInfo()
+		info.Address = sdk.AccAddress([]byte("addr"))
+		require.Error(t, info.Validate())
+	}
+
+	// fail: name
+	{
+		info := newInfo()
+		info.Name = "1Module"
+		require.Error(t, info.Validate())
+	}
+
+	// fail: code hash
+	{
+		info := newInfo()
+		info.CodeHash = "0102"
+		require.Error(t, info.Validate())
+	}
+
+	// fail: publisher
+	{
+		info := newInfo()
+		info.Publisher = sdk.AccAddress{}
+		require.Error(t, info.Validate())
+	}
+
+	// fail: height
+	{
+		info := newInfo()
+		info.Height = -1
+		require.Error(t, info.Validate())
+	}
+
+	// fail: upgrade policy
+	{
+		info := newInfo()
+		info.UpgradePolicy = ""
+		require.Error(t, info.Validate())
+	}
+
+	// ok: infos
+	{
+		info1, info2 := newInfo(), newInfo()
+		info2.Name = "Module2"
+		require.NoError(t, ModuleInfos{info1, info2}.Validate())
+	}
+
+	// fail: infos duplicated
+	{
+		require.Error(t, ModuleInfos{newInfo(), newInfo()}.Validate())
+	}
+}
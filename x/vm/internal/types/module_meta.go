@@ -0,0 +1,469 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// Move module bytecode table types (only ones required to build ModuleMeta).
+const (
+	moduleTableModuleHandles      byte = 0x1
+	moduleTableStructHandles      byte = 0x2
+	moduleTableFunctionHandles    byte = 0x3
+	moduleTableSignatures         byte = 0x5
+	moduleTableIdentifiers        byte = 0x7
+	moduleTableAddressIdentifiers byte = 0x8
+	moduleTableStructDefs         byte = 0xA
+)
+
+// Move module bytecode signature token types.
+const (
+	moduleTokenBool          byte = 0x1
+	moduleTokenU8            byte = 0x2
+	moduleTokenU64           byte = 0x3
+	moduleTokenU128          byte = 0x4
+	moduleTokenAddress       byte = 0x5
+	moduleTokenReference     byte = 0x6
+	moduleTokenMutReference  byte = 0x7
+	moduleTokenStruct        byte = 0x8
+	moduleTokenTypeParameter byte = 0x9
+	moduleTokenVector        byte = 0xA
+	moduleTokenStructInst    byte = 0xB
+	moduleTokenSigner        byte = 0xC
+)
+
+// Move module bytecode struct flags.
+const (
+	moduleStructResource       byte = 0x1
+	moduleStructNormal         byte = 0x2
+	moduleStructFieldsNative   byte = 0x1
+	moduleStructFieldsDeclared byte = 0x2
+)
+
+var (
+	// Move bytecode magic prefix
+	moduleMagic = []byte{0xA1, 0x1C, 0xEB, 0x0B}
+)
+
+// ModuleMeta defines Move module metadata parsed from the module bytecode.
+// Struct layouts and function signatures are used to check module upgrade compatibility.
+type ModuleMeta struct {
+	// Module address
+	Address []byte
+	// Module name
+	Name string
+	// Struct name -> struct layout (resource flag, type parameters kinds, fields)
+	Structs map[string]string
+	// Function name -> function signature (type parameters kinds, parameters, return types)
+	Functions map[string]string
+}
+
+// CheckUpgradeCompatibility checks that new module version keeps all existing structs layouts and
+// functions signatures (new structs and functions could be added).
+func (m ModuleMeta) CheckUpgradeCompatibility(newMeta ModuleMeta) error {
+	if m.Name != newMeta.Name {
+		return fmt.Errorf("module name mismatch: %q / %q", m.Name, newMeta.Name)
+	}
+
+	for _, name := range sortedMapKeys(m.Structs) {
+		newLayout, found := newMeta.Structs[name]
+		if !found {
+			return fmt.Errorf("struct %q: removed", name)
+		}
+		if newLayout != m.Structs[name] {
+			return fmt.Errorf("struct %q: layout changed: %q -> %q", name, m.Structs[name], newLayout)
+		}
+	}
+
+	for _, name := range sortedMapKeys(m.Functions) {
+		newSignature, found := newMeta.Functions[name]
+		if !found {
+			return fmt.Errorf("function %q: removed", name)
+		}
+		if newSignature != m.Functions[name] {
+			return fmt.Errorf("function %q: signature changed: %q -> %q", name, m.Functions[name], newSignature)
+		}
+	}
+
+	return nil
+}
+
+// moduleStructHandle is a bytecode struct handle.
+type moduleStructHandle struct {
+	ModuleIdx  uint64
+	NameIdx    uint64
+	IsResource bool
+	Kinds      []byte
+}
+
+// moduleFunctionHandle is a bytecode function handle.
+type moduleFunctionHandle struct {
+	ModuleIdx  uint64
+	NameIdx    uint64
+	ParamsIdx  uint64
+	ReturnsIdx uint64
+	Kinds      []byte
+}
+
+// moduleTable is a bytecode table header.
+type moduleTable struct {
+	Offset uint64
+	Length uint64
+}
+
+// ParseModuleMeta parses Move module bytecode and builds ModuleMeta.
+func ParseModuleMeta(code []byte) (retMeta ModuleMeta, retErr error) {
+	r := moduleReader{data: code}
+
+	// header
+	if magic := r.readBytes(uint64(len(moduleMagic))); !bytes.Equal(magic, moduleMagic) {
+		return ModuleMeta{}, fmt.Errorf("invalid magic")
+	}
+	r.readBytes(4) // version
+
+	tablesCnt := r.readUleb()
+	tables := make(map[byte]moduleTable, tablesCnt)
+	for i := uint64(0); i < tablesCnt && r.err == nil; i++ {
+		kind := r.readByte()
+		table := moduleTable{Offset: r.readUleb(), Length: r.readUleb()}
+		if _, found := tables[kind]; found {
+			return ModuleMeta{}, fmt.Errorf("table 0x%X: duplicated", kind)
+		}
+		tables[kind] = table
+	}
+	if r.err != nil {
+		return ModuleMeta{}, fmt.Errorf("header: %w", r.err)
+	}
+
+	// tables
+	tablesStart, tablesEnd := uint64(r.pos), uint64(r.pos)
+	for kind, table := range tables {
+		end := tablesStart + table.Offset + table.Length
+		if end < tablesStart || end > uint64(len(code)) {
+			return ModuleMeta{}, fmt.Errorf("table 0x%X: out of bounds", kind)
+		}
+		if end > tablesEnd {
+			tablesEnd = end
+		}
+	}
+
+	readTable := func(kind byte, itemReader func(r *moduleReader)) error {
+		table, found := tables[kind]
+		if !found {
+			return nil
+		}
+
+		start := tablesStart + table.Offset
+		tableReader := moduleReader{data: code[start : start+table.Length]}
+		for tableReader.pos < len(tableReader.data) && tableReader.err == nil {
+			itemReader(&tableReader)
+		}
+		if tableReader.err != nil {
+			return fmt.Errorf("table 0x%X: %w", kind, tableReader.err)
+		}
+
+		return nil
+	}
+
+	var identifiers []string
+	var addresses [][]byte
+	var moduleHandles [][2]uint64
+	var structHandles []moduleStructHandle
+	var functionHandles []moduleFunctionHandle
+	var signatures [][]moduleToken
+	var structDefs []moduleStructDef
+
+	if err := readTable(moduleTableIdentifiers, func(r *moduleReader) {
+		identifiers = append(identifiers, string(r.readBytes(r.readUleb())))
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+	if err := readTable(moduleTableAddressIdentifiers, func(r *moduleReader) {
+		addresses = append(addresses, r.readBytes(common_vm.VMAddressLength))
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+	if err := readTable(moduleTableModuleHandles, func(r *moduleReader) {
+		moduleHandles = append(moduleHandles, [2]uint64{r.readUleb(), r.readUleb()})
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+	if err := readTable(moduleTableStructHandles, func(r *moduleReader) {
+		handle := moduleStructHandle{ModuleIdx: r.readUleb(), NameIdx: r.readUleb()}
+		switch resourceFlag := r.readByte(); resourceFlag {
+		case moduleStructResource:
+			handle.IsResource = true
+		case moduleStructNormal:
+		default:
+			r.setErr(fmt.Errorf("struct handle: unknown resource flag 0x%X", resourceFlag))
+		}
+		handle.Kinds = r.readKinds()
+		structHandles = append(structHandles, handle)
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+	if err := readTable(moduleTableFunctionHandles, func(r *moduleReader) {
+		functionHandles = append(functionHandles, moduleFunctionHandle{
+			ModuleIdx:  r.readUleb(),
+			NameIdx:    r.readUleb(),
+			ParamsIdx:  r.readUleb(),
+			ReturnsIdx: r.readUleb(),
+			Kinds:      r.readKinds(),
+		})
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+	if err := readTable(moduleTableSignatures, func(r *moduleReader) {
+		tokensCnt := r.readUleb()
+		tokens := make([]moduleToken, 0)
+		for i := uint64(0); i < tokensCnt && r.err == nil; i++ {
+			tokens = append(tokens, r.readToken(0))
+		}
+		signatures = append(signatures, tokens)
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+	if err := readTable(moduleTableStructDefs, func(r *moduleReader) {
+		def := moduleStructDef{HandleIdx: r.readUleb()}
+		switch fieldsType := r.readByte(); fieldsType {
+		case moduleStructFieldsNative:
+			def.IsNative = true
+		case moduleStructFieldsDeclared:
+			fieldsCnt := r.readUleb()
+			for i := uint64(0); i < fieldsCnt && r.err == nil; i++ {
+				def.Fields = append(def.Fields, moduleStructField{NameIdx: r.readUleb(), Type: r.readToken(0)})
+			}
+		default:
+			r.setErr(fmt.Errorf("struct def: unknown fields type 0x%X", fieldsType))
+		}
+		structDefs = append(structDefs, def)
+	}); err != nil {
+		return ModuleMeta{}, err
+	}
+
+	// self module handle index follows tables (0 if omitted)
+	selfIdx := uint64(0)
+	if tablesEnd < uint64(len(code)) {
+		r.pos = int(tablesEnd)
+		selfIdx = r.readUleb()
+		if r.err != nil {
+			return ModuleMeta{}, fmt.Errorf("self module handle index: %w", r.err)
+		}
+		if r.pos != len(code) {
+			return ModuleMeta{}, fmt.Errorf("trailing bytes: %d", len(code)-r.pos)
+		}
+	}
+
+	// build meta (index out of range panics are converted to an error)
+	defer func() {
+		if rec := recover(); rec != nil {
+			retErr = fmt.Errorf("invalid index: %v", rec)
+		}
+	}()
+
+	strModule := func(idx uint64) string {
+		handle := moduleHandles[idx]
+		return fmt.Sprintf("%s::%s", hex.EncodeToString(addresses[handle[0]]), identifiers[handle[1]])
+	}
+	var strToken func(token moduleToken) string
+	strToken = func(token moduleToken) string {
+		switch token.Type {
+		case moduleTokenReference:
+			return "&" + strToken(token.Params[0])
+		case moduleTokenMutReference:
+			return "&mut " + strToken(token.Params[0])
+		case moduleTokenVector:
+			return "vector<" + strToken(token.Params[0]) + ">"
+		case moduleTokenTypeParameter:
+			return fmt.Sprintf("T%d", token.Idx)
+		case moduleTokenStruct, moduleTokenStructInst:
+			handle := structHandles[token.Idx]
+			str := strModule(handle.ModuleIdx) + "::" + identifiers[handle.NameIdx]
+			if token.Type == moduleTokenStruct {
+				return str
+			}
+			params := make([]string, 0, len(token.Params))
+			for _, param := range token.Params {
+				params = append(params, strToken(param))
+			}
+			return str + "<" + strings.Join(params, ", ") + ">"
+		default:
+			return moduleTokenPrimitives[token.Type]
+		}
+	}
+	strTokens := func(tokens []moduleToken) string {
+		strs := make([]string, 0, len(tokens))
+		for _, token := range tokens {
+			strs = append(strs, strToken(token))
+		}
+		return strings.Join(strs, ", ")
+	}
+
+	selfHandle := moduleHandles[selfIdx]
+	meta := ModuleMeta{
+		Address:   addresses[selfHandle[0]],
+		Name:      identifiers[selfHandle[1]],
+		Structs:   make(map[string]string, len(structDefs)),
+		Functions: make(map[string]string),
+	}
+
+	for _, def := range structDefs {
+		handle := structHandles[def.HandleIdx]
+		layout := fmt.Sprintf("resource: %v, kinds: %v, ", handle.IsResource, handle.Kinds)
+		if def.IsNative {
+			layout += "native"
+		} else {
+			fields := make([]string, 0, len(def.Fields))
+			for _, field := range def.Fields {
+				fields = append(fields, identifiers[field.NameIdx]+": "+strToken(field.Type))
+			}
+			layout += "fields: {" + strings.Join(fields, ", ") + "}"
+		}
+		meta.Structs[identifiers[handle.NameIdx]] = layout
+	}
+
+	for _, handle := range functionHandles {
+		if handle.ModuleIdx != selfIdx {
+			continue
+		}
+		meta.Functions[identifiers[handle.NameIdx]] = fmt.Sprintf("kinds: %v, params: (%s), returns: (%s)",
+			handle.Kinds,
+			strTokens(signatures[handle.ParamsIdx]),
+			strTokens(signatures[handle.ReturnsIdx]),
+		)
+	}
+
+	return meta, nil
+}
+
+// moduleToken is a bytecode signature token.
+type moduleToken struct {
+	Type byte
+	// Struct handle index / type parameter index
+	Idx uint64
+	// Reference / vector inner type, struct instantiation type parameters
+	Params []moduleToken
+}
+
+// moduleStructField is a bytecode struct field definition.
+type moduleStructField struct {
+	NameIdx uint64
+	Type    moduleToken
+}
+
+// moduleStructDef is a bytecode struct definition.
+type moduleStructDef struct {
+	HandleIdx uint64
+	IsNative  bool
+	Fields    []moduleStructField
+}
+
+var moduleTokenPrimitives = map[byte]string{
+	moduleTokenBool:    "bool",
+	moduleTokenU8:      "u8",
+	moduleTokenU64:     "u64",
+	moduleTokenU128:    "u128",
+	moduleTokenAddress: "address",
+	moduleTokenSigner:  "signer",
+}
+
+// moduleReader reads Move bytecode, the first error stops reading.
+type moduleReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *moduleReader) setErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *moduleReader) readBytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.data)-r.pos) {
+		r.setErr(fmt.Errorf("unexpected end of data at %d", r.pos))
+		return nil
+	}
+
+	bz := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+
+	return bz
+}
+
+func (r *moduleReader) readByte() byte {
+	bz := r.readBytes(1)
+	if bz == nil {
+		return 0
+	}
+
+	return bz[0]
+}
+
+func (r *moduleReader) readUleb() uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	value, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 {
+		r.setErr(fmt.Errorf("invalid ULEB128 value at %d", r.pos))
+		return 0
+	}
+	r.pos += n
+
+	return value
+}
+
+func (r *moduleReader) readKinds() []byte {
+	kindsCnt := r.readUleb()
+
+	return r.readBytes(kindsCnt)
+}
+
+func (r *moduleReader) readToken(depth int) moduleToken {
+	if depth > TypeTagMaxDepth*2 {
+		r.setErr(fmt.Errorf("signature token nesting level exceeded"))
+		return moduleToken{}
+	}
+
+	token := moduleToken{Type: r.readByte()}
+	switch token.Type {
+	case moduleTokenBool, moduleTokenU8, moduleTokenU64, moduleTokenU128, moduleTokenAddress, moduleTokenSigner:
+	case moduleTokenReference, moduleTokenMutReference, moduleTokenVector:
+		token.Params = []moduleToken{r.readToken(depth + 1)}
+	case moduleTokenStruct, moduleTokenTypeParameter:
+		token.Idx = r.readUleb()
+	case moduleTokenStructInst:
+		token.Idx = r.readUleb()
+		paramsCnt := r.readUleb()
+		for i := uint64(0); i < paramsCnt && r.err == nil; i++ {
+			token.Params = append(token.Params, r.readToken(depth+1))
+		}
+	default:
+		r.setErr(fmt.Errorf("unknown signature token type 0x%X at %d", token.Type, r.pos-1))
+	}
+
+	return token
+}
+
+// sortedMapKeys returns sorted map keys (deterministic iteration).
+func sortedMapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,134 @@
+// +build unit
+
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// Stdlib 0x1::XFI module (struct T { dummy_field: bool })
+	testModuleXFICode = "a11ceb0b01000000050100020202040706120818140a2c050000000102000358464901540b64756d6d795f6669656c640000000000000000000000000000000000000001000201020100"
+	// Stdlib 0x1::Event module (native fun emit<T: copyable>(account: &signer, msg: T))
+	testModuleEventCode = "a11ceb0b0100000006010002030206050806070e0b0819140c2d03000000010001010202060c090000054576656e7404656d6974000000000000000000000000000000000000000100030000"
+)
+
+func TestVM_ParseModuleMeta(t *testing.T) {
+	t.Parallel()
+
+	decodeCode := func(codeHex string) []byte {
+		code, err := hex.DecodeString(codeHex)
+		require.NoError(t, err)
+		return code
+	}
+
+	// ok: struct
+	{
+		meta, err := ParseModuleMeta(decodeCode(testModuleXFICode))
+		require.NoError(t, err)
+
+		require.Equal(t, "XFI", meta.Name)
+		require.Len(t, meta.Address, 20)
+		require.EqualValues(t, 1, meta.Address[19])
+		require.Len(t, meta.Structs, 1)
+		require.Contains(t, meta.Structs, "T")
+		require.Contains(t, meta.Structs["T"], "dummy_field: bool")
+		require.Empty(t, meta.Functions)
+	}
+
+	// ok: function
+	{
+		meta, err := ParseModuleMeta(decodeCode(testModuleEventCode))
+		require.NoError(t, err)
+
+		require.Equal(t, "Event", meta.Name)
+		require.Empty(t, meta.Structs)
+		require.Len(t, meta.Functions, 1)
+		require.Contains(t, meta.Functions, "emit")
+	}
+
+	// fail: empty
+	{
+		_, err := ParseModuleMeta(nil)
+		require.Error(t, err)
+	}
+
+	// fail: wrong magic
+	{
+		code := decodeCode(testModuleXFICode)
+		code[0] = 0xFF
+		_, err := ParseModuleMeta(code)
+		require.Error(t, err)
+	}
+
+	// fail: truncated
+	{
+		code := decodeCode(testModuleXFICode)
+		_, err := ParseModuleMeta(code[:len(code)-10])
+		require.Error(t, err)
+	}
+
+	// fail: trailing bytes
+	{
+		code := decodeCode(testModuleXFICode)
+		_, err := ParseModuleMeta(append(code, 0x00))
+		require.Error(t, err)
+	}
+}
+
+func TestVM_ModuleMeta_CheckUpgradeCompatibility(t *testing.T) {
+	t.Parallel()
+
+	xfiCode, err := hex.DecodeString(testModuleXFICode)
+	require.NoError(t, err)
+	xfiMeta, err := ParseModuleMeta(xfiCode)
+	require.NoError(t, err)
+
+	eventCode, err := hex.DecodeString(testModuleEventCode)
+	require.NoError(t, err)
+	eventMeta, err := ParseModuleMeta(eventCode)
+	require.NoError(t, err)
+
+	// ok: same module
+	require.NoError(t, xfiMeta.CheckUpgradeCompatibility(xfiMeta))
+	require.NoError(t, eventMeta.CheckUpgradeCompatibility(eventMeta))
+
+	// fail: module name changed
+	require.Error(t, xfiMeta.CheckUpgradeCompatibility(eventMeta))
+
+	// fail: struct field type changed (bool -> u8)
+	{
+		code := make([]byte, len(xfiCode))
+		copy(code, xfiCode)
+		code[len(code)-2] = 0x02
+
+		newMeta, err := ParseModuleMeta(code)
+		require.NoError(t, err)
+		require.Contains(t, newMeta.Structs["T"], "dummy_field: u8")
+		require.Error(t, xfiMeta.CheckUpgradeCompatibility(newMeta))
+	}
+
+	// fail: struct removed
+	{
+		newMeta := xfiMeta
+		newMeta.Structs = map[string]string{}
+		require.Error(t, xfiMeta.CheckUpgradeCompatibility(newMeta))
+	}
+
+	// ok: function added
+	{
+		newMeta := eventMeta
+		newMeta.Functions = map[string]string{"emit": eventMeta.Functions["emit"], "new_fn": "kinds: [], params: (), returns: ()"}
+		require.NoError(t, eventMeta.CheckUpgradeCompatibility(newMeta))
+	}
+
+	// fail: function signature changed
+	{
+		newMeta := eventMeta
+		newMeta.Functions = map[string]string{"emit": "kinds: [], params: (), returns: ()"}
+		require.Error(t, eventMeta.CheckUpgradeCompatibility(newMeta))
+	}
+}
@@ -0,0 +1,201 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// MoveEventsFilter defines past Move events search filter (used to build Tendermint tx search query).
+type MoveEventsFilter struct {
+	// Move event type (0x1::Account::SentPaymentEvent), optional if Sender is set
+	EventType string `json:"event_type" yaml:"event_type"`
+	// Move event sender (0x1 / Bech32), optional if EventType is set
+	Sender string `json:"sender" yaml:"sender"`
+	// Search range start height (inclusive), optional
+	FromHeight int64 `json:"from_height" yaml:"from_height"`
+	// Search range end height (inclusive), optional
+	ToHeight int64 `json:"to_height" yaml:"to_height"`
+}
+
+// Validate checks that filter is valid.
+func (f MoveEventsFilter) Validate() error {
+	if f.EventType == "" && f.Sender == "" {
+		return fmt.Errorf("event_type / sender: at least one should be set")
+	}
+	if f.FromHeight < 0 {
+		return fmt.Errorf("from_height: negative")
+	}
+	if f.ToHeight < 0 {
+		return fmt.Errorf("to_height: negative")
+	}
+	if f.ToHeight > 0 && f.FromHeight > f.ToHeight {
+		return fmt.Errorf("from_height / to_height: invalid range [%d, %d]", f.FromHeight, f.ToHeight)
+	}
+
+	return nil
+}
+
+// TxSearchEvents returns Tendermint tx search query conditions.
+// Contract: filter is valid.
+func (f MoveEventsFilter) TxSearchEvents() []string {
+	var events []string
+	if f.EventType != "" {
+		events = append(events, fmt.Sprintf("%s.%s='%s'", EventTypeMoveEvent, AttributeVmEventType, f.EventType))
+	}
+	if f.Sender != "" {
+		events = append(events, fmt.Sprintf("%s.%s='%s'", EventTypeMoveEvent, AttributeVmEventSender, f.Sender))
+	}
+	if f.FromHeight > 0 {
+		events = append(events, fmt.Sprintf("%s>=%d", tmTypes.TxHeightKey, f.FromHeight))
+	}
+	if f.ToHeight > 0 {
+		events = append(events, fmt.Sprintf("%s<=%d", tmTypes.TxHeightKey, f.ToHeight))
+	}
+
+	return events
+}
+
+// Match checks if Move event matches the filter (tx might include other events).
+func (f MoveEventsFilter) Match(event TxMoveEvent) bool {
+	if f.EventType != "" && event.Type != f.EventType {
+		return false
+	}
+	if f.Sender != "" && event.Sender != f.Sender {
+		return false
+	}
+
+	return true
+}
+
+// NewMoveEventsFilter creates a new MoveEventsFilter object normalizing event type and sender.
+func NewMoveEventsFilter(eventType, sender string, fromHeight, toHeight int64) (MoveEventsFilter, error) {
+	filter := MoveEventsFilter{
+		FromHeight: fromHeight,
+		ToHeight:   toHeight,
+	}
+
+	if eventType != "" {
+		typeTag, err := ParseTypeTag(eventType)
+		if err != nil {
+			return MoveEventsFilter{}, fmt.Errorf("event_type: %w", err)
+		}
+		filter.EventType = typeTag.String()
+	}
+
+	if sender != "" {
+		senderAddr, err := ParseVMAddress(sender)
+		if err != nil {
+			return MoveEventsFilter{}, fmt.Errorf("sender: %w", err)
+		}
+		filter.Sender = StringifySenderAddress(senderAddr)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return MoveEventsFilter{}, err
+	}
+
+	return filter, nil
+}
+
+// TxMoveEvent is a Move event emitted by a tx.
+type TxMoveEvent struct {
+	// Tx block height
+	Height int64 `json:"height" yaml:"height"`
+	// Tx hash
+	TxHash string `json:"tx_hash" yaml:"tx_hash"`
+	// Event sender address
+	Sender string `json:"sender" yaml:"sender"`
+	// Event source (script / module)
+	Source string `json:"source" yaml:"source"`
+	// Event type
+	Type string `json:"type" yaml:"type"`
+	// Event raw LCS data (HEX string)
+	Data string `json:"data" yaml:"data"`
+	// Event decoded data (JSON string), empty if event layout was not registered
+	DecodedData string `json:"data_decoded,omitempty" yaml:"data_decoded,omitempty"`
+}
+
+func (e TxMoveEvent) String() string {
+	return fmt.Sprintf("TxMoveEvent:\n"+
+		"  Height:      %d\n"+
+		"  TxHash:      %s\n"+
+		"  Sender:      %s\n"+
+		"  Source:      %s\n"+
+		"  Type:        %s\n"+
+		"  Data:        %s\n"+
+		"  DecodedData: %s",
+		e.Height,
+		e.TxHash,
+		e.Sender,
+		e.Source,
+		e.Type,
+		e.Data,
+		e.DecodedData,
+	)
+}
+
+// TxMoveEvents is a slice of TxMoveEvent objects.
+type TxMoveEvents []TxMoveEvent
+
+func (list TxMoveEvents) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString("TxMoveEvents:\n")
+	for i, event := range list {
+		strBuilder.WriteString(event.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// NewTxMoveEventsFromABCILogs converts SDK TxResponse log events to TxMoveEvents filtering them.
+// ABCI logs merge same type events attributes, so a new Move event starts with the sender attribute.
+func NewTxMoveEventsFromABCILogs(tx sdk.TxResponse, filter MoveEventsFilter) TxMoveEvents {
+	events := make(TxMoveEvents, 0)
+
+	for _, log := range tx.Logs {
+		for _, event := range log.Events {
+			if event.Type != EventTypeMoveEvent {
+				continue
+			}
+
+			var moveEvent *TxMoveEvent
+			appendEvent := func() {
+				if moveEvent != nil && filter.Match(*moveEvent) {
+					events = append(events, *moveEvent)
+				}
+			}
+
+			for _, attr := range event.Attributes {
+				if attr.Key == AttributeVmEventSender {
+					appendEvent()
+					moveEvent = &TxMoveEvent{Height: tx.Height, TxHash: tx.TxHash}
+				}
+				if moveEvent == nil {
+					continue
+				}
+
+				switch attr.Key {
+				case AttributeVmEventSender:
+					moveEvent.Sender = attr.Value
+				case AttributeVmEventSource:
+					moveEvent.Source = attr.Value
+				case AttributeVmEventType:
+					moveEvent.Type = attr.Value
+				case AttributeVmEventData:
+					moveEvent.Data = attr.Value
+				case AttributeVmEventDataDecoded:
+					moveEvent.DecodedData = attr.Value
+				}
+			}
+			appendEvent()
+		}
+	}
+
+	return events
+}
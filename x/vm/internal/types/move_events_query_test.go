@@ -0,0 +1,130 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// Test MoveEventsFilter building and validation.
+func TestVM_MoveEventsFilter(t *testing.T) {
+	t.Parallel()
+
+	sender := make(sdk.AccAddress, common_vm.VMAddressLength)
+	sender[0] = 0x2
+
+	// ok: normalized
+	{
+		filter, err := NewMoveEventsFilter("0x1::Foo::Bar<u64,u8>", "0x1", 10, 20)
+		require.NoError(t, err)
+		require.Equal(t, "0x1::Foo::Bar<u64, u8>", filter.EventType)
+		require.Equal(t, common_vm.StdLibAddressShortStr, filter.Sender)
+		require.Equal(t, []string{
+			EventTypeMoveEvent + "." + AttributeVmEventType + "='0x1::Foo::Bar<u64, u8>'",
+			EventTypeMoveEvent + "." + AttributeVmEventSender + "='0x1'",
+			"tx.height>=10",
+			"tx.height<=20",
+		}, filter.TxSearchEvents())
+	}
+
+	// ok: sender only (Bech32)
+	{
+		filter, err := NewMoveEventsFilter("", sender.String(), 0, 0)
+		require.NoError(t, err)
+		require.Equal(t, sender.String(), filter.Sender)
+		require.Len(t, filter.TxSearchEvents(), 1)
+	}
+
+	// fail: no type and sender
+	{
+		_, err := NewMoveEventsFilter("", "", 0, 0)
+		require.Error(t, err)
+	}
+
+	// fail: invalid inputs
+	{
+		_, err := NewMoveEventsFilter("0x1::Foo", "", 0, 0)
+		require.Error(t, err)
+
+		_, err = NewMoveEventsFilter("", "invalid", 0, 0)
+		require.Error(t, err)
+	}
+
+	// fail: invalid range
+	{
+		_, err := NewMoveEventsFilter("u64", "", -1, 0)
+		require.Error(t, err)
+
+		_, err = NewMoveEventsFilter("u64", "", 20, 10)
+		require.Error(t, err)
+	}
+}
+
+// Test Move events extraction from tx ABCI logs.
+func TestVM_NewTxMoveEventsFromABCILogs(t *testing.T) {
+	t.Parallel()
+
+	newAttrs := func(sender, eventType, data string) []sdk.Attribute {
+		return []sdk.Attribute{
+			{Key: AttributeVmEventSender, Value: sender},
+			{Key: AttributeVmEventSource, Value: AttributeValueSourceScript},
+			{Key: AttributeVmEventType, Value: eventType},
+			{Key: AttributeVmEventData, Value: data},
+		}
+	}
+
+	// ABCI log merges same type events
+	attrs := newAttrs("0x1", "0x1::Foo::Bar", "01")
+	attrs = append(attrs, sdk.Attribute{Key: AttributeVmEventDataDecoded, Value: `{"value":1}`})
+	attrs = append(attrs, newAttrs("0x1", "0x1::Foo::Baz", "02")...)
+	attrs = append(attrs, newAttrs("0x2", "0x1::Foo::Bar", "03")...)
+
+	tx := sdk.TxResponse{
+		Height: 10,
+		TxHash: "hash",
+		Logs: sdk.ABCIMessageLogs{
+			{
+				Events: sdk.StringEvents{
+					{Type: sdk.EventTypeMessage, Attributes: []sdk.Attribute{{Key: sdk.AttributeKeyModule, Value: ModuleName}}},
+					{Type: EventTypeMoveEvent, Attributes: attrs},
+				},
+			},
+		},
+	}
+
+	// filter by type
+	{
+		events := NewTxMoveEventsFromABCILogs(tx, MoveEventsFilter{EventType: "0x1::Foo::Bar"})
+		require.Len(t, events, 2)
+
+		require.Equal(t, int64(10), events[0].Height)
+		require.Equal(t, "hash", events[0].TxHash)
+		require.Equal(t, "0x1", events[0].Sender)
+		require.Equal(t, AttributeValueSourceScript, events[0].Source)
+		require.Equal(t, "01", events[0].Data)
+		require.Equal(t, `{"value":1}`, events[0].DecodedData)
+
+		require.Equal(t, "0x2", events[1].Sender)
+		require.Equal(t, "03", events[1].Data)
+		require.Empty(t, events[1].DecodedData)
+	}
+
+	// filter by sender
+	{
+		events := NewTxMoveEventsFromABCILogs(tx, MoveEventsFilter{Sender: "0x1"})
+		require.Len(t, events, 2)
+		require.Equal(t, "0x1::Foo::Bar", events[0].Type)
+		require.Equal(t, "0x1::Foo::Baz", events[1].Type)
+	}
+
+	// filter by type and sender
+	{
+		events := NewTxMoveEventsFromABCILogs(tx, MoveEventsFilter{EventType: "0x1::Foo::Baz", Sender: "0x2"})
+		require.Empty(t, events)
+	}
+}
@@ -11,8 +11,9 @@ var _ sdk.Msg = MsgDeployModule{}
 
 // Client message to deploy a module (contract) to VM.
 type MsgDeployModule struct {
-	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
-	Module []Contract     `json:"module" yaml:"module"`
+	Signer        sdk.AccAddress      `json:"signer" yaml:"signer"`
+	Module        []Contract          `json:"module" yaml:"module"`
+	UpgradePolicy ModuleUpgradePolicy `json:"upgrade_policy,omitempty" yaml:"upgrade_policy,omitempty"`
 }
 
 // Implements sdk.Msg interface.
@@ -35,6 +36,10 @@ func (msg MsgDeployModule) ValidateBasic() error {
 		return ErrEmptyContract
 	}
 
+	if msg.UpgradePolicy != "" && !msg.UpgradePolicy.IsValid() {
+		return sdkErrors.Wrapf(ErrWrongUpgradePolicy, "%q", msg.UpgradePolicy)
+	}
+
 	return nil
 }
 
@@ -49,9 +54,11 @@ func (msg MsgDeployModule) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgDeployModule creates a new MsgDeployModule message.
-func NewMsgDeployModule(signer sdk.AccAddress, modules []Contract) MsgDeployModule {
+// Empty {policy} keeps the current module upgrade policy (or sets the default one on the first deploy).
+func NewMsgDeployModule(signer sdk.AccAddress, modules []Contract, policy ModuleUpgradePolicy) MsgDeployModule {
 	return MsgDeployModule{
-		Signer: signer,
-		Module: modules,
+		Signer:        signer,
+		Module:        modules,
+		UpgradePolicy: policy,
 	}
 }
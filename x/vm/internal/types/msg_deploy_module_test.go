@@ -22,7 +22,7 @@ func TestVM_MsgDeployModule(t *testing.T) {
 
 	acc := sdk.AccAddress([]byte("addr1"))
 	code := make(Contract, 128)
-	msg := NewMsgDeployModule(acc, []Contract{code})
+	msg := NewMsgDeployModule(acc, []Contract{code}, "")
 
 	require.Equal(t, msg.Signer, acc)
 	require.Equal(t, msg.Module[0], code)
@@ -32,11 +32,17 @@ func TestVM_MsgDeployModule(t *testing.T) {
 	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{acc})
 	require.Equal(t, getMsgSignBytes(t, msg), msg.GetSignBytes())
 
-	msg = NewMsgDeployModule([]byte{}, []Contract{code})
+	msg = NewMsgDeployModule([]byte{}, []Contract{code}, "")
 	require.Empty(t, msg.Signer)
 	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
 
-	msg = NewMsgDeployModule(acc, []Contract{})
+	msg = NewMsgDeployModule(acc, []Contract{}, "")
 	require.Empty(t, msg.Module)
 	utils.CheckExpectedErr(t, ErrEmptyContract, msg.ValidateBasic())
+
+	msg = NewMsgDeployModule(acc, []Contract{code}, ModuleUpgradePolicyImmutable)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = NewMsgDeployModule(acc, []Contract{code}, "unknown")
+	utils.CheckExpectedErr(t, ErrWrongUpgradePolicy, msg.ValidateBasic())
 }
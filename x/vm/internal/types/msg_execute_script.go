@@ -13,9 +13,10 @@ var _ sdk.Msg = MsgExecuteScript{}
 
 // Client message to deploy a script with args to VM.
 type MsgExecuteScript struct {
-	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
-	Script Contract       `json:"script" yaml:"script"`
-	Args   []ScriptArg    `json:"args" yaml:"args"`
+	Signer   sdk.AccAddress  `json:"signer" yaml:"signer"`
+	Script   Contract        `json:"script" yaml:"script"`
+	TypeArgs []StructTypeTag `json:"type_args,omitempty" yaml:"type_args,omitempty"`
+	Args     []ScriptArg     `json:"args" yaml:"args"`
 }
 
 // Implements sdk.Msg interface.
@@ -34,20 +35,7 @@ func (msg MsgExecuteScript) ValidateBasic() error {
 		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "empty deployer address")
 	}
 
-	if len(msg.Script) == 0 {
-		return ErrEmptyContract
-	}
-
-	for _, arg := range msg.Args {
-		if _, err := StringifyVMTypeTag(arg.Type); err != nil {
-			return sdkErrors.Wrap(ErrWrongArgTypeTag, err.Error())
-		}
-		if len(arg.Value) == 0 {
-			return ErrWrongArgValue
-		}
-	}
-
-	return nil
+	return validateScriptPayload(msg.Script, msg.TypeArgs, msg.Args)
 }
 
 // Implements sdk.Msg interface.
@@ -61,14 +49,39 @@ func (msg MsgExecuteScript) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgExecuteScript creates a new MsgExecuteScript message.
-func NewMsgExecuteScript(signer sdk.AccAddress, script Contract, args []ScriptArg) MsgExecuteScript {
+func NewMsgExecuteScript(signer sdk.AccAddress, script Contract, typeArgs []StructTypeTag, args []ScriptArg) MsgExecuteScript {
 	return MsgExecuteScript{
-		Signer: signer,
-		Script: script,
-		Args:   args,
+		Signer:   signer,
+		Script:   script,
+		TypeArgs: typeArgs,
+		Args:     args,
 	}
 }
 
+// validateScriptPayload checks script code, type arguments and arguments (shared by script execution messages).
+func validateScriptPayload(script Contract, typeArgs []StructTypeTag, args []ScriptArg) error {
+	if len(script) == 0 {
+		return ErrEmptyContract
+	}
+
+	for typeArgIdx, typeArg := range typeArgs {
+		if err := typeArg.Validate(); err != nil {
+			return sdkErrors.Wrapf(ErrWrongTypeArg, "type_args[%d]: %v", typeArgIdx, err)
+		}
+	}
+
+	for _, arg := range args {
+		if _, err := StringifyVMTypeTag(arg.Type); err != nil {
+			return sdkErrors.Wrap(ErrWrongArgTypeTag, err.Error())
+		}
+		if len(arg.Value) == 0 {
+			return ErrWrongArgValue
+		}
+	}
+
+	return nil
+}
+
 // ScriptArg defines VM script argument.
 type ScriptArg struct {
 	Type  types_grpc.VMTypeTag `json:"type" yaml:"type"`
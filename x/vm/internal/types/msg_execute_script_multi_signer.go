@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	MsgExecuteScriptMultiSignerType = "execute_script_multi_signer"
+	// Max number of script signers (&signer script arguments)
+	ScriptMaxSigners = 7
+)
+
+var _ sdk.Msg = MsgExecuteScriptMultiSigner{}
+
+// Client message to execute a script requiring multiple signers (&signer arguments) with args to VM.
+// Signers order defines the order of &signer script arguments, every signer must sign the tx.
+type MsgExecuteScriptMultiSigner struct {
+	Signers  []sdk.AccAddress `json:"signers" yaml:"signers"`
+	Script   Contract         `json:"script" yaml:"script"`
+	TypeArgs []StructTypeTag  `json:"type_args,omitempty" yaml:"type_args,omitempty"`
+	Args     []ScriptArg      `json:"args" yaml:"args"`
+}
+
+// Implements sdk.Msg interface.
+func (MsgExecuteScriptMultiSigner) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (MsgExecuteScriptMultiSigner) Type() string {
+	return MsgExecuteScriptMultiSignerType
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgExecuteScriptMultiSigner) ValidateBasic() error {
+	if len(msg.Signers) == 0 {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "empty signers list")
+	}
+	if len(msg.Signers) > ScriptMaxSigners {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "signers list length exceeds %d", ScriptMaxSigners)
+	}
+
+	signersSet := make(map[string]bool, len(msg.Signers))
+	for signerIdx, signer := range msg.Signers {
+		if signer.Empty() {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "signers[%d]: empty", signerIdx)
+		}
+
+		signerStr := signer.String()
+		if signersSet[signerStr] {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "signers[%d]: duplicated %s", signerIdx, signerStr)
+		}
+		signersSet[signerStr] = true
+	}
+
+	return validateScriptPayload(msg.Script, msg.TypeArgs, msg.Args)
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgExecuteScriptMultiSigner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+// All script signers are tx signers: ante handler enforces every signer's signature (first signer pays the fees).
+func (msg MsgExecuteScriptMultiSigner) GetSigners() []sdk.AccAddress {
+	return msg.Signers
+}
+
+// NewMsgExecuteScriptMultiSigner creates a new MsgExecuteScriptMultiSigner message.
+func NewMsgExecuteScriptMultiSigner(signers []sdk.AccAddress, script Contract, typeArgs []StructTypeTag, args []ScriptArg) MsgExecuteScriptMultiSigner {
+	return MsgExecuteScriptMultiSigner{
+		Signers:  signers,
+		Script:   script,
+		TypeArgs: typeArgs,
+		Args:     args,
+	}
+}
@@ -0,0 +1,62 @@
+// +build unit
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dfinance/dvm-proto/go/types_grpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/helpers/tests/utils"
+)
+
+// Test MsgExecuteScriptMultiSigner.
+func TestVM_MsgExecuteScriptMultiSigner(t *testing.T) {
+	t.Parallel()
+
+	acc1, acc2 := sdk.AccAddress([]byte("addr1")), sdk.AccAddress([]byte("addr2"))
+	code := make(Contract, 128)
+	args := []ScriptArg{
+		{Type: types_grpc.VMTypeTag_U64, Value: []byte{0x1, 0x2, 0x3, 0x4}},
+	}
+
+	msg := NewMsgExecuteScriptMultiSigner([]sdk.AccAddress{acc1, acc2}, code, nil, args)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, MsgExecuteScriptMultiSignerType, msg.Type())
+	require.Equal(t, []sdk.AccAddress{acc1, acc2}, msg.GetSigners())
+	require.Equal(t, getMsgSignBytes(t, msg), msg.GetSignBytes())
+
+	// no signers
+	msg = NewMsgExecuteScriptMultiSigner(nil, code, nil, args)
+	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
+
+	// empty signer
+	msg = NewMsgExecuteScriptMultiSigner([]sdk.AccAddress{acc1, {}}, code, nil, args)
+	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
+
+	// duplicated signer
+	msg = NewMsgExecuteScriptMultiSigner([]sdk.AccAddress{acc1, acc2, acc1}, code, nil, args)
+	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
+
+	// too many signers
+	{
+		signers := make([]sdk.AccAddress, 0, ScriptMaxSigners+1)
+		for i := 0; i <= ScriptMaxSigners; i++ {
+			signers = append(signers, sdk.AccAddress([]byte{byte(i + 1)}))
+		}
+		msg = NewMsgExecuteScriptMultiSigner(signers, code, nil, args)
+		utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
+	}
+
+	// script without code
+	msg = NewMsgExecuteScriptMultiSigner([]sdk.AccAddress{acc1, acc2}, []byte{}, nil, nil)
+	utils.CheckExpectedErr(t, ErrEmptyContract, msg.ValidateBasic())
+
+	// invalid arg
+	msg = NewMsgExecuteScriptMultiSigner([]sdk.AccAddress{acc1, acc2}, code, nil, []ScriptArg{{Type: types_grpc.VMTypeTag_U64}})
+	utils.CheckExpectedErr(t, ErrWrongArgValue, msg.ValidateBasic())
+}
@@ -28,7 +28,7 @@ func TestVM_MsgExecuteScript(t *testing.T) {
 		{Type: types_grpc.VMTypeTag_Address, Value: common_vm.Bech32ToLibra(acc)},
 	}
 
-	msg := NewMsgExecuteScript(acc, code, args)
+	msg := NewMsgExecuteScript(acc, code, nil, args)
 	require.Equal(t, msg.Signer, acc)
 	require.Equal(t, msg.Script, code)
 	require.NoError(t, msg.ValidateBasic())
@@ -40,18 +40,29 @@ func TestVM_MsgExecuteScript(t *testing.T) {
 	require.EqualValues(t, msg.Args, args)
 
 	// message without signer
-	msg = NewMsgExecuteScript([]byte{}, code, nil)
+	msg = NewMsgExecuteScript([]byte{}, code, nil, nil)
 	require.Empty(t, msg.Signer)
 	require.Nil(t, msg.Args)
 	utils.CheckExpectedErr(t, sdkErrors.ErrInvalidAddress, msg.ValidateBasic())
 
 	// message without args should be fine
-	msg = NewMsgExecuteScript(acc, code, nil)
+	msg = NewMsgExecuteScript(acc, code, nil, nil)
 	require.NoError(t, msg.ValidateBasic())
 
 	// script without code
-	msg = NewMsgExecuteScript(acc, []byte{}, nil)
+	msg = NewMsgExecuteScript(acc, []byte{}, nil, nil)
 	utils.CheckExpectedErr(t, ErrEmptyContract, msg.ValidateBasic())
+
+	// message with type args
+	typeArg, err := ParseStructTypeTag("0x1::Coins::ETH")
+	require.NoError(t, err)
+	msg = NewMsgExecuteScript(acc, code, []StructTypeTag{typeArg}, args)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, getMsgSignBytes(t, msg), msg.GetSignBytes())
+
+	// invalid type arg
+	msg = NewMsgExecuteScript(acc, code, []StructTypeTag{{Address: acc, Module: "Coins", Name: "ETH"}}, args)
+	utils.CheckExpectedErr(t, ErrWrongTypeArg, msg.ValidateBasic())
 }
 
 // Test new argument
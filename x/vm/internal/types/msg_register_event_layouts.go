@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const MsgRegisterEventLayoutsType = "register_event_layouts"
+
+var _ sdk.Msg = MsgRegisterEventLayouts{}
+
+// Client message to register (or override) Move event layouts used to decode events data.
+// Layouts can only be registered by the event module owner (event type address).
+type MsgRegisterEventLayouts struct {
+	Signer  sdk.AccAddress `json:"signer" yaml:"signer"`
+	Layouts EventLayouts   `json:"layouts" yaml:"layouts"`
+}
+
+// Implements sdk.Msg interface.
+func (MsgRegisterEventLayouts) Route() string {
+	return RouterKey
+}
+
+// Implements sdk.Msg interface.
+func (MsgRegisterEventLayouts) Type() string {
+	return MsgRegisterEventLayoutsType
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRegisterEventLayouts) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidAddress, "empty signer address")
+	}
+
+	if len(msg.Layouts) == 0 {
+		return sdkErrors.Wrap(ErrWrongEventLayout, "empty layouts list")
+	}
+	if err := msg.Layouts.Validate(); err != nil {
+		return sdkErrors.Wrap(ErrWrongEventLayout, err.Error())
+	}
+
+	for layoutIdx, layout := range msg.Layouts {
+		if !layout.Owner().Equals(msg.Signer) {
+			return sdkErrors.Wrapf(sdkErrors.ErrUnauthorized, "layouts[%d]: event_type %q: signer is not the module owner", layoutIdx, layout.EventType)
+		}
+	}
+
+	return nil
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRegisterEventLayouts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Implements sdk.Msg interface.
+func (msg MsgRegisterEventLayouts) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// NewMsgRegisterEventLayouts creates a new MsgRegisterEventLayouts message.
+func NewMsgRegisterEventLayouts(signer sdk.AccAddress, layouts EventLayouts) MsgRegisterEventLayouts {
+	return MsgRegisterEventLayouts{
+		Signer:  signer,
+		Layouts: layouts,
+	}
+}
@@ -1,8 +1,22 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	QueryValue   = "value"
 	QueryLcsView = "lcsView"
+	//
+	QueryEventLayouts = "eventLayouts"
+	//
+	QueryResources       = "resources"
+	QueryResourceHolders = "resourceHolders"
+	//
+	QueryModules = "modules"
+	QueryModule  = "module"
+	//
+	QueryPaginationMaxLimit = 1000 // max items per page for paginated queries
 )
 
 // Client request for writeSet data.
@@ -19,6 +33,35 @@ type LcsViewReq struct {
 	ViewRequest ViewerRequest `json:"view_request" yaml:"view_request"`
 }
 
+// Client request for account resources list.
+type ResourcesReq struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Page    sdk.Uint       `json:"page" yaml:"page"`
+	Limit   sdk.Uint       `json:"limit" yaml:"limit"`
+}
+
+// Client request for struct type resource holders list.
+type ResourceHoldersReq struct {
+	// Move struct type (0x1::Account::Balance<0x1::XFI::T>)
+	StructType string   `json:"struct_type" yaml:"struct_type"`
+	Page       sdk.Uint `json:"page" yaml:"page"`
+	Limit      sdk.Uint `json:"limit" yaml:"limit"`
+}
+
+// Client request for published modules list.
+type ModulesReq struct {
+	// Modules address filter (optional)
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Page    sdk.Uint       `json:"page" yaml:"page"`
+	Limit   sdk.Uint       `json:"limit" yaml:"limit"`
+}
+
+// Client request for published module info.
+type ModuleReq struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Name    string         `json:"name" yaml:"name"`
+}
+
 // Client response for writeSet data.
 type ValueResp struct {
 	Value string `json:"value" yaml:"value" format:"HEX string"`
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dfinance/glav"
+)
+
+// Resource is a VM storage resource (writeSet) with optional type info.
+type Resource struct {
+	// Resource holder address (0x1 for stdlib / Bech32 for account)
+	Address string `json:"address" yaml:"address"`
+	// Resource VM storage path (HEX string)
+	Path string `json:"path" yaml:"path"`
+	// Resource struct type, empty if type can't be identified
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Resource raw LCS data (HEX string)
+	Value string `json:"value" yaml:"value"`
+	// Resource decoded data (JSON string), empty if struct layout is not registered
+	DecodedValue string `json:"value_decoded,omitempty" yaml:"value_decoded,omitempty"`
+}
+
+func (r Resource) String() string {
+	return fmt.Sprintf("Resource:\n"+
+		"  Address:      %s\n"+
+		"  Path:         %s\n"+
+		"  Type:         %s\n"+
+		"  Value:        %s\n"+
+		"  DecodedValue: %s",
+		r.Address,
+		r.Path,
+		r.Type,
+		r.Value,
+		r.DecodedValue,
+	)
+}
+
+// NewResource creates a new Resource object.
+func NewResource(address, path, value []byte) Resource {
+	return Resource{
+		Address: StringifySenderAddress(address),
+		Path:    hex.EncodeToString(path),
+		Value:   hex.EncodeToString(value),
+	}
+}
+
+// Resources is a slice of Resource objects.
+type Resources []Resource
+
+func (list Resources) String() string {
+	strBuilder := strings.Builder{}
+	strBuilder.WriteString("Resources:\n")
+	for i, resource := range list {
+		strBuilder.WriteString(resource.String())
+		if i < len(list)-1 {
+			strBuilder.WriteString("\n")
+		}
+	}
+
+	return strBuilder.String()
+}
+
+// IsResourceAccessPath checks if VM storage path is a resource path (not a module path).
+func IsResourceAccessPath(path []byte) bool {
+	return len(path) > 0 && path[0] == glav.ResourceTag
+}
@@ -0,0 +1,410 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+const (
+	// Max nesting level for script type arguments
+	TypeTagMaxDepth = 8
+)
+
+var (
+	// Move identifier (module / struct name) format
+	moveIdentifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	// Primitive Move types (non-generic)
+	primitiveTypeTags = map[string]vm_grpc.LcsType{
+		"bool":    vm_grpc.LcsType_LcsBool,
+		"u8":      vm_grpc.LcsType_LcsU8,
+		"u64":     vm_grpc.LcsType_LcsU64,
+		"u128":    vm_grpc.LcsType_LcsU128,
+		"address": vm_grpc.LcsType_LcsAddress,
+		"signer":  vm_grpc.LcsType_LcsSigner,
+	}
+)
+
+// StructTypeTag defines VM struct type used as a script generic type argument (0x1::XFI::T).
+type StructTypeTag struct {
+	// Module owner address
+	Address sdk.AccAddress `json:"address" yaml:"address" swaggertype:"string" format:"bech32" example:"wallet1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqlcs9pe"`
+	// Module name
+	Module string `json:"module" yaml:"module" example:"XFI"`
+	// Struct name
+	Name string `json:"name" yaml:"name" example:"T"`
+	// Struct generic type parameters
+	TypeParams []TypeTag `json:"type_params,omitempty" yaml:"type_params,omitempty"`
+}
+
+// Validate checks that struct type tag is valid.
+func (t StructTypeTag) Validate() error {
+	return t.validate(0)
+}
+
+// ToVMStructIdent converts StructTypeTag to VM StructIdent.
+// Contract: StructTypeTag is valid.
+func (t StructTypeTag) ToVMStructIdent() *vm_grpc.StructIdent {
+	ident := &vm_grpc.StructIdent{
+		Address: common_vm.Bech32ToLibra(t.Address),
+		Module:  t.Module,
+		Name:    t.Name,
+	}
+	for _, param := range t.TypeParams {
+		ident.TypeParams = append(ident.TypeParams, param.ToVMLcsTag())
+	}
+
+	return ident
+}
+
+// ResourceAccessPath returns VM storage path for the struct type resource.
+// Contract: StructTypeTag is valid.
+func (t StructTypeTag) ResourceAccessPath() []byte {
+	return t.toGlavStructTag().AccessVector()
+}
+
+// toGlavStructTag converts StructTypeTag to GLAV StructTag used to build VM storage paths.
+func (t StructTypeTag) toGlavStructTag() glav.StructTag {
+	var address [common_vm.VMAddressLength]byte
+	copy(address[:], common_vm.Bech32ToLibra(t.Address))
+
+	params := make([]glav.TypeParam, 0, len(t.TypeParams))
+	for _, param := range t.TypeParams {
+		params = append(params, param.toGlavTypeParam())
+	}
+
+	return glav.NewStructTag(address, t.Module, t.Name, params)
+}
+
+// String returns Move representation of the struct type (0x1::Coins::ETH<u64>).
+func (t StructTypeTag) String() string {
+	str := fmt.Sprintf("%s::%s::%s", StringifySenderAddress(t.Address), t.Module, t.Name)
+	if len(t.TypeParams) == 0 {
+		return str
+	}
+
+	params := make([]string, 0, len(t.TypeParams))
+	for _, param := range t.TypeParams {
+		params = append(params, param.String())
+	}
+
+	return fmt.Sprintf("%s<%s>", str, strings.Join(params, ", "))
+}
+
+func (t StructTypeTag) validate(depth int) error {
+	if depth > TypeTagMaxDepth {
+		return fmt.Errorf("nesting level exceeds %d", TypeTagMaxDepth)
+	}
+	if len(t.Address) != common_vm.VMAddressLength {
+		return fmt.Errorf("address: length mismatch: %d / %d", len(t.Address), common_vm.VMAddressLength)
+	}
+	if !moveIdentifierRegexp.MatchString(t.Module) {
+		return fmt.Errorf("module %q: invalid identifier", t.Module)
+	}
+	if !moveIdentifierRegexp.MatchString(t.Name) {
+		return fmt.Errorf("name %q: invalid identifier", t.Name)
+	}
+	for paramIdx, param := range t.TypeParams {
+		if err := param.validate(depth + 1); err != nil {
+			return fmt.Errorf("type_params[%d]: %w", paramIdx, err)
+		}
+	}
+
+	return nil
+}
+
+// TypeTag defines VM type used as a StructTypeTag type parameter (u64, vector<u8>, 0x1::XFI::T).
+type TypeTag struct {
+	// Type
+	Type vm_grpc.LcsType `json:"type" yaml:"type" swaggertype:"integer" example:"7"`
+	// Vector elements type (LcsVector type only)
+	VectorType *TypeTag `json:"vector_type,omitempty" yaml:"vector_type,omitempty"`
+	// Struct type (LcsStruct type only)
+	StructType *StructTypeTag `json:"struct_type,omitempty" yaml:"struct_type,omitempty"`
+}
+
+// Validate checks that type tag is valid.
+func (t TypeTag) Validate() error {
+	return t.validate(0)
+}
+
+// ToVMLcsTag converts TypeTag to VM LcsTag.
+// Contract: TypeTag is valid.
+func (t TypeTag) ToVMLcsTag() *vm_grpc.LcsTag {
+	tag := &vm_grpc.LcsTag{
+		TypeTag: t.Type,
+	}
+	if t.VectorType != nil {
+		tag.VectorType = t.VectorType.ToVMLcsTag()
+	}
+	if t.StructType != nil {
+		tag.StructIdent = t.StructType.ToVMStructIdent()
+	}
+
+	return tag
+}
+
+// toGlavTypeParam converts TypeTag to GLAV TypeParam.
+// Contract: TypeTag is valid.
+func (t TypeTag) toGlavTypeParam() glav.TypeParam {
+	switch t.Type {
+	case vm_grpc.LcsType_LcsBool:
+		return glav.NewBoolTypeParam()
+	case vm_grpc.LcsType_LcsU8:
+		return glav.NewU8TypeParam()
+	case vm_grpc.LcsType_LcsU64:
+		return glav.NewU64TypeParam()
+	case vm_grpc.LcsType_LcsU128:
+		return glav.NewU128TypeParam()
+	case vm_grpc.LcsType_LcsAddress:
+		return glav.NewAddressTypeParam()
+	case vm_grpc.LcsType_LcsSigner:
+		return glav.NewSignerTypeParam()
+	case vm_grpc.LcsType_LcsVector:
+		return glav.NewVectorTypeParam(t.VectorType.toGlavTypeParam())
+	case vm_grpc.LcsType_LcsStruct:
+		return glav.NewStructTypeParam(t.StructType.toGlavStructTag())
+	default:
+		panic(fmt.Errorf("unsupported type tag: %v", t.Type))
+	}
+}
+
+// String returns Move representation of the type.
+func (t TypeTag) String() string {
+	switch t.Type {
+	case vm_grpc.LcsType_LcsVector:
+		if t.VectorType == nil {
+			return "vector<?>"
+		}
+		return fmt.Sprintf("vector<%s>", t.VectorType.String())
+	case vm_grpc.LcsType_LcsStruct:
+		if t.StructType == nil {
+			return "struct<?>"
+		}
+		return t.StructType.String()
+	}
+
+	for name, lcsType := range primitiveTypeTags {
+		if lcsType == t.Type {
+			return name
+		}
+	}
+
+	return vm_grpc.LcsType_name[int32(t.Type)]
+}
+
+func (t TypeTag) validate(depth int) error {
+	if depth > TypeTagMaxDepth {
+		return fmt.Errorf("nesting level exceeds %d", TypeTagMaxDepth)
+	}
+	if _, ok := vm_grpc.LcsType_name[int32(t.Type)]; !ok {
+		return fmt.Errorf("type %d: unknown", t.Type)
+	}
+
+	switch t.Type {
+	case vm_grpc.LcsType_LcsVector:
+		if t.VectorType == nil {
+			return fmt.Errorf("vector_type: nil for vector type")
+		}
+		if t.StructType != nil {
+			return fmt.Errorf("struct_type: non-nil for vector type")
+		}
+		if err := t.VectorType.validate(depth + 1); err != nil {
+			return fmt.Errorf("vector_type: %w", err)
+		}
+	case vm_grpc.LcsType_LcsStruct:
+		if t.StructType == nil {
+			return fmt.Errorf("struct_type: nil for struct type")
+		}
+		if t.VectorType != nil {
+			return fmt.Errorf("vector_type: non-nil for struct type")
+		}
+		if err := t.StructType.validate(depth + 1); err != nil {
+			return fmt.Errorf("struct_type: %w", err)
+		}
+	default:
+		if t.VectorType != nil || t.StructType != nil {
+			return fmt.Errorf("vector_type / struct_type: non-nil for primitive type")
+		}
+	}
+
+	return nil
+}
+
+// ParseStructTypeTag parses Move struct type string (0x1::Coins::ETH, wallet1...::Foo::Bar<u64, vector<u8>>).
+// Address could be defined in the short HEX (0x1) or Bech32 form.
+func ParseStructTypeTag(str string) (StructTypeTag, error) {
+	tag, err := ParseTypeTag(str)
+	if err != nil {
+		return StructTypeTag{}, err
+	}
+	if tag.Type != vm_grpc.LcsType_LcsStruct {
+		return StructTypeTag{}, fmt.Errorf("%q: struct type expected", str)
+	}
+
+	return *tag.StructType, nil
+}
+
+// ParseTypeTag parses Move type string (u64, vector<u8>, 0x1::Coins::ETH).
+func ParseTypeTag(str string) (TypeTag, error) {
+	p := typeTagParser{input: str}
+
+	tag, err := p.parseTypeTag(0)
+	if err != nil {
+		return TypeTag{}, fmt.Errorf("%q: %w", str, err)
+	}
+
+	p.skipSpaces()
+	if p.pos != len(p.input) {
+		return TypeTag{}, fmt.Errorf("%q: unexpected %q at position %d", str, p.input[p.pos:], p.pos)
+	}
+
+	return tag, nil
+}
+
+// typeTagParser is a recursive Move type string parser.
+type typeTagParser struct {
+	input string
+	pos   int
+}
+
+func (p *typeTagParser) skipSpaces() {
+	for p.pos < len(p.input) && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// nextToken reads a type name / struct path token.
+func (p *typeTagParser) nextToken() string {
+	p.skipSpaces()
+
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune("<>, ", rune(p.input[p.pos])) {
+		p.pos++
+	}
+
+	return p.input[start:p.pos]
+}
+
+// consume checks the next symbol and moves forward if it matches.
+func (p *typeTagParser) consume(symbol byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == symbol {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+// parseTypeParams parses "<T1, T2, ...>" type parameters list (if any).
+func (p *typeTagParser) parseTypeParams(depth int) ([]TypeTag, error) {
+	if !p.consume('<') {
+		return nil, nil
+	}
+
+	var params []TypeTag
+	for {
+		param, err := p.parseTypeTag(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+
+		if p.consume(',') {
+			continue
+		}
+		if p.consume('>') {
+			return params, nil
+		}
+
+		return nil, fmt.Errorf("',' or '>' expected at position %d", p.pos)
+	}
+}
+
+func (p *typeTagParser) parseTypeTag(depth int) (TypeTag, error) {
+	if depth > TypeTagMaxDepth {
+		return TypeTag{}, fmt.Errorf("nesting level exceeds %d", TypeTagMaxDepth)
+	}
+
+	token := p.nextToken()
+	if token == "" {
+		return TypeTag{}, fmt.Errorf("type expected at position %d", p.pos)
+	}
+
+	params, err := p.parseTypeParams(depth)
+	if err != nil {
+		return TypeTag{}, err
+	}
+
+	// primitive
+	if lcsType, ok := primitiveTypeTags[token]; ok {
+		if params != nil {
+			return TypeTag{}, fmt.Errorf("%s: type parameters are not allowed", token)
+		}
+		return TypeTag{Type: lcsType}, nil
+	}
+
+	// vector
+	if token == "vector" {
+		if len(params) != 1 {
+			return TypeTag{}, fmt.Errorf("vector: exactly one type parameter expected")
+		}
+		return TypeTag{Type: vm_grpc.LcsType_LcsVector, VectorType: &params[0]}, nil
+	}
+
+	// struct
+	pathItems := strings.Split(token, "::")
+	if len(pathItems) != 3 {
+		return TypeTag{}, fmt.Errorf("%s: struct type path {address}::{module}::{name} expected", token)
+	}
+
+	address, err := ParseVMAddress(pathItems[0])
+	if err != nil {
+		return TypeTag{}, fmt.Errorf("%s: address: %w", token, err)
+	}
+
+	structTag := StructTypeTag{
+		Address:    address,
+		Module:     pathItems[1],
+		Name:       pathItems[2],
+		TypeParams: params,
+	}
+	if err := structTag.validate(depth); err != nil {
+		return TypeTag{}, fmt.Errorf("%s: %w", token, err)
+	}
+
+	return TypeTag{Type: vm_grpc.LcsType_LcsStruct, StructType: &structTag}, nil
+}
+
+// ParseVMAddress parses VM address in the HEX (0x1) or Bech32 form.
+func ParseVMAddress(str string) (sdk.AccAddress, error) {
+	if !strings.HasPrefix(str, "0x") {
+		return sdk.AccAddressFromBech32(str)
+	}
+
+	hexStr := strings.TrimPrefix(str, "0x")
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + hexStr
+	}
+
+	bz, err := hex.DecodeString(hexStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 || len(bz) > common_vm.VMAddressLength {
+		return nil, fmt.Errorf("length should be in (0, %d] range: %d", common_vm.VMAddressLength, len(bz))
+	}
+
+	address := make(sdk.AccAddress, common_vm.VMAddressLength)
+	copy(address[common_vm.VMAddressLength-len(bz):], bz)
+
+	return address, nil
+}
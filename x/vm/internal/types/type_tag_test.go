@@ -0,0 +1,203 @@
+// +build unit
+
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dfinance/dvm-proto/go/vm_grpc"
+	"github.com/dfinance/glav"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dfinance/dnode/x/common_vm"
+)
+
+// Test Move type string parsing.
+func TestVM_ParseTypeTag(t *testing.T) {
+	t.Parallel()
+
+	// ok: primitive
+	{
+		tag, err := ParseTypeTag("u64")
+		require.NoError(t, err)
+		require.Equal(t, vm_grpc.LcsType_LcsU64, tag.Type)
+		require.Nil(t, tag.VectorType)
+		require.Nil(t, tag.StructType)
+	}
+
+	// ok: vector
+	{
+		tag, err := ParseTypeTag("vector<vector<u8>>")
+		require.NoError(t, err)
+		require.Equal(t, vm_grpc.LcsType_LcsVector, tag.Type)
+		require.Equal(t, vm_grpc.LcsType_LcsVector, tag.VectorType.Type)
+		require.Equal(t, vm_grpc.LcsType_LcsU8, tag.VectorType.VectorType.Type)
+		require.Equal(t, "vector<vector<u8>>", tag.String())
+	}
+
+	// ok: nested struct
+	{
+		str := "0x1::Foo::Bar<u64, vector<0x1::XFI::T>, address>"
+		structTag, err := ParseStructTypeTag(str)
+		require.NoError(t, err)
+		require.NoError(t, structTag.Validate())
+		require.Equal(t, common_vm.StdLibAddress, common_vm.Bech32ToLibra(structTag.Address))
+		require.Equal(t, "Foo", structTag.Module)
+		require.Equal(t, "Bar", structTag.Name)
+		require.Len(t, structTag.TypeParams, 3)
+		require.Equal(t, vm_grpc.LcsType_LcsU64, structTag.TypeParams[0].Type)
+		require.Equal(t, "XFI", structTag.TypeParams[1].VectorType.StructType.Module)
+		require.Equal(t, vm_grpc.LcsType_LcsAddress, structTag.TypeParams[2].Type)
+		require.Equal(t, str, structTag.String())
+	}
+
+	// ok: bech32 address
+	{
+		addr := sdk.AccAddress(make([]byte, common_vm.VMAddressLength))
+		addr[0] = 0x1
+		structTag, err := ParseStructTypeTag(addr.String() + "::Foo::Bar")
+		require.NoError(t, err)
+		require.Equal(t, addr, structTag.Address)
+	}
+
+	// fail: invalid inputs
+	{
+		invalidInputs := []string{
+			"",
+			"u32",
+			"u64<u8>",
+			"vector",
+			"vector<u8, u8>",
+			"vector<u8",
+			"0x1::Foo",
+			"0x1::Foo::Bar::Baz",
+			"0xZZ::Foo::Bar",
+			"0x1::1Foo::Bar",
+			"0x1::Foo::Bar<>",
+			"0x1::Foo::Bar<u8> u8",
+		}
+		for _, input := range invalidInputs {
+			_, err := ParseTypeTag(input)
+			require.Error(t, err, input)
+		}
+	}
+
+	// fail: struct expected
+	{
+		_, err := ParseStructTypeTag("vector<u8>")
+		require.Error(t, err)
+	}
+
+	// fail: nesting level exceeded
+	{
+		str := strings.Repeat("vector<", TypeTagMaxDepth+1) + "u8" + strings.Repeat(">", TypeTagMaxDepth+1)
+		_, err := ParseTypeTag(str)
+		require.Error(t, err)
+	}
+}
+
+// Test StructTypeTag / TypeTag validation.
+func TestVM_TypeTag_Validate(t *testing.T) {
+	t.Parallel()
+
+	addr := make(sdk.AccAddress, common_vm.VMAddressLength)
+
+	// ok
+	{
+		tag := StructTypeTag{Address: addr, Module: "Foo", Name: "Bar", TypeParams: []TypeTag{{Type: vm_grpc.LcsType_LcsBool}}}
+		require.NoError(t, tag.Validate())
+	}
+
+	// fail: address length
+	{
+		tag := StructTypeTag{Address: addr[1:], Module: "Foo", Name: "Bar"}
+		require.Error(t, tag.Validate())
+	}
+
+	// fail: identifiers
+	{
+		require.Error(t, StructTypeTag{Address: addr, Module: "", Name: "Bar"}.Validate())
+		require.Error(t, StructTypeTag{Address: addr, Module: "Foo", Name: "Bar<u8>"}.Validate())
+	}
+
+	// fail: unknown type
+	{
+		require.Error(t, TypeTag{Type: vm_grpc.LcsType(100)}.Validate())
+	}
+
+	// fail: vector / struct fields mismatch
+	{
+		require.Error(t, TypeTag{Type: vm_grpc.LcsType_LcsVector}.Validate())
+		require.Error(t, TypeTag{Type: vm_grpc.LcsType_LcsStruct}.Validate())
+		require.Error(t, TypeTag{Type: vm_grpc.LcsType_LcsU8, VectorType: &TypeTag{Type: vm_grpc.LcsType_LcsU8}}.Validate())
+	}
+}
+
+// Test StructTypeTag to VM StructIdent conversion.
+func TestVM_StructTypeTag_ToVMStructIdent(t *testing.T) {
+	t.Parallel()
+
+	structTag, err := ParseStructTypeTag("0x1::Foo::Bar<vector<u8>, 0x1::XFI::T>")
+	require.NoError(t, err)
+
+	ident := structTag.ToVMStructIdent()
+	require.Equal(t, common_vm.StdLibAddress, ident.Address)
+	require.Equal(t, "Foo", ident.Module)
+	require.Equal(t, "Bar", ident.Name)
+	require.Len(t, ident.TypeParams, 2)
+	require.Equal(t, vm_grpc.LcsType_LcsVector, ident.TypeParams[0].TypeTag)
+	require.Equal(t, vm_grpc.LcsType_LcsU8, ident.TypeParams[0].VectorType.TypeTag)
+	require.Equal(t, vm_grpc.LcsType_LcsStruct, ident.TypeParams[1].TypeTag)
+	require.Equal(t, "XFI", ident.TypeParams[1].StructIdent.Module)
+	require.Equal(t, "T", ident.TypeParams[1].StructIdent.Name)
+}
+
+// Test StructTypeTag resource VM storage path building.
+func TestVM_StructTypeTag_ResourceAccessPath(t *testing.T) {
+	t.Parallel()
+
+	{
+		structTag, err := ParseStructTypeTag("0x1::Block::BlockMetadata")
+		require.NoError(t, err)
+		require.Equal(t, glav.BlockMetadataVector(), structTag.ResourceAccessPath())
+		require.True(t, IsResourceAccessPath(structTag.ResourceAccessPath()))
+	}
+
+	{
+		structTag, err := ParseStructTypeTag("0x1::Account::Balance<0x1::Coins::ETH>")
+		require.NoError(t, err)
+		require.Equal(t, glav.BalanceVector("eth"), structTag.ResourceAccessPath())
+	}
+
+	{
+		structTag, err := ParseStructTypeTag("0x1::Coins::Price<0x1::XFI::T, 0x1::Coins::BTC>")
+		require.NoError(t, err)
+		require.Equal(t, glav.OracleAccessVector("xfi", "btc"), structTag.ResourceAccessPath())
+	}
+
+	{
+		var address [common_vm.VMAddressLength]byte
+		copy(address[:], common_vm.StdLibAddress)
+		require.False(t, IsResourceAccessPath(glav.ModuleAccessVector(address, "Foo")))
+	}
+}
+
+// Test MsgExecuteScript with type args JSON round-trip.
+func TestVM_MsgExecuteScript_TypeArgsJSON(t *testing.T) {
+	t.Parallel()
+
+	typeArg, err := ParseStructTypeTag("0x1::Foo::Bar<u64, vector<0x1::XFI::T>>")
+	require.NoError(t, err)
+
+	msg := NewMsgExecuteScript(make(sdk.AccAddress, common_vm.VMAddressLength), make(Contract, 16), []StructTypeTag{typeArg}, nil)
+
+	bz, err := ModuleCdc.MarshalJSON(msg)
+	require.NoError(t, err)
+
+	var decodedMsg MsgExecuteScript
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decodedMsg))
+	require.Equal(t, msg, decodedMsg)
+	require.Equal(t, typeArg.String(), decodedMsg.TypeArgs[0].String())
+}